@@ -28,6 +28,7 @@ type Account struct {
 	EUUID             string      `json:"euuid"`
 	BillingSource     string      `json:"billing_source"`
 	Capabilities      []string    `json:"capabilities"`
+	ActivePromotions  []Promotion `json:"active_promotions"`
 	ActiveSince       *time.Time  `json:"-"`
 }
 
@@ -57,6 +58,11 @@ type CreditCard struct {
 	Expiry   string `json:"expiry"`
 }
 
+// HasCapabilities returns whether the Account has access to all of the given capabilities
+func (account Account) HasCapabilities(capabilities ...Capability) bool {
+	return hasCapabilities(account.Capabilities, capabilities)
+}
+
 // GetAccount gets the contact and billing information related to the Account.
 func (c *Client) GetAccount(ctx context.Context) (*Account, error) {
 	e := "account"
@@ -0,0 +1,43 @@
+package linodego
+
+import (
+	"context"
+)
+
+// AccountAgreements represents the agreements and their acknowledgement status for an account.
+// Some account operations are blocked until the applicable agreements are acknowledged.
+type AccountAgreements struct {
+	BillingAgreement       bool `json:"billing_agreement"`
+	EUModel                bool `json:"eu_model"`
+	MasterServiceAgreement bool `json:"master_service_agreement"`
+	PrivacyPolicy          bool `json:"privacy_policy"`
+}
+
+// AccountAgreementsUpdateOptions fields are those accepted by AcknowledgeAccountAgreements.
+// Agreements which are not set are left unchanged.
+type AccountAgreementsUpdateOptions struct {
+	BillingAgreement       *bool `json:"billing_agreement,omitempty"`
+	EUModel                *bool `json:"eu_model,omitempty"`
+	MasterServiceAgreement *bool `json:"master_service_agreement,omitempty"`
+	PrivacyPolicy          *bool `json:"privacy_policy,omitempty"`
+}
+
+// GetAccountAgreements gets the acknowledgement status of the agreements for the account
+func (c *Client) GetAccountAgreements(ctx context.Context) (*AccountAgreements, error) {
+	e := "account/agreements"
+
+	response, err := doGETRequest[AccountAgreements](ctx, c, e)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// AcknowledgeAccountAgreements acknowledges the given agreements for the account
+func (c *Client) AcknowledgeAccountAgreements(ctx context.Context, opts AccountAgreementsUpdateOptions) error {
+	e := "account/agreements"
+	_, err := doPOSTRequest[any](ctx, c, e, opts)
+
+	return err
+}
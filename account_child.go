@@ -2,6 +2,12 @@ package linodego
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/oauth2"
 )
 
 // ChildAccount represents an account under the current account.
@@ -45,3 +51,218 @@ func (c *Client) CreateChildAccountToken(ctx context.Context, euuid string) (*Ch
 		formatAPIPath("account/child-accounts/%s/token", euuid),
 	)
 }
+
+// ChildAccountTokenRefreshHook is called after a derived child account client attempts
+// to refresh its proxy token. err is non-nil if the refresh failed.
+type ChildAccountTokenRefreshHook func(token *ChildAccountToken, err error)
+
+// childHTTPClient returns an http.Client for a child account client with the timeout, redirect
+// policy and transport settings, such as TLS, of the parent's http.Client. An oauth2 transport
+// authenticating the parent is removed, as it would replace the child's Authorization header.
+func childHTTPClient(parent *http.Client) *http.Client {
+	transport := parent.Transport
+
+	if limited, ok := transport.(*responseLimitTransport); ok {
+		transport = limited.base
+	}
+
+	if auth, ok := transport.(*oauth2.Transport); ok {
+		transport = auth.Base
+	}
+
+	return &http.Client{
+		Transport:     transport,
+		CheckRedirect: parent.CheckRedirect,
+		Timeout:       parent.Timeout,
+	}
+}
+
+// childAccountTokenSource holds the proxy token of a derived child account client
+// and refreshes it at most once at a time.
+type childAccountTokenSource struct {
+	parent *Client
+	euuid  string
+
+	mu    sync.Mutex
+	token string
+
+	// fresh is true if the token was refreshed and has not yet
+	// been accepted by the API
+	fresh bool
+
+	// refreshing is non-nil while a refresh is in-flight
+	refreshing chan struct{}
+
+	// err is the terminal error of a failed refresh
+	err error
+
+	hooks []ChildAccountTokenRefreshHook
+}
+
+// UseChildAccount returns a new Client that makes requests under the given child account
+// using a short-lived proxy token. When the API rejects the proxy token with a 401, the
+// client refreshes it using the current client and retries the request. If the refresh
+// fails, or a refreshed token is rejected, all subsequent requests fail with that error.
+// NOTE: Parent/Child related features may not be generally available.
+func (c *Client) UseChildAccount(ctx context.Context, euuid string) (*Client, error) {
+	token, err := c.CreateChildAccountToken(ctx, euuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create child account token: %w", err)
+	}
+
+	child := NewClient(childHTTPClient(c.resty.GetClient()))
+
+	child.baseURL = c.baseURL
+	child.apiVersion = c.apiVersion
+	child.apiProto = c.apiProto
+	child.updateHostURL()
+
+	child.SetUserAgent(c.userAgent).
+		SetDebug(c.debug).
+		SetStrictDecoding(c.strictDecoding).
+		SetStrictValidation(c.strictValidation).
+		SetPayloadLimits(c.payloadLimits).
+		SetCircuitBreaker(c.circuitBreaker.options()).
+		SetMetricsCollector(c.metrics.get()).
+		SetRetryWaitTime(c.resty.RetryWaitTime).
+		SetRetryMaxWaitTime(c.resty.RetryMaxWaitTime).
+		SetRetryNonIdempotent(c.retryNonIdempotent.Load()).
+		SetGETDeduplication(c.getFlights.enabled.Load()).
+		SetMonitorAPIURL(c.monitorURL).
+		SetPollDelay(c.pollInterval)
+
+	child.responseLimits.copyFrom(c.responseLimits)
+
+	source := &childAccountTokenSource{
+		parent: c,
+		euuid:  euuid,
+		token:  token.Token,
+	}
+
+	child.childToken = source
+
+	child.OnBeforeRequest(func(r *Request) error {
+		token, err := source.current()
+		if err != nil {
+			return err
+		}
+
+		r.SetHeader("Authorization", fmt.Sprintf("Bearer %s", token))
+		return nil
+	})
+
+	child.OnAfterResponse(func(r *Response) error {
+		if r.StatusCode() != http.StatusUnauthorized {
+			source.accept(r.Request.Header.Get("Authorization"))
+		}
+		return nil
+	})
+
+	child.AddRetryCondition(func(r *resty.Response, _ error) bool {
+		if r == nil || r.StatusCode() != http.StatusUnauthorized {
+			return false
+		}
+
+		return source.refresh(r.Request.Context(), r.Request.Header.Get("Authorization"))
+	})
+
+	return &child, nil
+}
+
+// OnChildAccountTokenRefresh adds a hook that is called whenever a client created using
+// UseChildAccount(...) refreshes its proxy token. It has no effect on other clients.
+func (c *Client) OnChildAccountTokenRefresh(hook ChildAccountTokenRefreshHook) *Client {
+	if c.childToken == nil {
+		return c
+	}
+
+	c.childToken.mu.Lock()
+	defer c.childToken.mu.Unlock()
+
+	c.childToken.hooks = append(c.childToken.hooks, hook)
+
+	return c
+}
+
+// current returns the current token or the terminal refresh error
+func (s *childAccountTokenSource) current() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.token, s.err
+}
+
+// accept marks the token used in the given Authorization header as valid
+func (s *childAccountTokenSource) accept(authorization string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if authorization == fmt.Sprintf("Bearer %s", s.token) {
+		s.fresh = false
+	}
+}
+
+// refresh replaces the token used in the given Authorization header
+// and returns whether the request should be retried.
+// Concurrent callers wait for a single in-flight refresh, or until their own
+// request is cancelled.
+func (s *childAccountTokenSource) refresh(ctx context.Context, authorization string) bool {
+	s.mu.Lock()
+
+	if s.err != nil {
+		s.mu.Unlock()
+		return false
+	}
+
+	if s.refreshing != nil {
+		wait := s.refreshing
+		s.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return false
+		}
+
+		_, err := s.current()
+		return err == nil
+	}
+
+	// The token has already been refreshed since this request was sent
+	if authorization != fmt.Sprintf("Bearer %s", s.token) {
+		s.mu.Unlock()
+		return true
+	}
+
+	if s.fresh {
+		s.err = fmt.Errorf("refreshed child account token for %s was rejected by the API", s.euuid)
+		s.mu.Unlock()
+		return false
+	}
+
+	done := make(chan struct{})
+	s.refreshing = done
+	s.mu.Unlock()
+
+	token, err := s.parent.CreateChildAccountToken(ctx, s.euuid)
+
+	s.mu.Lock()
+	if err != nil {
+		s.err = fmt.Errorf("failed to refresh child account token for %s: %w", s.euuid, err)
+	} else {
+		s.token = token.Token
+		s.fresh = true
+	}
+
+	s.refreshing = nil
+	close(done)
+
+	hooks := s.hooks
+	s.mu.Unlock()
+
+	for _, hook := range hooks {
+		hook(token, err)
+	}
+
+	return err == nil
+}
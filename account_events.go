@@ -38,6 +38,12 @@ type Event struct {
 	// The username of the User who caused the Event.
 	Username string `json:"username"`
 
+	// The total duration in seconds that it takes for the Event to complete.
+	Duration float64 `json:"duration"`
+
+	// Additional information about the Event, such as the reason an Event failed.
+	Message string `json:"message"`
+
 	// Detailed information about the Event's entity, including ID, type, label, and URL used to access it.
 	Entity *EventEntity `json:"entity"`
 
@@ -313,16 +319,78 @@ func (c *Client) GetEvent(ctx context.Context, eventID int) (*Event, error) {
 	return response, nil
 }
 
+// EventListOptions builds ListOptions filtering Events on the commonly used fields,
+// without writing the JSON filter by hand. Unset fields do not filter Events.
+type EventListOptions struct {
+	// EntityType restricts Events to those of entities of the type
+	EntityType EntityType
+
+	// EntityID restricts Events to those of the entity with the ID, and should be used with EntityType
+	EntityID int
+
+	// Actions restricts Events to those with any of the actions
+	Actions []EventAction
+
+	// Since restricts Events to those created at or after the time
+	Since time.Time
+}
+
+// ListOptions returns ListOptions for the given page of Events matching the options,
+// or for all pages if page is 0, ordered by when the Events were created, newest first.
+func (o EventListOptions) ListOptions(page int) *ListOptions {
+	f := &Filter{
+		Order:   Descending,
+		OrderBy: "created",
+	}
+
+	if o.EntityType != "" {
+		f.AddField(Eq, "entity.type", o.EntityType)
+	}
+
+	if o.EntityID != 0 {
+		f.AddField(Eq, "entity.id", o.EntityID)
+	}
+
+	switch len(o.Actions) {
+	case 0:
+	case 1:
+		f.AddField(Eq, "action", o.Actions[0])
+	default:
+		actions := make([]FilterNode, len(o.Actions))
+		for i, action := range o.Actions {
+			actions[i] = &Comp{Column: "action", Operator: Eq, Value: action}
+		}
+
+		f.Children = append(f.Children, &Filter{Operator: "+or", Children: actions})
+	}
+
+	if !o.Since.IsZero() {
+		f.AddField(Gte, "created", o.Since.UTC().Format("2006-01-02T15:04:05"))
+	}
+
+	return NewListOptions(page, f)
+}
+
 // MarkEventRead marks a single Event as read.
 func (c *Client) MarkEventRead(ctx context.Context, event *Event) error {
-	e := formatAPIPath("account/events/%d/read", event.ID)
+	return c.MarkEventReadByID(ctx, event.ID)
+}
+
+// MarkEventReadByID marks the Event with the Event ID as read.
+func (c *Client) MarkEventReadByID(ctx context.Context, eventID int) error {
+	e := formatAPIPath("account/events/%d/read", eventID)
 	_, err := doPOSTRequest[Event](ctx, c, e, []any{})
 	return err
 }
 
 // MarkEventsSeen marks all Events up to and including this Event by ID as seen.
 func (c *Client) MarkEventsSeen(ctx context.Context, event *Event) error {
-	e := formatAPIPath("account/events/%d/seen", event.ID)
+	return c.MarkEventsSeenByID(ctx, event.ID)
+}
+
+// MarkEventsSeenByID marks all Events up to and including the Event with the Event ID as seen.
+func (c *Client) MarkEventsSeenByID(ctx context.Context, eventID int) error {
+	e := formatAPIPath("account/events/%d/seen", eventID)
 	_, err := doPOSTRequest[Event](ctx, c, e, []any{})
 	return err
 }
@@ -0,0 +1,195 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// EventWatchOptions selects the Events delivered by WatchEvents
+type EventWatchOptions struct {
+	// EntityType restricts Events to those of entities of the type; all Events are watched if empty
+	EntityType EntityType
+
+	// EntityID restricts Events to those of the entity with the ID, which requires EntityType
+	EntityID any
+
+	// Actions restricts Events to those with any of the actions; all actions are watched if empty
+	Actions []EventAction
+
+	// PollInterval is the time between polls of the Events list; the client's poll delay is used if 0
+	PollInterval time.Duration
+}
+
+// WatchEvents delivers the Events matching opts that are created after the watch starts, in the
+// order of their IDs and each exactly once. Events are polled using a filter on their ID, so only
+// the Events newer than the last one delivered are listed, across all pages of results.
+//
+// Errors listing Events are sent on the error channel without stopping the watch, which retries
+// at the next poll. Both channels are closed once ctx is done; callers should read from both
+// until then, as a poll waits for its Events and errors to be received before continuing.
+// If opts are invalid, the error is sent and both channels are closed immediately.
+func (c *Client) WatchEvents(ctx context.Context, opts EventWatchOptions) (<-chan Event, <-chan error) {
+	if opts.EntityID != nil && opts.EntityType == "" {
+		events := make(chan Event)
+		errs := make(chan error, 1)
+
+		errs <- fmt.Errorf("an EntityType is required to watch the events of entity %v", opts.EntityID)
+
+		close(events)
+		close(errs)
+
+		return events, errs
+	}
+
+	events := make(chan Event)
+	errs := make(chan error)
+
+	interval := opts.PollInterval
+	if interval == 0 {
+		interval = c.GetPollDelay()
+	}
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		w := eventWatcher{client: c, opts: opts}
+
+		for {
+			if err := w.poll(ctx, events); err != nil && ctx.Err() == nil {
+				select {
+				case errs <- err:
+				case <-ctx.Done():
+				}
+			}
+
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, errs
+}
+
+// eventWatcher tracks the last Event delivered by WatchEvents
+type eventWatcher struct {
+	client *Client
+	opts   EventWatchOptions
+
+	// lastID is the ID of the newest Event seen, starting from the newest Event found by the first poll
+	lastID  int
+	started bool
+}
+
+// poll sends the Events created since the last poll on events. The first poll only
+// records the ID of the newest Event, so that older Events are not delivered.
+func (w *eventWatcher) poll(ctx context.Context, events chan<- Event) error {
+	if !w.started {
+		latest, err := w.client.ListEvents(ctx, &ListOptions{
+			PageOptions: &PageOptions{Page: 1},
+			PageSize:    waitForEventPageSize,
+			Filter:      `{"+order_by": "id", "+order": "desc"}`,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(latest) > 0 {
+			w.lastID = latest[0].ID
+		}
+
+		w.started = true
+
+		return nil
+	}
+
+	listed, err := w.client.ListEvents(ctx, NewListOptions(0, w.filter()))
+	if err != nil {
+		return err
+	}
+
+	// Events may shift between pages while they are listed, so they are
+	// sorted and those at or below the last delivered ID are skipped
+	sort.SliceStable(listed, func(i, j int) bool {
+		return listed[i].ID < listed[j].ID
+	})
+
+	for _, event := range listed {
+		if event.ID <= w.lastID {
+			continue
+		}
+
+		if !w.matches(event) {
+			w.lastID = event.ID
+			continue
+		}
+
+		select {
+		case events <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		w.lastID = event.ID
+	}
+
+	return nil
+}
+
+// filter returns the filter listing the Events newer than the last one delivered
+func (w *eventWatcher) filter() *Filter {
+	f := &Filter{OrderBy: "id", Order: Ascending}
+	f.AddField(Gt, "id", w.lastID)
+
+	if len(w.opts.Actions) == 1 {
+		f.AddField(Eq, "action", w.opts.Actions[0])
+	}
+
+	// The API only supports filtering on the entity of some types, which all have int IDs
+	switch w.opts.EntityType {
+	case EntityDisk, EntityDatabase, EntityLinode, EntityDomain, EntityNodebalancer:
+		f.AddField(Eq, "entity.type", w.opts.EntityType)
+
+		if id, err := strconv.Atoi(eventEntityID(w.opts.EntityID)); err == nil {
+			f.AddField(Eq, "entity.id", id)
+		}
+	}
+
+	return f
+}
+
+// matches reports whether the event is one of those selected by the watch options
+func (w *eventWatcher) matches(event Event) bool {
+	if len(w.opts.Actions) > 0 && !slices.Contains(w.opts.Actions, event.Action) {
+		return false
+	}
+
+	if w.opts.EntityType == "" {
+		return true
+	}
+
+	if event.Entity == nil || event.Entity.Type != w.opts.EntityType {
+		return false
+	}
+
+	return w.opts.EntityID == nil || eventEntityID(event.Entity.ID) == eventEntityID(w.opts.EntityID)
+}
+
+// eventEntityID formats the ID of an Event's entity, which is decoded as a float64 for int IDs
+func eventEntityID(id any) string {
+	switch id := id.(type) {
+	case float64, float32:
+		return fmt.Sprintf("%.f", id)
+	case int:
+		return strconv.Itoa(id)
+	default:
+		return fmt.Sprintf("%v", id)
+	}
+}
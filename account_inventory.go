@@ -0,0 +1,174 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// defaultInventoryConcurrency is the number of concurrent requests made by GetAccountInventory
+// when InventoryOptions.Concurrency is not set.
+const defaultInventoryConcurrency = 4
+
+// InventoryOptions are the options accepted by GetAccountInventory
+type InventoryOptions struct {
+	// Concurrency is the maximum number of concurrent API requests; defaults to 4
+	Concurrency int
+}
+
+// InventoryCount is the number of entities of a given type on an account. ByRegion is
+// only populated for regional entities.
+type InventoryCount struct {
+	Total    int            `json:"total"`
+	ByRegion map[string]int `json:"by_region,omitempty"`
+}
+
+// AccountInventory summarizes the entities that exist on an account
+type AccountInventory struct {
+	Instances     InventoryCount `json:"instances"`
+	Volumes       InventoryCount `json:"volumes"`
+	VolumesSizeGB int            `json:"volumes_size_gb"`
+	NodeBalancers InventoryCount `json:"nodebalancers"`
+	Firewalls     InventoryCount `json:"firewalls"`
+	LKEClusters   InventoryCount `json:"lke_clusters"`
+	Images        InventoryCount `json:"images"`
+	ImagesSizeMB  int            `json:"images_size_mb"`
+	Domains       InventoryCount `json:"domains"`
+	ReservedIPs   InventoryCount `json:"reserved_ips"`
+}
+
+// GetAccountInventory gathers the number of Instances, Volumes, NodeBalancers, Firewalls,
+// LKE Clusters, private Images, Domains and reserved IPs on the account, with per-region
+// breakdowns for regional entities. Entities without a region are counted using the
+// results count of a single page rather than listing them in full.
+func (c *Client) GetAccountInventory(ctx context.Context, opts InventoryOptions) (*AccountInventory, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultInventoryConcurrency
+	}
+
+	var inventory AccountInventory
+
+	collectors := map[string]func() error{
+		"instances": func() error {
+			instances, err := c.ListInstances(ctx, nil)
+			for _, i := range instances {
+				inventory.Instances.add(i.Region)
+			}
+			return err
+		},
+		"volumes": func() error {
+			volumes, err := c.ListVolumes(ctx, nil)
+			for _, v := range volumes {
+				inventory.Volumes.add(v.Region)
+				inventory.VolumesSizeGB += v.Size
+			}
+			return err
+		},
+		"nodebalancers": func() error {
+			nodebalancers, err := c.ListNodeBalancers(ctx, nil)
+			for _, nb := range nodebalancers {
+				inventory.NodeBalancers.add(nb.Region)
+			}
+			return err
+		},
+		"lke clusters": func() error {
+			clusters, err := c.ListLKEClusters(ctx, nil)
+			for _, cluster := range clusters {
+				inventory.LKEClusters.add(cluster.Region)
+			}
+			return err
+		},
+		"images": func() error {
+			f := Filter{}
+			f.AddField(Eq, "is_public", false)
+
+			filter, err := f.MarshalJSON()
+			if err != nil {
+				return err
+			}
+
+			images, err := c.ListImages(ctx, NewListOptions(0, string(filter)))
+			for _, image := range images {
+				inventory.Images.Total++
+				inventory.ImagesSizeMB += image.Size
+			}
+			return err
+		},
+		"reserved ips": func() error {
+			ips, err := c.ListReservedIPAddresses(ctx, nil)
+			for _, ip := range ips {
+				inventory.ReservedIPs.add(ip.Region)
+			}
+			return err
+		},
+		"firewalls": func() (err error) {
+			inventory.Firewalls.Total, err = countResults(func(opts *ListOptions) error {
+				_, err := c.ListFirewalls(ctx, opts)
+				return err
+			})
+			return err
+		},
+		"domains": func() (err error) {
+			inventory.Domains.Total, err = countResults(func(opts *ListOptions) error {
+				_, err := c.ListDomains(ctx, opts)
+				return err
+			})
+			return err
+		},
+	}
+
+	sem := make(chan struct{}, concurrency)
+	errs := make([]error, 0)
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+
+	for name, collect := range collectors {
+		wg.Add(1)
+
+		go func(name string, collect func() error) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := collect(); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("failed to count %s: %w", name, err))
+				mu.Unlock()
+			}
+		}(name, collect)
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &inventory, nil
+}
+
+func (i *InventoryCount) add(region string) {
+	if i.ByRegion == nil {
+		i.ByRegion = make(map[string]int)
+	}
+
+	i.Total++
+	i.ByRegion[region]++
+}
+
+// countResults returns the total number of results of a list endpoint by
+// requesting only its first page.
+func countResults(list func(opts *ListOptions) error) (int, error) {
+	opts := NewListOptions(1, "")
+	if err := list(opts); err != nil {
+		return 0, err
+	}
+
+	return opts.Results, nil
+}
@@ -10,10 +10,11 @@ import (
 
 // Invoice structs reflect an invoice for billable activity on the account.
 type Invoice struct {
-	ID    int        `json:"id"`
-	Label string     `json:"label"`
-	Total float32    `json:"total"`
-	Date  *time.Time `json:"-"`
+	ID       int        `json:"id"`
+	Label    string     `json:"label"`
+	Subtotal float32    `json:"subtotal"`
+	Total    float32    `json:"total"`
+	Date     *time.Time `json:"-"`
 }
 
 // InvoiceItem structs reflect a single billable activity associate with an Invoice
@@ -0,0 +1,159 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// ErrNoActivePromotion is returned by PredictCreditExhaustion when the Account has no active promotions
+var ErrNoActivePromotion = errors.New("account has no active promotion")
+
+// Promotion is a promotional credit active on the Account. Amounts are in US dollars.
+type Promotion struct {
+	// CreditMonthlyCap is the amount of credit that can be applied each month
+	CreditMonthlyCap string `json:"credit_monthly_cap"`
+
+	// CreditRemaining is the total amount of credit remaining
+	CreditRemaining string `json:"credit_remaining"`
+
+	// ThisMonthCreditRemaining is the amount of credit remaining for the current month
+	ThisMonthCreditRemaining string `json:"this_month_credit_remaining"`
+
+	Description string `json:"description"`
+	ImageURL    string `json:"image_url"`
+	ServiceType string `json:"service_type"`
+	Summary     string `json:"summary"`
+
+	// ExpireDT is when the promotion and any remaining credit expire
+	ExpireDT *time.Time `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (p *Promotion) UnmarshalJSON(b []byte) error {
+	type Mask Promotion
+
+	l := struct {
+		*Mask
+		ExpireDT *parseabletime.ParseableTime `json:"expire_dt"`
+	}{
+		Mask: (*Mask)(p),
+	}
+
+	if err := json.Unmarshal(b, &l); err != nil {
+		return err
+	}
+
+	p.ExpireDT = (*time.Time)(l.ExpireDT)
+
+	return nil
+}
+
+// PredictCreditExhaustion estimates when the Account's promotional credit will run out, given the
+// current time. Spend is assumed to continue at the rate of the uninvoiced balance accrued since
+// the most recent Invoice or, if nothing has accrued yet, at the rate of the most recent Invoice.
+// The estimate is no later than the expiry of the last active promotion, as any remaining credit
+// lapses then. Monthly credit caps are not taken into account.
+//
+// ErrNoActivePromotion is returned if the Account has no active promotions.
+func (c *Client) PredictCreditExhaustion(ctx context.Context, now time.Time) (time.Time, error) {
+	account, err := c.GetAccount(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if len(account.ActivePromotions) == 0 {
+		return time.Time{}, ErrNoActivePromotion
+	}
+
+	var (
+		remaining float64
+		expiry    time.Time
+	)
+
+	for _, promotion := range account.ActivePromotions {
+		credit, err := strconv.ParseFloat(promotion.CreditRemaining, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to parse remaining credit %q of promotion %q: %w", promotion.CreditRemaining, promotion.Summary, err)
+		}
+
+		remaining += credit
+
+		if promotion.ExpireDT != nil && promotion.ExpireDT.After(expiry) {
+			expiry = *promotion.ExpireDT
+		}
+	}
+
+	// Spend per hour
+	rate, err := c.creditBurnRate(ctx, account, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if rate <= 0 {
+		if expiry.IsZero() {
+			return time.Time{}, fmt.Errorf("account has no spend to predict credit exhaustion from")
+		}
+
+		return expiry, nil
+	}
+
+	exhaustion := now.Add(time.Duration(remaining / rate * float64(time.Hour)).Round(time.Second))
+
+	if !expiry.IsZero() && expiry.Before(exhaustion) {
+		return expiry, nil
+	}
+
+	return exhaustion, nil
+}
+
+// creditBurnRate returns the Account's spend per hour, based on its uninvoiced balance
+// or its most recent Invoice
+func (c *Client) creditBurnRate(ctx context.Context, account *Account, now time.Time) (float64, error) {
+	f := Filter{
+		Order:   Descending,
+		OrderBy: "date",
+	}
+
+	fBytes, err := f.MarshalJSON()
+	if err != nil {
+		return 0, err
+	}
+
+	invoices, err := c.ListInvoices(ctx, NewListOptions(1, string(fBytes)))
+	if err != nil {
+		return 0, err
+	}
+
+	// The current billing period started with the most recent Invoice, or when the Account was opened
+	periodStart := account.ActiveSince
+	if len(invoices) > 0 {
+		periodStart = invoices[0].Date
+	}
+
+	if account.BalanceUninvoiced > 0 && periodStart != nil && now.After(*periodStart) {
+		return float64(account.BalanceUninvoiced) / now.Sub(*periodStart).Hours(), nil
+	}
+
+	if len(invoices) == 0 || invoices[0].Date == nil {
+		return 0, nil
+	}
+
+	// The most recent Invoice covers the period since the previous Invoice, or since the Account was opened
+	previous := account.ActiveSince
+	if len(invoices) > 1 {
+		previous = invoices[1].Date
+	}
+
+	if previous == nil || !invoices[0].Date.After(*previous) {
+		return 0, nil
+	}
+
+	// The subtotal is the spend before promotional credit, which the total has already been reduced by
+	return float64(invoices[0].Subtotal) / invoices[0].Date.Sub(*previous).Hours(), nil
+}
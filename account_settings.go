@@ -20,6 +20,10 @@ type AccountSettings struct {
 
 	// A string like "disabled", "suspended", or "active" describing the status of this account’s Object Storage service enrollment.
 	ObjectStorage *string `json:"object_storage"`
+
+	// The default maintenance policy for all new Linodes on the account.
+	// NOTE: Maintenance policies may not currently be available to all users.
+	MaintenancePolicy InstanceMaintenancePolicy `json:"maintenance_policy"`
 }
 
 // AccountSettingsUpdateOptions are the updateable account wide flags or plans that effect new resources.
@@ -33,6 +37,10 @@ type AccountSettingsUpdateOptions struct {
 
 	// The default network helper setting for all new Linodes and Linode Configs for all users on the account.
 	NetworkHelper *bool `json:"network_helper,omitempty"`
+
+	// The default maintenance policy for all new Linodes on the account.
+	// NOTE: Maintenance policies may not currently be available to all users.
+	MaintenancePolicy *InstanceMaintenancePolicy `json:"maintenance_policy,omitempty"`
 }
 
 // GetAccountSettings gets the account wide flags or plans that effect new resources
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -156,3 +157,29 @@ func (c *Client) DeleteUser(ctx context.Context, userID string) error {
 	err := doDELETERequest(ctx, c, e)
 	return err
 }
+
+// CheckAuthorizedUsers verifies that each of the given usernames belongs to a User on the
+// account with at least one SSH key, so that an Instance created or rebuilt with them as
+// AuthorizedUsers can be logged in to. A warning is returned for each username failing these
+// checks; an error is only returned if the Users could not be retrieved.
+func (c *Client) CheckAuthorizedUsers(ctx context.Context, usernames []string) ([]string, error) {
+	warnings := make([]string, 0)
+
+	for _, username := range usernames {
+		user, err := c.GetUser(ctx, username)
+		if IsNotFound(err) {
+			warnings = append(warnings, fmt.Sprintf("user %s does not exist", username))
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(user.SSHKeys) == 0 {
+			warnings = append(warnings, fmt.Sprintf("user %s has no SSH keys", username))
+		}
+	}
+
+	return warnings, nil
+}
@@ -0,0 +1,252 @@
+package linodego
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ErrCircuitOpen is returned for requests made while the client's circuit breaker is open.
+// It can be matched using errors.Is.
+var ErrCircuitOpen = &Error{Code: ErrorFromCircuitBreaker, Message: "circuit breaker is open"}
+
+// CircuitBreakerState is the state of a client's circuit breaker
+type CircuitBreakerState string
+
+// CircuitBreakerState enums
+const (
+	CircuitClosed   CircuitBreakerState = "closed"
+	CircuitOpen     CircuitBreakerState = "open"
+	CircuitHalfOpen CircuitBreakerState = "half_open"
+)
+
+const (
+	defaultCircuitBreakerWindow   = time.Minute
+	defaultCircuitBreakerCoolDown = 30 * time.Second
+)
+
+// CircuitBreakerOptions configure the circuit breaker set using Client.SetCircuitBreaker
+type CircuitBreakerOptions struct {
+	// FailureThreshold is the number of consecutive failed attempts, across all requests,
+	// that opens the circuit; 0 disables the circuit breaker
+	FailureThreshold int
+
+	// Window is the period in which failures must occur to be counted as consecutive;
+	// defaults to 1 minute
+	Window time.Duration
+
+	// CoolDown is how long the circuit stays open before allowing probe requests;
+	// defaults to 30 seconds
+	CoolDown time.Duration
+
+	// HalfOpenProbes is the number of probe requests allowed while the circuit is half-open,
+	// all of which must succeed to close the circuit; defaults to 1
+	HalfOpenProbes int
+
+	// OnStateChange, if set, is called whenever the circuit changes state
+	OnStateChange func(from, to CircuitBreakerState)
+}
+
+// SetCircuitBreaker configures a circuit breaker to protect the API during incidents. Once
+// FailureThreshold consecutive attempts, including retries, have failed with a 5xx response, a
+// retryable error or a transport error, the circuit opens and requests fail fast with ErrCircuitOpen, including any retries in progress.
+// After CoolDown the circuit is half-open, allowing HalfOpenProbes requests through: if they
+// succeed the circuit closes, otherwise it opens again. Disabled by default.
+func (c *Client) SetCircuitBreaker(opts CircuitBreakerOptions) *Client {
+	c.circuitBreaker.configure(opts)
+	return c
+}
+
+// circuitBreaker is shared by all copies of a Client, as its hooks are registered on the resty client
+type circuitBreaker struct {
+	mu   sync.Mutex
+	opts CircuitBreakerOptions
+
+	state     CircuitBreakerState
+	changedAt time.Time
+
+	// failures is the number of consecutive failures while closed, the first of which was at firstFailure
+	failures     int
+	firstFailure time.Time
+
+	// probes and successes are the number of requests allowed and succeeded while half-open
+	probes    int
+	successes int
+}
+
+// newCircuitBreaker returns the circuit breaker of c, registering the hooks that check it before
+// each attempt and record the outcome of each attempt. Transport errors of attempts being retried
+// are recorded by checkRetryConditionals, as only the last error of a request is passed to OnError.
+func newCircuitBreaker(c *Client) *circuitBreaker {
+	b := &circuitBreaker{state: CircuitClosed}
+
+	c.resty.OnBeforeRequest(func(_ *resty.Client, _ *resty.Request) error {
+		return b.allow()
+	})
+
+	c.resty.OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+		b.record(r.StatusCode() >= http.StatusInternalServerError || c.isRetryable(r, nil))
+		return nil
+	})
+
+	c.resty.OnError(func(_ *resty.Request, err error) {
+		// Retry conditions are only checked, and so transport errors recorded, if retries are enabled
+		var respErr *resty.ResponseError
+		if c.resty.RetryCount == 0 && errors.As(err, &respErr) && respErr.Response.RawResponse == nil {
+			b.record(true)
+		}
+	})
+
+	return b
+}
+
+func (b *circuitBreaker) configure(opts CircuitBreakerOptions) {
+	if opts.Window <= 0 {
+		opts.Window = defaultCircuitBreakerWindow
+	}
+
+	if opts.CoolDown <= 0 {
+		opts.CoolDown = defaultCircuitBreakerCoolDown
+	}
+
+	if opts.HalfOpenProbes <= 0 {
+		opts.HalfOpenProbes = 1
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.opts = opts
+	b.setState(CircuitClosed)
+}
+
+func (b *circuitBreaker) options() CircuitBreakerOptions {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.opts
+}
+
+// allow returns ErrCircuitOpen if a request should not be sent.
+func (b *circuitBreaker) allow() error {
+	var notify func()
+
+	// Callbacks are made once the lock is released, so that they may use the client
+	defer func() {
+		if notify != nil {
+			notify()
+		}
+	}()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.opts.FailureThreshold <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	switch b.state {
+	case CircuitClosed:
+		return nil
+	case CircuitOpen:
+		if now.Sub(b.changedAt) < b.opts.CoolDown {
+			return ErrCircuitOpen
+		}
+
+		notify = b.setState(CircuitHalfOpen)
+	case CircuitHalfOpen:
+		if b.probes >= b.opts.HalfOpenProbes {
+			// Probes which never completed, e.g. as their context was cancelled,
+			// are given up on after a further cool-down
+			if now.Sub(b.changedAt) < b.opts.CoolDown {
+				return ErrCircuitOpen
+			}
+
+			b.changedAt = now
+			b.probes = 0
+			b.successes = 0
+		}
+	}
+
+	b.probes++
+
+	return nil
+}
+
+// record records the outcome of a request that was sent.
+func (b *circuitBreaker) record(failed bool) {
+	var notify func()
+
+	defer func() {
+		if notify != nil {
+			notify()
+		}
+	}()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.opts.FailureThreshold <= 0 {
+		return
+	}
+
+	now := time.Now()
+
+	switch b.state {
+	case CircuitClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+
+		if b.failures == 0 || now.Sub(b.firstFailure) > b.opts.Window {
+			b.failures = 0
+			b.firstFailure = now
+		}
+
+		b.failures++
+
+		if b.failures >= b.opts.FailureThreshold {
+			notify = b.setState(CircuitOpen)
+		}
+	case CircuitHalfOpen:
+		if failed {
+			notify = b.setState(CircuitOpen)
+			return
+		}
+
+		b.successes++
+
+		if b.successes >= b.opts.HalfOpenProbes {
+			notify = b.setState(CircuitClosed)
+		}
+	case CircuitOpen:
+		// Outcomes of requests sent before the circuit opened are ignored
+	}
+}
+
+// setState transitions the circuit breaker, returning a function making the
+// OnStateChange callback if required. b.mu must be held.
+func (b *circuitBreaker) setState(to CircuitBreakerState) func() {
+	from := b.state
+
+	b.state = to
+	b.changedAt = time.Now()
+	b.failures = 0
+	b.probes = 0
+	b.successes = 0
+
+	callback := b.opts.OnStateChange
+	if from == to || callback == nil {
+		return nil
+	}
+
+	return func() {
+		callback(from, to)
+	}
+}
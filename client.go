@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"text/template"
 	"time"
 
@@ -70,18 +71,35 @@ type Client struct {
 	resty             *resty.Client
 	userAgent         string
 	debug             bool
+	strictDecoding    bool
+	strictValidation  bool
 	retryConditionals []RetryConditional
 
+	payloadLimits PayloadLimits
+
+	circuitBreaker *circuitBreaker
+	metrics        *metricsObserver
+	responseLimits *responseLimits
+	rateLimits     *rateLimits
+	deprecations   *deprecations
+	getFlights     *getFlights
+
+	retryNonIdempotent *atomic.Bool
+
 	pollInterval time.Duration
 
 	baseURL         string
 	apiVersion      string
 	apiProto        string
+	monitorURL      string
 	selectedProfile string
 	loadedProfile   string
 
 	configProfiles map[string]ConfigProfile
 
+	// childToken is set on clients derived using UseChildAccount(...)
+	childToken *childAccountTokenSource
+
 	// Fields for caching endpoint responses
 	shouldCache     bool
 	cacheExpiration time.Duration
@@ -377,6 +395,45 @@ func (c *Client) SetDebug(debug bool) *Client {
 	return c
 }
 
+// SetStrictDecoding configures whether API responses containing fields that are not
+// modeled by the client should cause an error. This is useful for detecting when the
+// API has added fields that linodego does not yet support. Defaults to false.
+// NOTE: Fields consumed by types implementing json.Unmarshaler (e.g. those with
+// timestamp fields) are decoded leniently regardless of this setting.
+func (c *Client) SetStrictDecoding(strict bool) *Client {
+	c.strictDecoding = strict
+
+	if strict {
+		c.resty.SetJSONUnmarshaler(strictJSONUnmarshal)
+	} else {
+		c.resty.SetJSONUnmarshaler(json.Unmarshal)
+	}
+
+	return c
+}
+
+// SetStrictValidation configures whether requests are validated against the known limits
+// of the client's API version before being sent, rather than leaving validation to the API.
+// Some checks, such as the Image compatibility check made by RebuildInstance, require
+// additional requests. Defaults to false.
+func (c *Client) SetStrictValidation(strict bool) *Client {
+	c.strictValidation = strict
+	return c
+}
+
+// strictJSONUnmarshal decodes data into v, disallowing unknown fields.
+// API error responses are always decoded leniently so they are never masked.
+func strictJSONUnmarshal(data []byte, v any) error {
+	if _, ok := v.(*APIError); ok {
+		return json.Unmarshal(data, v)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(v)
+}
+
 // SetLogger allows the user to override the output
 // logger for debug logs.
 func (c *Client) SetLogger(logger Logger) *Client {
@@ -470,7 +527,8 @@ func (c *Client) SetBaseURL(baseURL string) *Client {
 	return c
 }
 
-// SetAPIVersion sets the version of the API to interface with
+// SetAPIVersion sets the version of the API to interface with, e.g. "v4beta".
+// Every request made by the client, including those to beta-only endpoints, uses this version.
 func (c *Client) SetAPIVersion(apiVersion string) *Client {
 	c.apiVersion = apiVersion
 
@@ -479,19 +537,24 @@ func (c *Client) SetAPIVersion(apiVersion string) *Client {
 	return c
 }
 
+// GetAPIVersion gets the version of the API the client interfaces with
+func (c *Client) GetAPIVersion() string {
+	if c.apiVersion == "" {
+		return APIVersion
+	}
+
+	return c.apiVersion
+}
+
 func (c *Client) updateHostURL() {
 	apiProto := APIProto
 	baseURL := APIHost
-	apiVersion := APIVersion
+	apiVersion := c.GetAPIVersion()
 
 	if c.baseURL != "" {
 		baseURL = c.baseURL
 	}
 
-	if c.apiVersion != "" {
-		apiVersion = c.apiVersion
-	}
-
 	if c.apiProto != "" {
 		apiProto = c.apiProto
 	}
@@ -508,7 +571,10 @@ func (c *Client) updateHostURL() {
 
 // SetRootCertificate adds a root certificate to the underlying TLS client config
 func (c *Client) SetRootCertificate(path string) *Client {
-	c.resty.SetRootCertificate(path)
+	c.withBaseTransport(func(rc *resty.Client) {
+		rc.SetRootCertificate(path)
+	})
+
 	return c
 }
 
@@ -520,6 +586,8 @@ func (c *Client) SetToken(token string) *Client {
 }
 
 // SetRetries adds retry conditions for "Linode Busy." errors and 429s.
+// 502 and 504 responses and transient network errors are only retried for requests
+// using an idempotent method, unless enabled using SetRetryNonIdempotent.
 func (c *Client) SetRetries() *Client {
 	c.
 		addRetryConditional(linodeBusyRetryCondition).
@@ -528,11 +596,47 @@ func (c *Client) SetRetries() *Client {
 		addRetryConditional(requestTimeoutRetryCondition).
 		addRetryConditional(requestGOAWAYRetryCondition).
 		addRetryConditional(requestNGINXRetryCondition).
+		addRetryConditional(idempotentRetryCondition(c.retryNonIdempotent, badGatewayRetryCondition)).
+		addRetryConditional(idempotentRetryCondition(c.retryNonIdempotent, transientNetworkRetryCondition)).
 		SetRetryMaxWaitTime(APIRetryMaxWaitTime)
 	configureRetries(c)
 	return c
 }
 
+// SetRetryNonIdempotent configures whether requests using a non-idempotent method, i.e. POST,
+// are retried following a 502 or 504 response or a transient network error, in which case the
+// API may have already processed the request. Defaults to false.
+func (c *Client) SetRetryNonIdempotent(retry bool) *Client {
+	c.retryNonIdempotent.Store(retry)
+	return c
+}
+
+// RetryAttempt describes a failed request which is about to be retried
+type RetryAttempt struct {
+	// Attempt is the number of the attempt which failed, starting from 1
+	Attempt int
+
+	// Response is the response to the failed attempt, if any
+	Response *Response
+
+	// Err is the error which caused the attempt to fail, if any
+	Err error
+}
+
+// OnRetry adds a handler to run whenever a request is about to be retried, e.g. to log attempts
+func (c *Client) OnRetry(m func(attempt RetryAttempt)) *Client {
+	c.resty.AddRetryHook(func(r *resty.Response, err error) {
+		attempt := RetryAttempt{Response: r, Err: err}
+		if r != nil && r.Request != nil {
+			attempt.Attempt = r.Request.Attempt
+		}
+
+		m(attempt)
+	})
+
+	return c
+}
+
 // AddRetryCondition adds a RetryConditional function to the Client
 func (c *Client) AddRetryCondition(retryCondition RetryConditional) *Client {
 	c.resty.AddRetryCondition(resty.RetryConditionFunc(retryCondition))
@@ -711,7 +815,9 @@ func (c *Client) enableLogSanitization() *Client {
 // NewClient factory to create new Client struct
 func NewClient(hc *http.Client) (client Client) {
 	if hc != nil {
-		client.resty = resty.NewWithClient(hc)
+		// Copy hc, as the client wraps the transport of its http.Client
+		hcCopy := *hc
+		client.resty = resty.NewWithClient(&hcCopy)
 	} else {
 		client.resty = resty.New()
 	}
@@ -722,6 +828,16 @@ func NewClient(hc *http.Client) (client Client) {
 	client.cachedEntryLock = &sync.RWMutex{}
 
 	client.SetUserAgent(DefaultUserAgent)
+	client.SetPayloadLimits(DefaultPayloadLimits)
+
+	client.circuitBreaker = newCircuitBreaker(&client)
+
+	client.metrics = newMetricsObserver(client.resty)
+	client.responseLimits = newResponseLimits(client.resty)
+	client.rateLimits = newRateLimits(client.resty)
+	client.deprecations = newDeprecations(client.resty)
+	client.getFlights = newGETFlights()
+	client.retryNonIdempotent = &atomic.Bool{}
 
 	baseURL, baseURLExists := os.LookupEnv(APIHostVar)
 
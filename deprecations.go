@@ -0,0 +1,142 @@
+package linodego
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	deprecationHeaderName = "Deprecation"
+	sunsetHeaderName      = "Sunset"
+	warningHeaderName     = "Warning"
+)
+
+// DeprecationNotice describes the deprecation of an endpoint, as signalled by the
+// Deprecation, Sunset and Warning headers of its responses
+type DeprecationNotice struct {
+	// Endpoint is the method and path of the request with IDs replaced by "{id}",
+	// e.g. "GET /linode/instances/{id}"
+	Endpoint string
+
+	// DeprecatedAt is the date from the Deprecation header, if it included one
+	DeprecatedAt *time.Time
+
+	// Sunset is the date from the Sunset header after which the endpoint may stop responding, if any
+	Sunset *time.Time
+
+	// Warnings are the values of any Warning headers
+	Warnings []string
+}
+
+// OnDeprecation adds a handler to run whenever a response signals that its endpoint is deprecated
+func (c *Client) OnDeprecation(m func(notice DeprecationNotice)) *Client {
+	c.deprecations.mu.Lock()
+	defer c.deprecations.mu.Unlock()
+
+	c.deprecations.hooks = append(c.deprecations.hooks, m)
+
+	return c
+}
+
+// DeprecationsSeen returns the latest DeprecationNotice of each deprecated endpoint the client
+// has received a response from, ordered by endpoint
+func (c *Client) DeprecationsSeen() []DeprecationNotice {
+	c.deprecations.mu.RLock()
+	defer c.deprecations.mu.RUnlock()
+
+	result := make([]DeprecationNotice, 0, len(c.deprecations.seen))
+	for _, notice := range c.deprecations.seen {
+		result = append(result, notice)
+	}
+
+	slices.SortFunc(result, func(a, b DeprecationNotice) int {
+		return strings.Compare(a.Endpoint, b.Endpoint)
+	})
+
+	return result
+}
+
+// deprecations is shared by all copies of a Client, as its hooks are registered on the resty client
+type deprecations struct {
+	mu    sync.RWMutex
+	seen  map[string]DeprecationNotice
+	hooks []func(DeprecationNotice)
+}
+
+func newDeprecations(rc *resty.Client) *deprecations {
+	d := &deprecations{seen: make(map[string]DeprecationNotice)}
+
+	rc.OnAfterResponse(func(rc *resty.Client, r *resty.Response) error {
+		if r == nil || r.Request == nil {
+			return nil
+		}
+
+		if notice, ok := parseDeprecationHeaders(r.Header()); ok {
+			notice.Endpoint = r.Request.Method + " " + endpointTemplate(rc.BaseURL, r.Request.URL)
+			d.record(notice)
+		}
+
+		return nil
+	})
+
+	return d
+}
+
+func (d *deprecations) record(notice DeprecationNotice) {
+	d.mu.Lock()
+	d.seen[notice.Endpoint] = notice
+	hooks := d.hooks
+	d.mu.Unlock()
+
+	for _, hook := range hooks {
+		hook(notice)
+	}
+}
+
+// parseDeprecationHeaders returns the DeprecationNotice signalled by a response's
+// headers, and whether any deprecation headers were present
+func parseDeprecationHeaders(header http.Header) (notice DeprecationNotice, ok bool) {
+	if value := header.Get(deprecationHeaderName); value != "" {
+		notice.DeprecatedAt = parseDeprecationDate(value)
+		ok = true
+	}
+
+	if value := header.Get(sunsetHeaderName); value != "" {
+		if sunset, err := http.ParseTime(value); err == nil {
+			notice.Sunset = &sunset
+		}
+
+		ok = true
+	}
+
+	if warnings := header.Values(warningHeaderName); len(warnings) > 0 {
+		notice.Warnings = warnings
+		ok = true
+	}
+
+	return notice, ok
+}
+
+// parseDeprecationDate parses the value of a Deprecation header, which is either a Unix
+// timestamp such as "@1688169599" (RFC 9745), an HTTP date, or "true" if no date is given
+func parseDeprecationDate(value string) *time.Time {
+	if seconds, found := strings.CutPrefix(value, "@"); found {
+		if unix, err := strconv.ParseInt(seconds, 10, 64); err == nil {
+			return Pointer(time.Unix(unix, 0).UTC())
+		}
+
+		return nil
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return &date
+	}
+
+	return nil
+}
@@ -0,0 +1,5 @@
+package linodego
+
+// String, IsValid and ParseX helpers for the package's enum types are generated
+// into enums_gen.go. Re-run this after adding a new enum type or value.
+//go:generate go run ./internal/enumgen
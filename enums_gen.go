@@ -0,0 +1,1580 @@
+// Code generated by internal/enumgen; DO NOT EDIT.
+
+package linodego
+
+import "fmt"
+
+// String returns the string representation of the APIErrorCode.
+func (v APIErrorCode) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the APIErrorCode is one of its known values.
+func (v APIErrorCode) IsValid() bool {
+	switch v {
+	case APIErrorCodeUnknown, APIErrorCodeInvalidRequest, APIErrorCodeNotFound, APIErrorCodePermissionDenied, APIErrorCodeRateLimited, APIErrorCodeBusy, APIErrorCodeQuotaExceeded, APIErrorCodeRegionCapacity, APIErrorCodeInvalidPlan, APIErrorCodeInvalidAddress, APIErrorCodeAddressAssigned, APIErrorCodeAddressNotOwned, APIErrorCodeWrongRegion, APIErrorCodeServerError:
+		return true
+	}
+
+	return false
+}
+
+// ParseAPIErrorCode converts s to a APIErrorCode, returning an error if it is not a known value.
+func ParseAPIErrorCode(s string) (APIErrorCode, error) {
+	v := APIErrorCode(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid APIErrorCode %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the AlertDefinitionStatus.
+func (v AlertDefinitionStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the AlertDefinitionStatus is one of its known values.
+func (v AlertDefinitionStatus) IsValid() bool {
+	switch v {
+	case AlertDefinitionStatusEnabled, AlertDefinitionStatusDisabled, AlertDefinitionStatusInProgress, AlertDefinitionStatusFailed:
+		return true
+	}
+
+	return false
+}
+
+// ParseAlertDefinitionStatus converts s to a AlertDefinitionStatus, returning an error if it is not a known value.
+func ParseAlertDefinitionStatus(s string) (AlertDefinitionStatus, error) {
+	v := AlertDefinitionStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid AlertDefinitionStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the AlertDefinitionType.
+func (v AlertDefinitionType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the AlertDefinitionType is one of its known values.
+func (v AlertDefinitionType) IsValid() bool {
+	switch v {
+	case AlertDefinitionTypeUser, AlertDefinitionTypeSystem:
+		return true
+	}
+
+	return false
+}
+
+// ParseAlertDefinitionType converts s to a AlertDefinitionType, returning an error if it is not a known value.
+func ParseAlertDefinitionType(s string) (AlertDefinitionType, error) {
+	v := AlertDefinitionType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid AlertDefinitionType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the AlertRuleOperator.
+func (v AlertRuleOperator) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the AlertRuleOperator is one of its known values.
+func (v AlertRuleOperator) IsValid() bool {
+	switch v {
+	case AlertRuleOperatorEqual, AlertRuleOperatorGreaterThan, AlertRuleOperatorGreaterThanOrEqual, AlertRuleOperatorLessThan, AlertRuleOperatorLessThanOrEqual:
+		return true
+	}
+
+	return false
+}
+
+// ParseAlertRuleOperator converts s to a AlertRuleOperator, returning an error if it is not a known value.
+func ParseAlertRuleOperator(s string) (AlertRuleOperator, error) {
+	v := AlertRuleOperator(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid AlertRuleOperator %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the CircuitBreakerState.
+func (v CircuitBreakerState) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the CircuitBreakerState is one of its known values.
+func (v CircuitBreakerState) IsValid() bool {
+	switch v {
+	case CircuitClosed, CircuitOpen, CircuitHalfOpen:
+		return true
+	}
+
+	return false
+}
+
+// ParseCircuitBreakerState converts s to a CircuitBreakerState, returning an error if it is not a known value.
+func ParseCircuitBreakerState(s string) (CircuitBreakerState, error) {
+	v := CircuitBreakerState(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CircuitBreakerState %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the ConfigAlgorithm.
+func (v ConfigAlgorithm) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the ConfigAlgorithm is one of its known values.
+func (v ConfigAlgorithm) IsValid() bool {
+	switch v {
+	case AlgorithmRoundRobin, AlgorithmLeastConn, AlgorithmSource:
+		return true
+	}
+
+	return false
+}
+
+// ParseConfigAlgorithm converts s to a ConfigAlgorithm, returning an error if it is not a known value.
+func ParseConfigAlgorithm(s string) (ConfigAlgorithm, error) {
+	v := ConfigAlgorithm(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ConfigAlgorithm %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the ConfigCheck.
+func (v ConfigCheck) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the ConfigCheck is one of its known values.
+func (v ConfigCheck) IsValid() bool {
+	switch v {
+	case CheckNone, CheckConnection, CheckHTTP, CheckHTTPBody:
+		return true
+	}
+
+	return false
+}
+
+// ParseConfigCheck converts s to a ConfigCheck, returning an error if it is not a known value.
+func ParseConfigCheck(s string) (ConfigCheck, error) {
+	v := ConfigCheck(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ConfigCheck %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the ConfigCipher.
+func (v ConfigCipher) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the ConfigCipher is one of its known values.
+func (v ConfigCipher) IsValid() bool {
+	switch v {
+	case CipherRecommended, CipherLegacy:
+		return true
+	}
+
+	return false
+}
+
+// ParseConfigCipher converts s to a ConfigCipher, returning an error if it is not a known value.
+func ParseConfigCipher(s string) (ConfigCipher, error) {
+	v := ConfigCipher(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ConfigCipher %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the ConfigInterfacePurpose.
+func (v ConfigInterfacePurpose) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the ConfigInterfacePurpose is one of its known values.
+func (v ConfigInterfacePurpose) IsValid() bool {
+	switch v {
+	case InterfacePurposePublic, InterfacePurposeVLAN, InterfacePurposeVPC:
+		return true
+	}
+
+	return false
+}
+
+// ParseConfigInterfacePurpose converts s to a ConfigInterfacePurpose, returning an error if it is not a known value.
+func ParseConfigInterfacePurpose(s string) (ConfigInterfacePurpose, error) {
+	v := ConfigInterfacePurpose(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ConfigInterfacePurpose %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the ConfigProtocol.
+func (v ConfigProtocol) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the ConfigProtocol is one of its known values.
+func (v ConfigProtocol) IsValid() bool {
+	switch v {
+	case ProtocolHTTP, ProtocolHTTPS, ProtocolTCP:
+		return true
+	}
+
+	return false
+}
+
+// ParseConfigProtocol converts s to a ConfigProtocol, returning an error if it is not a known value.
+func ParseConfigProtocol(s string) (ConfigProtocol, error) {
+	v := ConfigProtocol(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ConfigProtocol %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the ConfigProxyProtocol.
+func (v ConfigProxyProtocol) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the ConfigProxyProtocol is one of its known values.
+func (v ConfigProxyProtocol) IsValid() bool {
+	switch v {
+	case ProxyProtocolNone, ProxyProtocolV1, ProxyProtocolV2:
+		return true
+	}
+
+	return false
+}
+
+// ParseConfigProxyProtocol converts s to a ConfigProxyProtocol, returning an error if it is not a known value.
+func ParseConfigProxyProtocol(s string) (ConfigProxyProtocol, error) {
+	v := ConfigProxyProtocol(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ConfigProxyProtocol %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the ConfigStickiness.
+func (v ConfigStickiness) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the ConfigStickiness is one of its known values.
+func (v ConfigStickiness) IsValid() bool {
+	switch v {
+	case StickinessNone, StickinessTable, StickinessHTTPCookie:
+		return true
+	}
+
+	return false
+}
+
+// ParseConfigStickiness converts s to a ConfigStickiness, returning an error if it is not a known value.
+func ParseConfigStickiness(s string) (ConfigStickiness, error) {
+	v := ConfigStickiness(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ConfigStickiness %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the DatabaseEngineType.
+func (v DatabaseEngineType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the DatabaseEngineType is one of its known values.
+func (v DatabaseEngineType) IsValid() bool {
+	switch v {
+	case DatabaseEngineTypeMySQL, DatabaseEngineTypePostgres:
+		return true
+	}
+
+	return false
+}
+
+// ParseDatabaseEngineType converts s to a DatabaseEngineType, returning an error if it is not a known value.
+func ParseDatabaseEngineType(s string) (DatabaseEngineType, error) {
+	v := DatabaseEngineType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid DatabaseEngineType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the DatabaseMaintenanceFrequency.
+func (v DatabaseMaintenanceFrequency) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the DatabaseMaintenanceFrequency is one of its known values.
+func (v DatabaseMaintenanceFrequency) IsValid() bool {
+	switch v {
+	case DatabaseMaintenanceFrequencyWeekly, DatabaseMaintenanceFrequencyMonthly:
+		return true
+	}
+
+	return false
+}
+
+// ParseDatabaseMaintenanceFrequency converts s to a DatabaseMaintenanceFrequency, returning an error if it is not a known value.
+func ParseDatabaseMaintenanceFrequency(s string) (DatabaseMaintenanceFrequency, error) {
+	v := DatabaseMaintenanceFrequency(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid DatabaseMaintenanceFrequency %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the DatabaseStatus.
+func (v DatabaseStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the DatabaseStatus is one of its known values.
+func (v DatabaseStatus) IsValid() bool {
+	switch v {
+	case DatabaseStatusProvisioning, DatabaseStatusActive, DatabaseStatusDeleting, DatabaseStatusDeleted, DatabaseStatusSuspending, DatabaseStatusSuspended, DatabaseStatusResuming, DatabaseStatusRestoring, DatabaseStatusFailed, DatabaseStatusDegraded, DatabaseStatusUpdating, DatabaseStatusBackingUp:
+		return true
+	}
+
+	return false
+}
+
+// ParseDatabaseStatus converts s to a DatabaseStatus, returning an error if it is not a known value.
+func ParseDatabaseStatus(s string) (DatabaseStatus, error) {
+	v := DatabaseStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid DatabaseStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the DiskFilesystem.
+func (v DiskFilesystem) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the DiskFilesystem is one of its known values.
+func (v DiskFilesystem) IsValid() bool {
+	switch v {
+	case FilesystemRaw, FilesystemSwap, FilesystemExt3, FilesystemExt4, FilesystemInitrd:
+		return true
+	}
+
+	return false
+}
+
+// ParseDiskFilesystem converts s to a DiskFilesystem, returning an error if it is not a known value.
+func ParseDiskFilesystem(s string) (DiskFilesystem, error) {
+	v := DiskFilesystem(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid DiskFilesystem %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the DiskStatus.
+func (v DiskStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the DiskStatus is one of its known values.
+func (v DiskStatus) IsValid() bool {
+	switch v {
+	case DiskReady, DiskNotReady, DiskDeleting:
+		return true
+	}
+
+	return false
+}
+
+// ParseDiskStatus converts s to a DiskStatus, returning an error if it is not a known value.
+func ParseDiskStatus(s string) (DiskStatus, error) {
+	v := DiskStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid DiskStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the DomainRecordType.
+func (v DomainRecordType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the DomainRecordType is one of its known values.
+func (v DomainRecordType) IsValid() bool {
+	switch v {
+	case RecordTypeA, RecordTypeAAAA, RecordTypeNS, RecordTypeMX, RecordTypeCNAME, RecordTypeTXT, RecordTypeSRV, RecordTypePTR, RecordTypeCAA:
+		return true
+	}
+
+	return false
+}
+
+// ParseDomainRecordType converts s to a DomainRecordType, returning an error if it is not a known value.
+func ParseDomainRecordType(s string) (DomainRecordType, error) {
+	v := DomainRecordType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid DomainRecordType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the DomainStatus.
+func (v DomainStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the DomainStatus is one of its known values.
+func (v DomainStatus) IsValid() bool {
+	switch v {
+	case DomainStatusDisabled, DomainStatusActive, DomainStatusEditMode, DomainStatusHasErrors:
+		return true
+	}
+
+	return false
+}
+
+// ParseDomainStatus converts s to a DomainStatus, returning an error if it is not a known value.
+func ParseDomainStatus(s string) (DomainStatus, error) {
+	v := DomainStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid DomainStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the DomainType.
+func (v DomainType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the DomainType is one of its known values.
+func (v DomainType) IsValid() bool {
+	switch v {
+	case DomainTypeMaster, DomainTypeSlave:
+		return true
+	}
+
+	return false
+}
+
+// ParseDomainType converts s to a DomainType, returning an error if it is not a known value.
+func ParseDomainType(s string) (DomainType, error) {
+	v := DomainType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid DomainType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the EntityType.
+func (v EntityType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the EntityType is one of its known values.
+func (v EntityType) IsValid() bool {
+	switch v {
+	case EntityAccount, EntityBackups, EntityCommunity, EntityDatabase, EntityDisk, EntityDomain, EntityTransfer, EntityFirewall, EntityImage, EntityIPAddress, EntityLinode, EntityLongview, EntityManagedService, EntityNodebalancer, EntityOAuthClient, EntityPlacementGroup, EntityProfile, EntityStackscript, EntityTag, EntityTicket, EntityToken, EntityUser, EntityUserSSHKey, EntityVolume, EntityVPC, EntityVPCSubnet:
+		return true
+	}
+
+	return false
+}
+
+// ParseEntityType converts s to a EntityType, returning an error if it is not a known value.
+func ParseEntityType(s string) (EntityType, error) {
+	v := EntityType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid EntityType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the EventAction.
+func (v EventAction) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the EventAction is one of its known values.
+func (v EventAction) IsValid() bool {
+	switch v {
+	case ActionAccountUpdate, ActionAccountSettingsUpdate, ActionBackupsEnable, ActionBackupsCancel, ActionBackupsRestore, ActionCommunityQuestionReply, ActionCommunityLike, ActionCreditCardUpdated, ActionDatabaseCreate, ActionDatabaseDegraded, ActionDatabaseDelete, ActionDatabaseFailed, ActionDatabaseUpdate, ActionDatabaseCreateFailed, ActionDatabaseUpdateFailed, ActionDatabaseBackupCreate, ActionDatabaseBackupRestore, ActionDatabaseCredentialsReset, ActionDiskCreate, ActionDiskDelete, ActionDiskUpdate, ActionDiskDuplicate, ActionDiskImagize, ActionDiskResize, ActionDNSRecordCreate, ActionDNSRecordDelete, ActionDNSRecordUpdate, ActionDNSZoneCreate, ActionDNSZoneDelete, ActionDNSZoneUpdate, ActionDNSZoneImport, ActionEntityTransferAccept, ActionEntityTransferCancel, ActionEntityTransferCreate, ActionEntityTransferFail, ActionEntityTransferStale, ActionFirewallCreate, ActionFirewallDelete, ActionFirewallDisable, ActionFirewallEnable, ActionFirewallUpdate, ActionFirewallDeviceAdd, ActionFirewallDeviceRemove, ActionHostReboot, ActionImageDelete, ActionImageUpdate, ActionImageUpload, ActionIPAddressUpdate, ActionLassieReboot, ActionLinodeAddIP, ActionLinodeBoot, ActionLinodeClone, ActionLinodeCreate, ActionLinodeDelete, ActionLinodeUpdate, ActionLinodeDeleteIP, ActionLinodeMigrate, ActionLinodeMigrateDatacenter, ActionLinodeMigrateDatacenterCreate, ActionLinodeMutate, ActionLinodeMutateCreate, ActionLinodeReboot, ActionLinodeRebuild, ActionLinodeResize, ActionLinodeResizeCreate, ActionLinodeShutdown, ActionLinodeSnapshot, ActionLinodeConfigCreate, ActionLinodeConfigDelete, ActionLinodeConfigUpdate, ActionLishBoot, ActionLKENodeCreate, ActionLKEControlPlaneACLCreate, ActionLKEControlPlaneACLUpdate, ActionLKEControlPlaneACLDelete, ActionLongviewClientCreate, ActionLongviewClientDelete, ActionLongviewClientUpdate, ActionManagedDisabled, ActionManagedEnabled, ActionManagedServiceCreate, ActionManagedServiceDelete, ActionNodebalancerCreate, ActionNodebalancerDelete, ActionNodebalancerUpdate, ActionNodebalancerConfigCreate, ActionNodebalancerConfigDelete, ActionNodebalancerConfigUpdate, ActionNodebalancerFirewallModificationSuccess, ActionNodebalancerFirewallModificationFailed, ActionNodebalancerNodeCreate, ActionNodebalancerNodeDelete, ActionNodebalancerNodeUpdate, ActionOAuthClientCreate, ActionOAuthClientDelete, ActionOAuthClientSecretReset, ActionOAuthClientUpdate, ActionOBJAccessKeyCreate, ActionOBJAccessKeyDelete, ActionOBJAccessKeyUpdate, ActionPaymentMethodAdd, ActionPaymentSubmitted, ActionPasswordReset, ActionPlacementGroupCreate, ActionPlacementGroupUpdate, ActionPlacementGroupDelete, ActionPlacementGroupAssign, ActionPlacementGroupUnassign, ActionPlacementGroupBecameNonCompliant, ActionPlacementGroupBecameCompliant, ActionProfileUpdate, ActionStackScriptCreate, ActionStackScriptDelete, ActionStackScriptUpdate, ActionStackScriptPublicize, ActionStackScriptRevise, ActionTaxIDInvalid, ActionTagCreate, ActionTagDelete, ActionTFADisabled, ActionTFAEnabled, ActionTicketAttachmentUpload, ActionTicketCreate, ActionTicketUpdate, ActionTokenCreate, ActionTokenDelete, ActionTokenUpdate, ActionUserCreate, ActionUserDelete, ActionUserUpdate, ActionUserSSHKeyAdd, ActionUserSSHKeyDelete, ActionUserSSHKeyUpdate, ActionVLANAttach, ActionVLANDetach, ActionVolumeAttach, ActionVolumeClone, ActionVolumeCreate, ActionVolumeDelete, ActionVolumeUpdate, ActionVolumeDetach, ActionVolumeResize, ActionVPCCreate, ActionVPCDelete, ActionVPCUpdate, ActionVPCSubnetCreate, ActionVPCSubnetDelete, ActionVPCSubnetUpdate:
+		return true
+	}
+
+	return false
+}
+
+// ParseEventAction converts s to a EventAction, returning an error if it is not a known value.
+func ParseEventAction(s string) (EventAction, error) {
+	v := EventAction(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid EventAction %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the EventStatus.
+func (v EventStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the EventStatus is one of its known values.
+func (v EventStatus) IsValid() bool {
+	switch v {
+	case EventFailed, EventFinished, EventNotification, EventScheduled, EventStarted:
+		return true
+	}
+
+	return false
+}
+
+// ParseEventStatus converts s to a EventStatus, returning an error if it is not a known value.
+func ParseEventStatus(s string) (EventStatus, error) {
+	v := EventStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid EventStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the FailoverMethod.
+func (v FailoverMethod) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the FailoverMethod is one of its known values.
+func (v FailoverMethod) IsValid() bool {
+	switch v {
+	case FailoverMethodBGP, FailoverMethodClassic:
+		return true
+	}
+
+	return false
+}
+
+// ParseFailoverMethod converts s to a FailoverMethod, returning an error if it is not a known value.
+func ParseFailoverMethod(s string) (FailoverMethod, error) {
+	v := FailoverMethod(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid FailoverMethod %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the FilterOperator.
+func (v FilterOperator) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the FilterOperator is one of its known values.
+func (v FilterOperator) IsValid() bool {
+	switch v {
+	case Eq, Neq, Gt, Gte, Lt, Lte, Contains:
+		return true
+	}
+
+	return false
+}
+
+// ParseFilterOperator converts s to a FilterOperator, returning an error if it is not a known value.
+func ParseFilterOperator(s string) (FilterOperator, error) {
+	v := FilterOperator(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid FilterOperator %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the FirewallDeviceType.
+func (v FirewallDeviceType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the FirewallDeviceType is one of its known values.
+func (v FirewallDeviceType) IsValid() bool {
+	switch v {
+	case FirewallDeviceLinode, FirewallDeviceNodeBalancer, FirewallDeviceLinodeInterface:
+		return true
+	}
+
+	return false
+}
+
+// ParseFirewallDeviceType converts s to a FirewallDeviceType, returning an error if it is not a known value.
+func ParseFirewallDeviceType(s string) (FirewallDeviceType, error) {
+	v := FirewallDeviceType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid FirewallDeviceType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the FirewallStatus.
+func (v FirewallStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the FirewallStatus is one of its known values.
+func (v FirewallStatus) IsValid() bool {
+	switch v {
+	case FirewallEnabled, FirewallDisabled, FirewallDeleted:
+		return true
+	}
+
+	return false
+}
+
+// ParseFirewallStatus converts s to a FirewallStatus, returning an error if it is not a known value.
+func ParseFirewallStatus(s string) (FirewallStatus, error) {
+	v := FirewallStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid FirewallStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the GrantPermissionLevel.
+func (v GrantPermissionLevel) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the GrantPermissionLevel is one of its known values.
+func (v GrantPermissionLevel) IsValid() bool {
+	switch v {
+	case AccessLevelReadOnly, AccessLevelReadWrite:
+		return true
+	}
+
+	return false
+}
+
+// ParseGrantPermissionLevel converts s to a GrantPermissionLevel, returning an error if it is not a known value.
+func ParseGrantPermissionLevel(s string) (GrantPermissionLevel, error) {
+	v := GrantPermissionLevel(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid GrantPermissionLevel %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the ImageRegionStatus.
+func (v ImageRegionStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the ImageRegionStatus is one of its known values.
+func (v ImageRegionStatus) IsValid() bool {
+	switch v {
+	case ImageRegionStatusAvailable, ImageRegionStatusCreating, ImageRegionStatusPending, ImageRegionStatusPendingReplication, ImageRegionStatusPendingDeletion, ImageRegionStatusReplicating:
+		return true
+	}
+
+	return false
+}
+
+// ParseImageRegionStatus converts s to a ImageRegionStatus, returning an error if it is not a known value.
+func ParseImageRegionStatus(s string) (ImageRegionStatus, error) {
+	v := ImageRegionStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ImageRegionStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the ImageStatus.
+func (v ImageStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the ImageStatus is one of its known values.
+func (v ImageStatus) IsValid() bool {
+	switch v {
+	case ImageStatusCreating, ImageStatusPendingUpload, ImageStatusAvailable:
+		return true
+	}
+
+	return false
+}
+
+// ParseImageStatus converts s to a ImageStatus, returning an error if it is not a known value.
+func ParseImageStatus(s string) (ImageStatus, error) {
+	v := ImageStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ImageStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the ImageType.
+func (v ImageType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the ImageType is one of its known values.
+func (v ImageType) IsValid() bool {
+	switch v {
+	case ImageTypeManual, ImageTypeAutomatic:
+		return true
+	}
+
+	return false
+}
+
+// ParseImageType converts s to a ImageType, returning an error if it is not a known value.
+func ParseImageType(s string) (ImageType, error) {
+	v := ImageType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ImageType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the InstanceDiskEncryption.
+func (v InstanceDiskEncryption) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the InstanceDiskEncryption is one of its known values.
+func (v InstanceDiskEncryption) IsValid() bool {
+	switch v {
+	case InstanceDiskEncryptionEnabled, InstanceDiskEncryptionDisabled:
+		return true
+	}
+
+	return false
+}
+
+// ParseInstanceDiskEncryption converts s to a InstanceDiskEncryption, returning an error if it is not a known value.
+func ParseInstanceDiskEncryption(s string) (InstanceDiskEncryption, error) {
+	v := InstanceDiskEncryption(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid InstanceDiskEncryption %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the InstanceIPType.
+func (v InstanceIPType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the InstanceIPType is one of its known values.
+func (v InstanceIPType) IsValid() bool {
+	switch v {
+	case IPTypeIPv4, IPTypeIPv6, IPTypeIPv6Pool, IPTypeIPv6Range:
+		return true
+	}
+
+	return false
+}
+
+// ParseInstanceIPType converts s to a InstanceIPType, returning an error if it is not a known value.
+func ParseInstanceIPType(s string) (InstanceIPType, error) {
+	v := InstanceIPType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid InstanceIPType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the InstanceMaintenancePolicy.
+func (v InstanceMaintenancePolicy) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the InstanceMaintenancePolicy is one of its known values.
+func (v InstanceMaintenancePolicy) IsValid() bool {
+	switch v {
+	case InstanceMaintenancePolicyMigrate, InstanceMaintenancePolicyPowerOffOn:
+		return true
+	}
+
+	return false
+}
+
+// ParseInstanceMaintenancePolicy converts s to a InstanceMaintenancePolicy, returning an error if it is not a known value.
+func ParseInstanceMaintenancePolicy(s string) (InstanceMaintenancePolicy, error) {
+	v := InstanceMaintenancePolicy(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid InstanceMaintenancePolicy %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the InstanceMigrationType.
+func (v InstanceMigrationType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the InstanceMigrationType is one of its known values.
+func (v InstanceMigrationType) IsValid() bool {
+	switch v {
+	case WarmMigration, ColdMigration:
+		return true
+	}
+
+	return false
+}
+
+// ParseInstanceMigrationType converts s to a InstanceMigrationType, returning an error if it is not a known value.
+func ParseInstanceMigrationType(s string) (InstanceMigrationType, error) {
+	v := InstanceMigrationType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid InstanceMigrationType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the InstanceSnapshotStatus.
+func (v InstanceSnapshotStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the InstanceSnapshotStatus is one of its known values.
+func (v InstanceSnapshotStatus) IsValid() bool {
+	switch v {
+	case SnapshotPaused, SnapshotPending, SnapshotRunning, SnapshotNeedsPostProcessing, SnapshotSuccessful, SnapshotFailed, SnapshotUserAborted:
+		return true
+	}
+
+	return false
+}
+
+// ParseInstanceSnapshotStatus converts s to a InstanceSnapshotStatus, returning an error if it is not a known value.
+func ParseInstanceSnapshotStatus(s string) (InstanceSnapshotStatus, error) {
+	v := InstanceSnapshotStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid InstanceSnapshotStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the InstanceStatus.
+func (v InstanceStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the InstanceStatus is one of its known values.
+func (v InstanceStatus) IsValid() bool {
+	switch v {
+	case InstanceBooting, InstanceRunning, InstanceOffline, InstanceShuttingDown, InstanceRebooting, InstanceProvisioning, InstanceDeleting, InstanceMigrating, InstanceRebuilding, InstanceCloning, InstanceRestoring, InstanceResizing:
+		return true
+	}
+
+	return false
+}
+
+// ParseInstanceStatus converts s to a InstanceStatus, returning an error if it is not a known value.
+func ParseInstanceStatus(s string) (InstanceStatus, error) {
+	v := InstanceStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid InstanceStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the LKEClusterStatus.
+func (v LKEClusterStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the LKEClusterStatus is one of its known values.
+func (v LKEClusterStatus) IsValid() bool {
+	switch v {
+	case LKEClusterReady, LKEClusterNotReady:
+		return true
+	}
+
+	return false
+}
+
+// ParseLKEClusterStatus converts s to a LKEClusterStatus, returning an error if it is not a known value.
+func ParseLKEClusterStatus(s string) (LKEClusterStatus, error) {
+	v := LKEClusterStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid LKEClusterStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the LKELinodeStatus.
+func (v LKELinodeStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the LKELinodeStatus is one of its known values.
+func (v LKELinodeStatus) IsValid() bool {
+	switch v {
+	case LKELinodeReady, LKELinodeNotReady:
+		return true
+	}
+
+	return false
+}
+
+// ParseLKELinodeStatus converts s to a LKELinodeStatus, returning an error if it is not a known value.
+func ParseLKELinodeStatus(s string) (LKELinodeStatus, error) {
+	v := LKELinodeStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid LKELinodeStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the LKENodePoolTaintEffect.
+func (v LKENodePoolTaintEffect) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the LKENodePoolTaintEffect is one of its known values.
+func (v LKENodePoolTaintEffect) IsValid() bool {
+	switch v {
+	case LKENodePoolTaintEffectNoSchedule, LKENodePoolTaintEffectPreferNoSchedule, LKENodePoolTaintEffectNoExecute:
+		return true
+	}
+
+	return false
+}
+
+// ParseLKENodePoolTaintEffect converts s to a LKENodePoolTaintEffect, returning an error if it is not a known value.
+func ParseLKENodePoolTaintEffect(s string) (LKENodePoolTaintEffect, error) {
+	v := LKENodePoolTaintEffect(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid LKENodePoolTaintEffect %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the LKENodePoolUpdateStrategy.
+func (v LKENodePoolUpdateStrategy) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the LKENodePoolUpdateStrategy is one of its known values.
+func (v LKENodePoolUpdateStrategy) IsValid() bool {
+	switch v {
+	case LKENodePoolUpdateStrategyRollingUpdate, LKENodePoolUpdateStrategyOnRecycle:
+		return true
+	}
+
+	return false
+}
+
+// ParseLKENodePoolUpdateStrategy converts s to a LKENodePoolUpdateStrategy, returning an error if it is not a known value.
+func ParseLKENodePoolUpdateStrategy(s string) (LKENodePoolUpdateStrategy, error) {
+	v := LKENodePoolUpdateStrategy(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid LKENodePoolUpdateStrategy %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the LinodeTypeClass.
+func (v LinodeTypeClass) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the LinodeTypeClass is one of its known values.
+func (v LinodeTypeClass) IsValid() bool {
+	switch v {
+	case ClassNanode, ClassStandard, ClassHighmem, ClassDedicated, ClassGPU, ClassPremium:
+		return true
+	}
+
+	return false
+}
+
+// ParseLinodeTypeClass converts s to a LinodeTypeClass, returning an error if it is not a known value.
+func ParseLinodeTypeClass(s string) (LinodeTypeClass, error) {
+	v := LinodeTypeClass(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid LinodeTypeClass %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the LishAuthMethod.
+func (v LishAuthMethod) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the LishAuthMethod is one of its known values.
+func (v LishAuthMethod) IsValid() bool {
+	switch v {
+	case AuthMethodPasswordKeys, AuthMethodKeysOnly, AuthMethodDisabled:
+		return true
+	}
+
+	return false
+}
+
+// ParseLishAuthMethod converts s to a LishAuthMethod, returning an error if it is not a known value.
+func ParseLishAuthMethod(s string) (LishAuthMethod, error) {
+	v := LishAuthMethod(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid LishAuthMethod %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the MetricAggregateFunction.
+func (v MetricAggregateFunction) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the MetricAggregateFunction is one of its known values.
+func (v MetricAggregateFunction) IsValid() bool {
+	switch v {
+	case MetricAggregateAverage, MetricAggregateSum, MetricAggregateMin, MetricAggregateMax, MetricAggregateCount:
+		return true
+	}
+
+	return false
+}
+
+// ParseMetricAggregateFunction converts s to a MetricAggregateFunction, returning an error if it is not a known value.
+func ParseMetricAggregateFunction(s string) (MetricAggregateFunction, error) {
+	v := MetricAggregateFunction(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid MetricAggregateFunction %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the MonitorServiceType.
+func (v MonitorServiceType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the MonitorServiceType is one of its known values.
+func (v MonitorServiceType) IsValid() bool {
+	switch v {
+	case MonitorServiceTypeDBaaS, MonitorServiceTypeLinode, MonitorServiceTypeNodeBalancer:
+		return true
+	}
+
+	return false
+}
+
+// ParseMonitorServiceType converts s to a MonitorServiceType, returning an error if it is not a known value.
+func ParseMonitorServiceType(s string) (MonitorServiceType, error) {
+	v := MonitorServiceType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid MonitorServiceType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the MySQLDatabaseTarget.
+func (v MySQLDatabaseTarget) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the MySQLDatabaseTarget is one of its known values.
+func (v MySQLDatabaseTarget) IsValid() bool {
+	switch v {
+	case MySQLDatabaseTargetPrimary, MySQLDatabaseTargetSecondary:
+		return true
+	}
+
+	return false
+}
+
+// ParseMySQLDatabaseTarget converts s to a MySQLDatabaseTarget, returning an error if it is not a known value.
+func ParseMySQLDatabaseTarget(s string) (MySQLDatabaseTarget, error) {
+	v := MySQLDatabaseTarget(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid MySQLDatabaseTarget %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the NetworkProtocol.
+func (v NetworkProtocol) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the NetworkProtocol is one of its known values.
+func (v NetworkProtocol) IsValid() bool {
+	switch v {
+	case TCP, UDP, ICMP, IPENCAP:
+		return true
+	}
+
+	return false
+}
+
+// ParseNetworkProtocol converts s to a NetworkProtocol, returning an error if it is not a known value.
+func ParseNetworkProtocol(s string) (NetworkProtocol, error) {
+	v := NetworkProtocol(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid NetworkProtocol %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the NodeMode.
+func (v NodeMode) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the NodeMode is one of its known values.
+func (v NodeMode) IsValid() bool {
+	switch v {
+	case ModeAccept, ModeReject, ModeDrain, ModeBackup:
+		return true
+	}
+
+	return false
+}
+
+// ParseNodeMode converts s to a NodeMode, returning an error if it is not a known value.
+func ParseNodeMode(s string) (NodeMode, error) {
+	v := NodeMode(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid NodeMode %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the NotificationChannelType.
+func (v NotificationChannelType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the NotificationChannelType is one of its known values.
+func (v NotificationChannelType) IsValid() bool {
+	switch v {
+	case NotificationChannelTypeEmail, NotificationChannelTypeWebhook:
+		return true
+	}
+
+	return false
+}
+
+// ParseNotificationChannelType converts s to a NotificationChannelType, returning an error if it is not a known value.
+func ParseNotificationChannelType(s string) (NotificationChannelType, error) {
+	v := NotificationChannelType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid NotificationChannelType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the NotificationSeverity.
+func (v NotificationSeverity) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the NotificationSeverity is one of its known values.
+func (v NotificationSeverity) IsValid() bool {
+	switch v {
+	case NotificationMinor, NotificationMajor, NotificationCritical:
+		return true
+	}
+
+	return false
+}
+
+// ParseNotificationSeverity converts s to a NotificationSeverity, returning an error if it is not a known value.
+func ParseNotificationSeverity(s string) (NotificationSeverity, error) {
+	v := NotificationSeverity(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid NotificationSeverity %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the NotificationType.
+func (v NotificationType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the NotificationType is one of its known values.
+func (v NotificationType) IsValid() bool {
+	switch v {
+	case NotificationMigrationScheduled, NotificationMigrationImminent, NotificationMigrationPending, NotificationRebootScheduled, NotificationOutage, NotificationPaymentDue, NotificationTicketImportant, NotificationTicketAbuse, NotificationNotice, NotificationMaintenance:
+		return true
+	}
+
+	return false
+}
+
+// ParseNotificationType converts s to a NotificationType, returning an error if it is not a known value.
+func ParseNotificationType(s string) (NotificationType, error) {
+	v := NotificationType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid NotificationType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the OAuthClientStatus.
+func (v OAuthClientStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the OAuthClientStatus is one of its known values.
+func (v OAuthClientStatus) IsValid() bool {
+	switch v {
+	case OAuthClientActive, OAuthClientDisabled, OAuthClientSuspended:
+		return true
+	}
+
+	return false
+}
+
+// ParseOAuthClientStatus converts s to a OAuthClientStatus, returning an error if it is not a known value.
+func ParseOAuthClientStatus(s string) (OAuthClientStatus, error) {
+	v := OAuthClientStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid OAuthClientStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the ObjectStorageACL.
+func (v ObjectStorageACL) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the ObjectStorageACL is one of its known values.
+func (v ObjectStorageACL) IsValid() bool {
+	switch v {
+	case ACLPrivate, ACLPublicRead, ACLAuthenticatedRead, ACLPublicReadWrite:
+		return true
+	}
+
+	return false
+}
+
+// ParseObjectStorageACL converts s to a ObjectStorageACL, returning an error if it is not a known value.
+func ParseObjectStorageACL(s string) (ObjectStorageACL, error) {
+	v := ObjectStorageACL(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ObjectStorageACL %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the PlacementGroupPolicy.
+func (v PlacementGroupPolicy) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the PlacementGroupPolicy is one of its known values.
+func (v PlacementGroupPolicy) IsValid() bool {
+	switch v {
+	case PlacementGroupPolicyStrict, PlacementGroupPolicyFlexible:
+		return true
+	}
+
+	return false
+}
+
+// ParsePlacementGroupPolicy converts s to a PlacementGroupPolicy, returning an error if it is not a known value.
+func ParsePlacementGroupPolicy(s string) (PlacementGroupPolicy, error) {
+	v := PlacementGroupPolicy(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PlacementGroupPolicy %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the PlacementGroupType.
+func (v PlacementGroupType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the PlacementGroupType is one of its known values.
+func (v PlacementGroupType) IsValid() bool {
+	switch v {
+	case PlacementGroupTypeAntiAffinityLocal:
+		return true
+	}
+
+	return false
+}
+
+// ParsePlacementGroupType converts s to a PlacementGroupType, returning an error if it is not a known value.
+func ParsePlacementGroupType(s string) (PlacementGroupType, error) {
+	v := PlacementGroupType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PlacementGroupType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the PostgresCommitType.
+func (v PostgresCommitType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the PostgresCommitType is one of its known values.
+func (v PostgresCommitType) IsValid() bool {
+	switch v {
+	case PostgresCommitTrue, PostgresCommitFalse, PostgresCommitLocal, PostgresCommitRemoteWrite, PostgresCommitRemoteApply:
+		return true
+	}
+
+	return false
+}
+
+// ParsePostgresCommitType converts s to a PostgresCommitType, returning an error if it is not a known value.
+func ParsePostgresCommitType(s string) (PostgresCommitType, error) {
+	v := PostgresCommitType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PostgresCommitType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the PostgresDatabaseTarget.
+func (v PostgresDatabaseTarget) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the PostgresDatabaseTarget is one of its known values.
+func (v PostgresDatabaseTarget) IsValid() bool {
+	switch v {
+	case PostgresDatabaseTargetPrimary, PostgresDatabaseTargetSecondary:
+		return true
+	}
+
+	return false
+}
+
+// ParsePostgresDatabaseTarget converts s to a PostgresDatabaseTarget, returning an error if it is not a known value.
+func ParsePostgresDatabaseTarget(s string) (PostgresDatabaseTarget, error) {
+	v := PostgresDatabaseTarget(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PostgresDatabaseTarget %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the PostgresReplicationType.
+func (v PostgresReplicationType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the PostgresReplicationType is one of its known values.
+func (v PostgresReplicationType) IsValid() bool {
+	switch v {
+	case PostgresReplicationNone, PostgresReplicationAsynch, PostgresReplicationSemiSynch:
+		return true
+	}
+
+	return false
+}
+
+// ParsePostgresReplicationType converts s to a PostgresReplicationType, returning an error if it is not a known value.
+func ParsePostgresReplicationType(s string) (PostgresReplicationType, error) {
+	v := PostgresReplicationType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PostgresReplicationType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the PowerAction.
+func (v PowerAction) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the PowerAction is one of its known values.
+func (v PowerAction) IsValid() bool {
+	switch v {
+	case PowerBoot, PowerShutdown:
+		return true
+	}
+
+	return false
+}
+
+// ParsePowerAction converts s to a PowerAction, returning an error if it is not a known value.
+func ParsePowerAction(s string) (PowerAction, error) {
+	v := PowerAction(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PowerAction %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the TeardownAction.
+func (v TeardownAction) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the TeardownAction is one of its known values.
+func (v TeardownAction) IsValid() bool {
+	switch v {
+	case TeardownDeleteDomainRecord, TeardownDeleteNodeBalancerNode, TeardownDetachVolume, TeardownDeleteFirewallDevice, TeardownDeleteNodeBalancer, TeardownDeleteInstance, TeardownDeleteVolume, TeardownDeleteFirewall, TeardownReleaseReservedIP:
+		return true
+	}
+
+	return false
+}
+
+// ParseTeardownAction converts s to a TeardownAction, returning an error if it is not a known value.
+func ParseTeardownAction(s string) (TeardownAction, error) {
+	v := TeardownAction(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid TeardownAction %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the TicketStatus.
+func (v TicketStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the TicketStatus is one of its known values.
+func (v TicketStatus) IsValid() bool {
+	switch v {
+	case TicketNew, TicketClosed, TicketOpen:
+		return true
+	}
+
+	return false
+}
+
+// ParseTicketStatus converts s to a TicketStatus, returning an error if it is not a known value.
+func ParseTicketStatus(s string) (TicketStatus, error) {
+	v := TicketStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid TicketStatus %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the UserType.
+func (v UserType) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the UserType is one of its known values.
+func (v UserType) IsValid() bool {
+	switch v {
+	case UserTypeProxy, UserTypeParent, UserTypeChild, UserTypeDefault:
+		return true
+	}
+
+	return false
+}
+
+// ParseUserType converts s to a UserType, returning an error if it is not a known value.
+func ParseUserType(s string) (UserType, error) {
+	v := UserType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UserType %q", s)
+	}
+
+	return v, nil
+}
+
+// String returns the string representation of the VolumeStatus.
+func (v VolumeStatus) String() string {
+	return string(v)
+}
+
+// IsValid reports whether the VolumeStatus is one of its known values.
+func (v VolumeStatus) IsValid() bool {
+	switch v {
+	case VolumeCreating, VolumeActive, VolumeResizing, VolumeContactSupport:
+		return true
+	}
+
+	return false
+}
+
+// ParseVolumeStatus converts s to a VolumeStatus, returning an error if it is not a known value.
+func ParseVolumeStatus(s string) (VolumeStatus, error) {
+	v := VolumeStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid VolumeStatus %q", s)
+	}
+
+	return v, nil
+}
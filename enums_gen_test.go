@@ -0,0 +1,197 @@
+// Code generated by internal/enumgen; DO NOT EDIT.
+
+package linodego
+
+import "testing"
+
+func TestGeneratedEnums(t *testing.T) {
+	t.Run("APIErrorCode", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseAPIErrorCode, []APIErrorCode{APIErrorCodeUnknown, APIErrorCodeInvalidRequest, APIErrorCodeNotFound, APIErrorCodePermissionDenied, APIErrorCodeRateLimited, APIErrorCodeBusy, APIErrorCodeQuotaExceeded, APIErrorCodeRegionCapacity, APIErrorCodeInvalidPlan, APIErrorCodeInvalidAddress, APIErrorCodeAddressAssigned, APIErrorCodeAddressNotOwned, APIErrorCodeWrongRegion, APIErrorCodeServerError})
+	})
+	t.Run("AlertDefinitionStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseAlertDefinitionStatus, []AlertDefinitionStatus{AlertDefinitionStatusEnabled, AlertDefinitionStatusDisabled, AlertDefinitionStatusInProgress, AlertDefinitionStatusFailed})
+	})
+	t.Run("AlertDefinitionType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseAlertDefinitionType, []AlertDefinitionType{AlertDefinitionTypeUser, AlertDefinitionTypeSystem})
+	})
+	t.Run("AlertRuleOperator", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseAlertRuleOperator, []AlertRuleOperator{AlertRuleOperatorEqual, AlertRuleOperatorGreaterThan, AlertRuleOperatorGreaterThanOrEqual, AlertRuleOperatorLessThan, AlertRuleOperatorLessThanOrEqual})
+	})
+	t.Run("CircuitBreakerState", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseCircuitBreakerState, []CircuitBreakerState{CircuitClosed, CircuitOpen, CircuitHalfOpen})
+	})
+	t.Run("ConfigAlgorithm", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseConfigAlgorithm, []ConfigAlgorithm{AlgorithmRoundRobin, AlgorithmLeastConn, AlgorithmSource})
+	})
+	t.Run("ConfigCheck", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseConfigCheck, []ConfigCheck{CheckNone, CheckConnection, CheckHTTP, CheckHTTPBody})
+	})
+	t.Run("ConfigCipher", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseConfigCipher, []ConfigCipher{CipherRecommended, CipherLegacy})
+	})
+	t.Run("ConfigInterfacePurpose", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseConfigInterfacePurpose, []ConfigInterfacePurpose{InterfacePurposePublic, InterfacePurposeVLAN, InterfacePurposeVPC})
+	})
+	t.Run("ConfigProtocol", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseConfigProtocol, []ConfigProtocol{ProtocolHTTP, ProtocolHTTPS, ProtocolTCP})
+	})
+	t.Run("ConfigProxyProtocol", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseConfigProxyProtocol, []ConfigProxyProtocol{ProxyProtocolNone, ProxyProtocolV1, ProxyProtocolV2})
+	})
+	t.Run("ConfigStickiness", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseConfigStickiness, []ConfigStickiness{StickinessNone, StickinessTable, StickinessHTTPCookie})
+	})
+	t.Run("DatabaseEngineType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseDatabaseEngineType, []DatabaseEngineType{DatabaseEngineTypeMySQL, DatabaseEngineTypePostgres})
+	})
+	t.Run("DatabaseMaintenanceFrequency", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseDatabaseMaintenanceFrequency, []DatabaseMaintenanceFrequency{DatabaseMaintenanceFrequencyWeekly, DatabaseMaintenanceFrequencyMonthly})
+	})
+	t.Run("DatabaseStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseDatabaseStatus, []DatabaseStatus{DatabaseStatusProvisioning, DatabaseStatusActive, DatabaseStatusDeleting, DatabaseStatusDeleted, DatabaseStatusSuspending, DatabaseStatusSuspended, DatabaseStatusResuming, DatabaseStatusRestoring, DatabaseStatusFailed, DatabaseStatusDegraded, DatabaseStatusUpdating, DatabaseStatusBackingUp})
+	})
+	t.Run("DiskFilesystem", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseDiskFilesystem, []DiskFilesystem{FilesystemRaw, FilesystemSwap, FilesystemExt3, FilesystemExt4, FilesystemInitrd})
+	})
+	t.Run("DiskStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseDiskStatus, []DiskStatus{DiskReady, DiskNotReady, DiskDeleting})
+	})
+	t.Run("DomainRecordType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseDomainRecordType, []DomainRecordType{RecordTypeA, RecordTypeAAAA, RecordTypeNS, RecordTypeMX, RecordTypeCNAME, RecordTypeTXT, RecordTypeSRV, RecordTypePTR, RecordTypeCAA})
+	})
+	t.Run("DomainStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseDomainStatus, []DomainStatus{DomainStatusDisabled, DomainStatusActive, DomainStatusEditMode, DomainStatusHasErrors})
+	})
+	t.Run("DomainType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseDomainType, []DomainType{DomainTypeMaster, DomainTypeSlave})
+	})
+	t.Run("EntityType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseEntityType, []EntityType{EntityAccount, EntityBackups, EntityCommunity, EntityDatabase, EntityDisk, EntityDomain, EntityTransfer, EntityFirewall, EntityImage, EntityIPAddress, EntityLinode, EntityLongview, EntityManagedService, EntityNodebalancer, EntityOAuthClient, EntityPlacementGroup, EntityProfile, EntityStackscript, EntityTag, EntityTicket, EntityToken, EntityUser, EntityUserSSHKey, EntityVolume, EntityVPC, EntityVPCSubnet})
+	})
+	t.Run("EventAction", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseEventAction, []EventAction{ActionAccountUpdate, ActionAccountSettingsUpdate, ActionBackupsEnable, ActionBackupsCancel, ActionBackupsRestore, ActionCommunityQuestionReply, ActionCommunityLike, ActionCreditCardUpdated, ActionDatabaseCreate, ActionDatabaseDegraded, ActionDatabaseDelete, ActionDatabaseFailed, ActionDatabaseUpdate, ActionDatabaseCreateFailed, ActionDatabaseUpdateFailed, ActionDatabaseBackupCreate, ActionDatabaseBackupRestore, ActionDatabaseCredentialsReset, ActionDiskCreate, ActionDiskDelete, ActionDiskUpdate, ActionDiskDuplicate, ActionDiskImagize, ActionDiskResize, ActionDNSRecordCreate, ActionDNSRecordDelete, ActionDNSRecordUpdate, ActionDNSZoneCreate, ActionDNSZoneDelete, ActionDNSZoneUpdate, ActionDNSZoneImport, ActionEntityTransferAccept, ActionEntityTransferCancel, ActionEntityTransferCreate, ActionEntityTransferFail, ActionEntityTransferStale, ActionFirewallCreate, ActionFirewallDelete, ActionFirewallDisable, ActionFirewallEnable, ActionFirewallUpdate, ActionFirewallDeviceAdd, ActionFirewallDeviceRemove, ActionHostReboot, ActionImageDelete, ActionImageUpdate, ActionImageUpload, ActionIPAddressUpdate, ActionLassieReboot, ActionLinodeAddIP, ActionLinodeBoot, ActionLinodeClone, ActionLinodeCreate, ActionLinodeDelete, ActionLinodeUpdate, ActionLinodeDeleteIP, ActionLinodeMigrate, ActionLinodeMigrateDatacenter, ActionLinodeMigrateDatacenterCreate, ActionLinodeMutate, ActionLinodeMutateCreate, ActionLinodeReboot, ActionLinodeRebuild, ActionLinodeResize, ActionLinodeResizeCreate, ActionLinodeShutdown, ActionLinodeSnapshot, ActionLinodeConfigCreate, ActionLinodeConfigDelete, ActionLinodeConfigUpdate, ActionLishBoot, ActionLKENodeCreate, ActionLKEControlPlaneACLCreate, ActionLKEControlPlaneACLUpdate, ActionLKEControlPlaneACLDelete, ActionLongviewClientCreate, ActionLongviewClientDelete, ActionLongviewClientUpdate, ActionManagedDisabled, ActionManagedEnabled, ActionManagedServiceCreate, ActionManagedServiceDelete, ActionNodebalancerCreate, ActionNodebalancerDelete, ActionNodebalancerUpdate, ActionNodebalancerConfigCreate, ActionNodebalancerConfigDelete, ActionNodebalancerConfigUpdate, ActionNodebalancerFirewallModificationSuccess, ActionNodebalancerFirewallModificationFailed, ActionNodebalancerNodeCreate, ActionNodebalancerNodeDelete, ActionNodebalancerNodeUpdate, ActionOAuthClientCreate, ActionOAuthClientDelete, ActionOAuthClientSecretReset, ActionOAuthClientUpdate, ActionOBJAccessKeyCreate, ActionOBJAccessKeyDelete, ActionOBJAccessKeyUpdate, ActionPaymentMethodAdd, ActionPaymentSubmitted, ActionPasswordReset, ActionPlacementGroupCreate, ActionPlacementGroupUpdate, ActionPlacementGroupDelete, ActionPlacementGroupAssign, ActionPlacementGroupUnassign, ActionPlacementGroupBecameNonCompliant, ActionPlacementGroupBecameCompliant, ActionProfileUpdate, ActionStackScriptCreate, ActionStackScriptDelete, ActionStackScriptUpdate, ActionStackScriptPublicize, ActionStackScriptRevise, ActionTaxIDInvalid, ActionTagCreate, ActionTagDelete, ActionTFADisabled, ActionTFAEnabled, ActionTicketAttachmentUpload, ActionTicketCreate, ActionTicketUpdate, ActionTokenCreate, ActionTokenDelete, ActionTokenUpdate, ActionUserCreate, ActionUserDelete, ActionUserUpdate, ActionUserSSHKeyAdd, ActionUserSSHKeyDelete, ActionUserSSHKeyUpdate, ActionVLANAttach, ActionVLANDetach, ActionVolumeAttach, ActionVolumeClone, ActionVolumeCreate, ActionVolumeDelete, ActionVolumeUpdate, ActionVolumeDetach, ActionVolumeResize, ActionVPCCreate, ActionVPCDelete, ActionVPCUpdate, ActionVPCSubnetCreate, ActionVPCSubnetDelete, ActionVPCSubnetUpdate})
+	})
+	t.Run("EventStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseEventStatus, []EventStatus{EventFailed, EventFinished, EventNotification, EventScheduled, EventStarted})
+	})
+	t.Run("FailoverMethod", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseFailoverMethod, []FailoverMethod{FailoverMethodBGP, FailoverMethodClassic})
+	})
+	t.Run("FilterOperator", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseFilterOperator, []FilterOperator{Eq, Neq, Gt, Gte, Lt, Lte, Contains})
+	})
+	t.Run("FirewallDeviceType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseFirewallDeviceType, []FirewallDeviceType{FirewallDeviceLinode, FirewallDeviceNodeBalancer, FirewallDeviceLinodeInterface})
+	})
+	t.Run("FirewallStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseFirewallStatus, []FirewallStatus{FirewallEnabled, FirewallDisabled, FirewallDeleted})
+	})
+	t.Run("GrantPermissionLevel", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseGrantPermissionLevel, []GrantPermissionLevel{AccessLevelReadOnly, AccessLevelReadWrite})
+	})
+	t.Run("ImageRegionStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseImageRegionStatus, []ImageRegionStatus{ImageRegionStatusAvailable, ImageRegionStatusCreating, ImageRegionStatusPending, ImageRegionStatusPendingReplication, ImageRegionStatusPendingDeletion, ImageRegionStatusReplicating})
+	})
+	t.Run("ImageStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseImageStatus, []ImageStatus{ImageStatusCreating, ImageStatusPendingUpload, ImageStatusAvailable})
+	})
+	t.Run("ImageType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseImageType, []ImageType{ImageTypeManual, ImageTypeAutomatic})
+	})
+	t.Run("InstanceDiskEncryption", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseInstanceDiskEncryption, []InstanceDiskEncryption{InstanceDiskEncryptionEnabled, InstanceDiskEncryptionDisabled})
+	})
+	t.Run("InstanceIPType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseInstanceIPType, []InstanceIPType{IPTypeIPv4, IPTypeIPv6, IPTypeIPv6Pool, IPTypeIPv6Range})
+	})
+	t.Run("InstanceMaintenancePolicy", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseInstanceMaintenancePolicy, []InstanceMaintenancePolicy{InstanceMaintenancePolicyMigrate, InstanceMaintenancePolicyPowerOffOn})
+	})
+	t.Run("InstanceMigrationType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseInstanceMigrationType, []InstanceMigrationType{WarmMigration, ColdMigration})
+	})
+	t.Run("InstanceSnapshotStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseInstanceSnapshotStatus, []InstanceSnapshotStatus{SnapshotPaused, SnapshotPending, SnapshotRunning, SnapshotNeedsPostProcessing, SnapshotSuccessful, SnapshotFailed, SnapshotUserAborted})
+	})
+	t.Run("InstanceStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseInstanceStatus, []InstanceStatus{InstanceBooting, InstanceRunning, InstanceOffline, InstanceShuttingDown, InstanceRebooting, InstanceProvisioning, InstanceDeleting, InstanceMigrating, InstanceRebuilding, InstanceCloning, InstanceRestoring, InstanceResizing})
+	})
+	t.Run("LKEClusterStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseLKEClusterStatus, []LKEClusterStatus{LKEClusterReady, LKEClusterNotReady})
+	})
+	t.Run("LKELinodeStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseLKELinodeStatus, []LKELinodeStatus{LKELinodeReady, LKELinodeNotReady})
+	})
+	t.Run("LKENodePoolTaintEffect", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseLKENodePoolTaintEffect, []LKENodePoolTaintEffect{LKENodePoolTaintEffectNoSchedule, LKENodePoolTaintEffectPreferNoSchedule, LKENodePoolTaintEffectNoExecute})
+	})
+	t.Run("LKENodePoolUpdateStrategy", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseLKENodePoolUpdateStrategy, []LKENodePoolUpdateStrategy{LKENodePoolUpdateStrategyRollingUpdate, LKENodePoolUpdateStrategyOnRecycle})
+	})
+	t.Run("LinodeTypeClass", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseLinodeTypeClass, []LinodeTypeClass{ClassNanode, ClassStandard, ClassHighmem, ClassDedicated, ClassGPU, ClassPremium})
+	})
+	t.Run("LishAuthMethod", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseLishAuthMethod, []LishAuthMethod{AuthMethodPasswordKeys, AuthMethodKeysOnly, AuthMethodDisabled})
+	})
+	t.Run("MetricAggregateFunction", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseMetricAggregateFunction, []MetricAggregateFunction{MetricAggregateAverage, MetricAggregateSum, MetricAggregateMin, MetricAggregateMax, MetricAggregateCount})
+	})
+	t.Run("MonitorServiceType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseMonitorServiceType, []MonitorServiceType{MonitorServiceTypeDBaaS, MonitorServiceTypeLinode, MonitorServiceTypeNodeBalancer})
+	})
+	t.Run("MySQLDatabaseTarget", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseMySQLDatabaseTarget, []MySQLDatabaseTarget{MySQLDatabaseTargetPrimary, MySQLDatabaseTargetSecondary})
+	})
+	t.Run("NetworkProtocol", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseNetworkProtocol, []NetworkProtocol{TCP, UDP, ICMP, IPENCAP})
+	})
+	t.Run("NodeMode", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseNodeMode, []NodeMode{ModeAccept, ModeReject, ModeDrain, ModeBackup})
+	})
+	t.Run("NotificationChannelType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseNotificationChannelType, []NotificationChannelType{NotificationChannelTypeEmail, NotificationChannelTypeWebhook})
+	})
+	t.Run("NotificationSeverity", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseNotificationSeverity, []NotificationSeverity{NotificationMinor, NotificationMajor, NotificationCritical})
+	})
+	t.Run("NotificationType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseNotificationType, []NotificationType{NotificationMigrationScheduled, NotificationMigrationImminent, NotificationMigrationPending, NotificationRebootScheduled, NotificationOutage, NotificationPaymentDue, NotificationTicketImportant, NotificationTicketAbuse, NotificationNotice, NotificationMaintenance})
+	})
+	t.Run("OAuthClientStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseOAuthClientStatus, []OAuthClientStatus{OAuthClientActive, OAuthClientDisabled, OAuthClientSuspended})
+	})
+	t.Run("ObjectStorageACL", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseObjectStorageACL, []ObjectStorageACL{ACLPrivate, ACLPublicRead, ACLAuthenticatedRead, ACLPublicReadWrite})
+	})
+	t.Run("PlacementGroupPolicy", func(t *testing.T) {
+		testEnumRoundTrip(t, ParsePlacementGroupPolicy, []PlacementGroupPolicy{PlacementGroupPolicyStrict, PlacementGroupPolicyFlexible})
+	})
+	t.Run("PlacementGroupType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParsePlacementGroupType, []PlacementGroupType{PlacementGroupTypeAntiAffinityLocal})
+	})
+	t.Run("PostgresCommitType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParsePostgresCommitType, []PostgresCommitType{PostgresCommitTrue, PostgresCommitFalse, PostgresCommitLocal, PostgresCommitRemoteWrite, PostgresCommitRemoteApply})
+	})
+	t.Run("PostgresDatabaseTarget", func(t *testing.T) {
+		testEnumRoundTrip(t, ParsePostgresDatabaseTarget, []PostgresDatabaseTarget{PostgresDatabaseTargetPrimary, PostgresDatabaseTargetSecondary})
+	})
+	t.Run("PostgresReplicationType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParsePostgresReplicationType, []PostgresReplicationType{PostgresReplicationNone, PostgresReplicationAsynch, PostgresReplicationSemiSynch})
+	})
+	t.Run("PowerAction", func(t *testing.T) {
+		testEnumRoundTrip(t, ParsePowerAction, []PowerAction{PowerBoot, PowerShutdown})
+	})
+	t.Run("TeardownAction", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseTeardownAction, []TeardownAction{TeardownDeleteDomainRecord, TeardownDeleteNodeBalancerNode, TeardownDetachVolume, TeardownDeleteFirewallDevice, TeardownDeleteNodeBalancer, TeardownDeleteInstance, TeardownDeleteVolume, TeardownDeleteFirewall, TeardownReleaseReservedIP})
+	})
+	t.Run("TicketStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseTicketStatus, []TicketStatus{TicketNew, TicketClosed, TicketOpen})
+	})
+	t.Run("UserType", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseUserType, []UserType{UserTypeProxy, UserTypeParent, UserTypeChild, UserTypeDefault})
+	})
+	t.Run("VolumeStatus", func(t *testing.T) {
+		testEnumRoundTrip(t, ParseVolumeStatus, []VolumeStatus{VolumeCreating, VolumeActive, VolumeResizing, VolumeContactSupport})
+	})
+}
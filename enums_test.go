@@ -0,0 +1,135 @@
+package linodego
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+)
+
+// testEnumRoundTrip verifies that every value of an enum is valid, survives a
+// JSON round trip and can be parsed from its string representation.
+func testEnumRoundTrip[T interface {
+	~string
+	IsValid() bool
+	String() string
+}](t *testing.T, parse func(string) (T, error), values []T) {
+	t.Helper()
+
+	for _, v := range values {
+		if !v.IsValid() {
+			t.Errorf("expected %q to be valid", v)
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("failed to marshal %q: %s", v, err)
+		}
+
+		var decoded T
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatalf("failed to unmarshal %s: %s", b, err)
+		}
+
+		if decoded != v {
+			t.Errorf("expected %q after round trip, got %q", v, decoded)
+		}
+
+		parsed, err := parse(v.String())
+		if err != nil || parsed != v {
+			t.Errorf("expected to parse %q, got %q (%v)", v, parsed, err)
+		}
+	}
+
+	invalid := T(" " + string(values[0]))
+	if invalid.IsValid() {
+		t.Errorf("expected %q to be invalid", invalid)
+	}
+
+	if _, err := parse(string(invalid)); err == nil {
+		t.Errorf("expected an error parsing %q", invalid)
+	}
+}
+
+// TestEnumsHaveHelpers ensures every exported string enum type declared in the
+// package has String, IsValid and ParseX helpers, so that newly added enums are
+// not missed when enums_gen.go is regenerated.
+func TestEnumsHaveHelpers(t *testing.T) {
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stringTypes := make(map[string]bool)
+	enums := make(map[string]bool)
+	methods := make(map[string]bool)
+	funcs := make(map[string]bool)
+
+	for _, file := range pkgs["linodego"].Files {
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if d.Recv == nil {
+					funcs[d.Name.Name] = true
+					continue
+				}
+
+				if recv, ok := d.Recv.List[0].Type.(*ast.Ident); ok {
+					methods[recv.Name+"."+d.Name.Name] = true
+				}
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						// Aliases such as Capability cannot declare methods.
+						if ident, ok := ts.Type.(*ast.Ident); ok && ident.Name == "string" && !ts.Assign.IsValid() {
+							stringTypes[ts.Name.Name] = true
+						}
+
+						continue
+					}
+
+					vs, ok := spec.(*ast.ValueSpec)
+					if !ok {
+						continue
+					}
+
+					typ, ok := vs.Type.(*ast.Ident)
+					if !ok || !typ.IsExported() || len(vs.Values) == 0 {
+						continue
+					}
+
+					if lit, ok := vs.Values[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+						enums[typ.Name] = true
+					}
+				}
+			}
+		}
+	}
+
+	if len(enums) == 0 {
+		t.Fatal("expected to find enum types")
+	}
+
+	for name := range enums {
+		if !stringTypes[name] {
+			continue
+		}
+
+		for _, method := range []string{"String", "IsValid"} {
+			if !methods[name+"."+method] {
+				t.Errorf("%s is missing the %s method; run go generate", name, method)
+			}
+		}
+
+		if !funcs["Parse"+name] {
+			t.Errorf("%s is missing Parse%s; run go generate", name, name)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package linodego
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+)
+
+// APIErrorCode is a stable, machine-readable classification of an error returned by the Linode API,
+// derived from its HTTP status code and reasons. Use ErrorCode to classify an error.
+type APIErrorCode string
+
+// APIErrorCode constants start with APIErrorCode and include every classification ErrorCode may return
+const (
+	APIErrorCodeUnknown          APIErrorCode = "unknown"
+	APIErrorCodeInvalidRequest   APIErrorCode = "invalid_request"
+	APIErrorCodeNotFound         APIErrorCode = "not_found"
+	APIErrorCodePermissionDenied APIErrorCode = "permission_denied"
+	APIErrorCodeRateLimited      APIErrorCode = "rate_limited"
+	APIErrorCodeBusy             APIErrorCode = "busy"
+	APIErrorCodeQuotaExceeded    APIErrorCode = "quota_exceeded"
+	APIErrorCodeRegionCapacity   APIErrorCode = "region_capacity"
+	APIErrorCodeInvalidPlan      APIErrorCode = "invalid_plan"
+	APIErrorCodeInvalidAddress   APIErrorCode = "invalid_address"
+	APIErrorCodeAddressAssigned  APIErrorCode = "address_assigned"
+	APIErrorCodeAddressNotOwned  APIErrorCode = "address_not_owned"
+	APIErrorCodeWrongRegion      APIErrorCode = "wrong_region"
+	APIErrorCodeServerError      APIErrorCode = "server_error"
+)
+
+// APIErrorCodePattern classifies Linode API errors having a matching reason
+type APIErrorCodePattern struct {
+	// Status is the HTTP status code of the errors the pattern applies to, or 0 for any
+	Status int
+
+	// Field is the field of the reasons the pattern applies to, or empty for any
+	Field string
+
+	// Reason is matched against each reason of the error
+	Reason *regexp.Regexp
+
+	// Code is the classification of matching errors
+	Code APIErrorCode
+}
+
+// APIErrorCodePatterns are checked in order by ErrorCode, and the Code of the first pattern
+// matching any of an error's reasons is used. Errors matching no pattern are classified
+// by their HTTP status code alone. Patterns may be added to classify reasons not covered
+// here; the slice must not be modified while requests are being made.
+var APIErrorCodePatterns = []APIErrorCodePattern{
+	// Reserved IP addresses
+	{Reason: regexp.MustCompile(`(?i)must be reserved and must be currently unassigned`), Code: APIErrorCodeAddressNotOwned},
+	{Reason: regexp.MustCompile(`(?i)must be currently unassigned`), Code: APIErrorCodeAddressAssigned},
+	{Reason: regexp.MustCompile(`(?i)must belong to same region`), Code: APIErrorCodeWrongRegion},
+	{Reason: regexp.MustCompile(`(?i)valid reserved (ipv4 )?address`), Code: APIErrorCodeInvalidAddress},
+	{Reason: regexp.MustCompile(`(?i)cannot reserve a private address`), Code: APIErrorCodeInvalidAddress},
+	{Reason: regexp.MustCompile(`(?i)only addresses of type \w+ are currently supported`), Code: APIErrorCodeInvalidAddress},
+	{Reason: regexp.MustCompile(`(?i)must be a valid ipv[46] address`), Code: APIErrorCodeInvalidAddress},
+
+	// Account limits
+	{Reason: regexp.MustCompile(`(?i)require[s]? technical justification`), Code: APIErrorCodeQuotaExceeded},
+	{Reason: regexp.MustCompile(`(?i)quota|limit (exceeded|reached)|maximum number of`), Code: APIErrorCodeQuotaExceeded},
+	{Reason: regexp.MustCompile(`(?i)(doesn't|does not) have permission|unauthorized|not authorized`), Code: APIErrorCodePermissionDenied},
+
+	// Regions and plans
+	{Reason: regexp.MustCompile(`(?i)capacity|sold out|not available in (this|the selected) region`), Code: APIErrorCodeRegionCapacity},
+	{Field: "type", Reason: regexp.MustCompile(`(?i)not valid|invalid|not found|not available`), Code: APIErrorCodeInvalidPlan},
+
+	// Transient conditions
+	{Reason: regexp.MustCompile(`(?i)\bbusy\b`), Code: APIErrorCodeBusy},
+	{Reason: regexp.MustCompile(`(?i)too many requests`), Code: APIErrorCodeRateLimited},
+}
+
+// ClassifiedError is a Linode API error along with its classification.
+// It can be extracted from any error returned by the client using errors.As.
+type ClassifiedError struct {
+	Code APIErrorCode
+	Err  *Error
+}
+
+func (e *ClassifiedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ClassifiedError) Unwrap() error {
+	return e.Err
+}
+
+// As allows errors.As to extract a *ClassifiedError from an Error
+func (err Error) As(target any) bool {
+	t, ok := target.(**ClassifiedError)
+	if !ok {
+		return false
+	}
+
+	*t = &ClassifiedError{Code: classifyError(&err), Err: &err}
+
+	return true
+}
+
+// ErrorCode returns the classification of err, which must contain an [Error] from the Linode API.
+// APIErrorCodeUnknown is returned for other errors, including nil.
+func ErrorCode(err error) APIErrorCode {
+	if err == nil {
+		return APIErrorCodeUnknown
+	}
+
+	var c *ClassifiedError
+	if errors.As(err, &c) {
+		return c.Code
+	}
+
+	return APIErrorCodeUnknown
+}
+
+// classifyError classifies e using APIErrorCodePatterns, falling back to its status code
+func classifyError(e *Error) APIErrorCode {
+	for _, p := range APIErrorCodePatterns {
+		if p.Status != 0 && p.Status != e.Code {
+			continue
+		}
+
+		for _, r := range e.Reasons {
+			if (p.Field == "" || p.Field == r.Field) && p.Reason != nil && p.Reason.MatchString(r.Reason) {
+				return p.Code
+			}
+		}
+	}
+
+	switch {
+	case e.Code == http.StatusNotFound:
+		return APIErrorCodeNotFound
+	case e.Code == http.StatusUnauthorized || e.Code == http.StatusForbidden:
+		return APIErrorCodePermissionDenied
+	case e.Code == http.StatusTooManyRequests:
+		return APIErrorCodeRateLimited
+	case e.Code == http.StatusConflict:
+		return APIErrorCodeBusy
+	case e.Code >= 500 && e.Code < 600:
+		return APIErrorCodeServerError
+	case e.Code >= 400 && e.Code < 500:
+		return APIErrorCodeInvalidRequest
+	}
+
+	return APIErrorCodeUnknown
+}
@@ -0,0 +1,149 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+)
+
+func TestErrorCode(t *testing.T) {
+	// Payloads are captured from the reserved IP fixtures in test/integration/fixtures
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   APIErrorCode
+	}{
+		{
+			"already assigned", http.StatusBadRequest,
+			`{"errors": [{"reason": "Address must be currently unassigned.", "field": "address"}]}`,
+			APIErrorCodeAddressAssigned,
+		},
+		{
+			"not owned", http.StatusBadRequest,
+			`{"errors": [{"reason": "Address must be reserved and must be currently unassigned.", "field": "address"}]}`,
+			APIErrorCodeAddressNotOwned,
+		},
+		{
+			"wrong region", http.StatusBadRequest,
+			`{"errors": [{"reason": "Address must belong to same region as linode.", "field": "address"}]}`,
+			APIErrorCodeWrongRegion,
+		},
+		{
+			"invalid address", http.StatusBadRequest,
+			`{"errors": [{"reason": "Must provide a single valid reserved ipv4 address", "field": "ipv4"}]}`,
+			APIErrorCodeInvalidAddress,
+		},
+		{
+			"private address", http.StatusBadRequest,
+			`{"errors": [{"reason": "Cannot reserve a private address.", "field": "address"}]}`,
+			APIErrorCodeInvalidAddress,
+		},
+		{
+			"ipmax limit", http.StatusBadRequest,
+			`{"errors": [{"reason": "Additional IPv4 addresses require technical justification.  Please contact support describing your requirement"}]}`,
+			APIErrorCodeQuotaExceeded,
+		},
+		{
+			"permission denied", http.StatusForbidden,
+			`{"errors": [{"reason": "Account doesn't have permission to access the 'Reserved IPs' feature."}]}`,
+			APIErrorCodePermissionDenied,
+		},
+		{
+			"busy", http.StatusBadRequest,
+			`{"errors": [{"reason": "Linode busy."}]}`,
+			APIErrorCodeBusy,
+		},
+		{
+			"invalid plan", http.StatusBadRequest,
+			`{"errors": [{"reason": "A valid plan type by that ID was not found", "field": "type"}]}`,
+			APIErrorCodeInvalidPlan,
+		},
+		{
+			"not found", http.StatusNotFound,
+			`{"errors": [{"reason": "Not found"}]}`,
+			APIErrorCodeNotFound,
+		},
+		{
+			"rate limited", http.StatusTooManyRequests,
+			`{"errors": [{"reason": "Too many requests"}]}`,
+			APIErrorCodeRateLimited,
+		},
+		{
+			"other invalid request", http.StatusBadRequest,
+			`{"errors": [{"reason": "region is not valid", "field": "region"}]}`,
+			APIErrorCodeInvalidRequest,
+		},
+		{
+			"server error", http.StatusInternalServerError,
+			`{"errors": [{"reason": "Please try again"}]}`,
+			APIErrorCodeServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := "/v4/networking/reserved/ips"
+			ts, client := createTestServer(http.MethodPost, route, "application/json", tt.body, tt.status)
+			defer ts.Close()
+
+			// Busy and rate limited responses would otherwise be retried
+			client.SetRetryCount(0)
+
+			_, err := coupleAPIErrors(client.R(context.Background()).Post(ts.URL + route))
+			err = fmt.Errorf("wrapped: %w", err)
+
+			if got := ErrorCode(err); got != tt.want {
+				t.Errorf("expected code %q, got %q", tt.want, got)
+			}
+
+			var classified *ClassifiedError
+			if !errors.As(err, &classified) {
+				t.Fatalf("expected a *ClassifiedError in %v", err)
+			}
+
+			if classified.Code != tt.want || classified.Err.Code != tt.status {
+				t.Errorf("unexpected classified error %+v", classified)
+			}
+
+			// The original error remains available
+			var e *Error
+			if !errors.As(classified, &e) || e.Code != tt.status {
+				t.Errorf("expected the *Error to be unwrapped from %v", classified)
+			}
+		})
+	}
+}
+
+func TestErrorCodeCustomPattern(t *testing.T) {
+	original := APIErrorCodePatterns
+	t.Cleanup(func() { APIErrorCodePatterns = original })
+
+	err := &Error{Code: http.StatusBadRequest, Reasons: []APIErrorReason{{Reason: "Volume is being resized"}}}
+
+	if got := ErrorCode(err); got != APIErrorCodeInvalidRequest {
+		t.Errorf("expected code %q before adding a pattern, got %q", APIErrorCodeInvalidRequest, got)
+	}
+
+	APIErrorCodePatterns = append(APIErrorCodePatterns, APIErrorCodePattern{
+		Status: http.StatusBadRequest,
+		Reason: regexp.MustCompile(`being resized`),
+		Code:   APIErrorCodeBusy,
+	})
+
+	if got := ErrorCode(err); got != APIErrorCodeBusy {
+		t.Errorf("expected code %q after adding a pattern, got %q", APIErrorCodeBusy, got)
+	}
+}
+
+func TestErrorCodeNonAPIErrors(t *testing.T) {
+	for _, err := range []error{nil, io.EOF, NewError(io.EOF)} {
+		if got := ErrorCode(err); got != APIErrorCodeUnknown {
+			t.Errorf("expected %v to be unknown, got %q", err, got)
+		}
+	}
+}
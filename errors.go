@@ -20,6 +20,10 @@ const (
 	ErrorFromError
 	// ErrorFromStringer is the Code identifying Errors created by fmt.Stringer types
 	ErrorFromStringer
+	// ErrorFromCircuitBreaker is the Code identifying Errors returned while the circuit breaker is open
+	ErrorFromCircuitBreaker
+	// ErrorFromResponseTooLarge is the Code identifying Errors returned for responses exceeding the maximum response size
+	ErrorFromResponseTooLarge
 )
 
 // Error wraps the LinodeGo error with the relevant http.Response
@@ -27,6 +31,9 @@ type Error struct {
 	Response *http.Response
 	Code     int
 	Message  string
+
+	// Reasons contains each reason and field returned by the Linode API, if any
+	Reasons []APIErrorReason
 }
 
 // APIErrorReason is an individual invalid request message returned by the Linode API
@@ -135,7 +142,7 @@ func coupleAPIErrorsHTTP(resp *http.Response, err error) (*http.Response, error)
 			return resp, nil
 		}
 
-		return nil, Error{Code: resp.StatusCode, Message: apiError.Errors[0].String()}
+		return nil, Error{Code: resp.StatusCode, Message: apiError.Errors[0].String(), Reasons: apiError.Errors}
 	}
 
 	// no error in the http.Response
@@ -191,6 +198,7 @@ func NewError(err any) *Error {
 			Code:     e.RawResponse.StatusCode,
 			Message:  apiError.Error(),
 			Response: e.RawResponse,
+			Reasons:  apiError.Errors,
 		}
 	case error:
 		return &Error{Code: ErrorFromError, Message: e.Error()}
@@ -208,6 +216,28 @@ func IsNotFound(err error) bool {
 	return ErrHasStatus(err, http.StatusNotFound)
 }
 
+// IsRateLimited indicates if err indicates a 429 Too Many Requests error from the Linode API.
+func IsRateLimited(err error) bool {
+	return ErrHasStatus(err, http.StatusTooManyRequests)
+}
+
+// ErrHasField checks if err is an error from the Linode API with a reason for the given field,
+// e.g. "ipv4" or "interfaces[0].label".
+func ErrHasField(err error, field string) bool {
+	e, ok := asError(err)
+	if !ok {
+		return false
+	}
+
+	for _, r := range e.Reasons {
+		if r.Field == field {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ErrHasStatus checks if err is an error from the Linode API, and whether it contains the given HTTP status code.
 // More than one status code may be given.
 // If len(code) == 0, err is nil or is not a [Error], ErrHasStatus will return false.
@@ -221,8 +251,8 @@ func ErrHasStatus(err error, code ...int) bool {
 		return false
 	}
 
-	var e *Error
-	if !errors.As(err, &e) {
+	e, ok := asError(err)
+	if !ok {
 		return false
 	}
 	ec := e.StatusCode()
@@ -233,3 +263,18 @@ func ErrHasStatus(err error, code ...int) bool {
 	}
 	return false
 }
+
+// asError finds the first [Error] in err's tree, which may be either an *Error or an Error
+func asError(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, e != nil
+	}
+
+	var v Error
+	if errors.As(err, &v) {
+		return &v, true
+	}
+
+	return nil, false
+}
@@ -516,3 +516,72 @@ func TestErrHasStatusCode(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorReasons(t *testing.T) {
+	t.Run("multiple errors", func(t *testing.T) {
+		rawResponse := `{"errors": [
+			{"reason": "Linode busy."},
+			{"reason": "Must be a valid IPv4 address.", "field": "ipv4"},
+			{"reason": "Label must be unique.", "field": "label"}
+		]}`
+		route := "/v4/linode/instances/123"
+		ts, client := createTestServer(http.MethodGet, route, "application/json", rawResponse, http.StatusBadRequest)
+		defer ts.Close()
+
+		_, err := coupleAPIErrors(client.R(context.Background()).SetResult(&Instance{}).Get(ts.URL + route))
+		err = fmt.Errorf("wrapped: %w", err)
+
+		var e *Error
+		if !errors.As(err, &e) {
+			t.Fatalf("expected an *Error, got %T", err)
+		}
+
+		expectedReasons := []APIErrorReason{
+			{Reason: "Linode busy."},
+			{Reason: "Must be a valid IPv4 address.", Field: "ipv4"},
+			{Reason: "Label must be unique.", Field: "label"},
+		}
+		if diff := cmp.Diff(expectedReasons, e.Reasons); diff != "" {
+			t.Errorf("expected reasons to match but got diff:\n%s", diff)
+		}
+
+		if e.StatusCode() != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, e.StatusCode())
+		}
+
+		if !ErrHasField(err, "ipv4") || !ErrHasField(err, "label") {
+			t.Error("expected the ipv4 and label fields to match")
+		}
+
+		if ErrHasField(err, "region") {
+			t.Error("expected the region field not to match")
+		}
+	})
+
+	t.Run("html error", func(t *testing.T) {
+		rawResponse := `<html><head><title>404 Not Found</title></head></html>`
+		route := "/v4/linode/instances/123"
+		ts, client := createTestServer(http.MethodGet, route, "text/html", rawResponse, http.StatusNotFound)
+		defer ts.Close()
+
+		_, err := coupleAPIErrors(client.R(context.Background()).SetResult(&Instance{}).Get(ts.URL + route))
+
+		if !IsNotFound(err) {
+			t.Errorf("expected %v to be a not found error", err)
+		}
+
+		if ErrHasField(err, "") {
+			t.Error("expected an error without reasons not to match any field")
+		}
+	})
+}
+
+func TestIsRateLimited(t *testing.T) {
+	if !IsRateLimited(fmt.Errorf("wrapped: %w", &Error{Code: http.StatusTooManyRequests})) {
+		t.Error("expected a 429 to be rate limited")
+	}
+
+	if IsRateLimited(&Error{Code: http.StatusServiceUnavailable}) || IsRateLimited(io.EOF) || IsRateLimited(nil) {
+		t.Error("expected only a 429 to be rate limited")
+	}
+}
@@ -56,6 +56,23 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		return json.Marshal(result)
 	}
 
+	result[f.Operator] = f.JSONValueSegment()
+
+	return json.Marshal(result)
+}
+
+// Key implements FilterNode, allowing a Filter to be nested within another
+// Filter, e.g. an Or within an And. OrderBy and Order are ignored when nested.
+func (f *Filter) Key() string {
+	if f.Operator == "" {
+		return "+and"
+	}
+
+	return f.Operator
+}
+
+// JSONValueSegment implements FilterNode
+func (f *Filter) JSONValueSegment() any {
 	fields := make([]map[string]any, len(f.Children))
 	for i, c := range f.Children {
 		fields[i] = map[string]any{
@@ -63,9 +80,7 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		}
 	}
 
-	result[f.Operator] = fields
-
-	return json.Marshal(result)
+	return fields
 }
 
 type Comp struct {
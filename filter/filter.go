@@ -0,0 +1,60 @@
+// Package filter provides composable constructors for linodego.Filter, for example:
+//
+//	f := filter.And(
+//		filter.Eq("region", "us-east"),
+//		filter.Or(filter.Contains("label", "web"), filter.Contains("label", "api")),
+//	)
+//	f.OrderBy = "label"
+//	f.Order = linodego.Ascending
+//
+//	instances, err := client.ListInstances(ctx, linodego.NewListOptions(0, f))
+package filter
+
+import (
+	"github.com/linode/linodego"
+)
+
+// Eq matches entities where key is equal to value
+func Eq(key string, value any) *linodego.Comp {
+	return &linodego.Comp{Column: key, Operator: linodego.Eq, Value: value}
+}
+
+// Neq matches entities where key is not equal to value
+func Neq(key string, value any) *linodego.Comp {
+	return &linodego.Comp{Column: key, Operator: linodego.Neq, Value: value}
+}
+
+// Gt matches entities where key is greater than value
+func Gt(key string, value any) *linodego.Comp {
+	return &linodego.Comp{Column: key, Operator: linodego.Gt, Value: value}
+}
+
+// Gte matches entities where key is greater than or equal to value
+func Gte(key string, value any) *linodego.Comp {
+	return &linodego.Comp{Column: key, Operator: linodego.Gte, Value: value}
+}
+
+// Lt matches entities where key is less than value
+func Lt(key string, value any) *linodego.Comp {
+	return &linodego.Comp{Column: key, Operator: linodego.Lt, Value: value}
+}
+
+// Lte matches entities where key is less than or equal to value
+func Lte(key string, value any) *linodego.Comp {
+	return &linodego.Comp{Column: key, Operator: linodego.Lte, Value: value}
+}
+
+// Contains matches entities where key contains value
+func Contains(key string, value string) *linodego.Comp {
+	return &linodego.Comp{Column: key, Operator: linodego.Contains, Value: value}
+}
+
+// And matches entities matching all of the given nodes, which may themselves be And or Or filters
+func And(nodes ...linodego.FilterNode) *linodego.Filter {
+	return &linodego.Filter{Operator: "+and", Children: nodes}
+}
+
+// Or matches entities matching any of the given nodes, which may themselves be And or Or filters
+func Or(nodes ...linodego.FilterNode) *linodego.Filter {
+	return &linodego.Filter{Operator: "+or", Children: nodes}
+}
@@ -0,0 +1,100 @@
+package filter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/linode/linodego"
+)
+
+func TestFilterJSON(t *testing.T) {
+	ordered := And(Eq("label", `my "quoted" label`))
+	ordered.OrderBy = "label"
+	ordered.Order = linodego.Descending
+
+	tests := map[string]struct {
+		filter   *linodego.Filter
+		expected string
+	}{
+		"and": {
+			filter:   And(Eq("region", "us-east"), Gte("vcpus", 2)),
+			expected: `{"+and": [{"region": "us-east"}, {"vcpus": {"+gte": 2}}]}`,
+		},
+		"or": {
+			filter:   Or(Lt("memory", 2048), Gt("memory", 8192)),
+			expected: `{"+or": [{"memory": {"+lt": 2048}}, {"memory": {"+gt": 8192}}]}`,
+		},
+		"nested": {
+			filter: And(
+				Neq("status", "offline"),
+				Or(Contains("label", "web"), Lte("disk", 81920)),
+			),
+			expected: `{"+and": [
+				{"status": {"+neq": "offline"}},
+				{"+or": [{"label": {"+contains": "web"}}, {"disk": {"+lte": 81920}}]}
+			]}`,
+		},
+		"nested in implicit and": {
+			filter: &linodego.Filter{Children: []linodego.FilterNode{
+				Eq("region", "us-east"),
+				Or(Eq("type", "g6-nanode-1"), Eq("type", "g6-standard-1")),
+			}},
+			expected: `{"region": "us-east", "+or": [{"type": "g6-nanode-1"}, {"type": "g6-standard-1"}]}`,
+		},
+		"ordered with escaped label": {
+			filter:   ordered,
+			expected: `{"+and": [{"label": "my \"quoted\" label"}], "+order_by": "label", "+order": "desc"}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := tt.filter.MarshalJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var actualValue, expectedValue any
+
+			if err := json.Unmarshal(actual, &actualValue); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal([]byte(tt.expected), &expectedValue); err != nil {
+				t.Fatal(err)
+			}
+
+			expectedJSON, _ := json.Marshal(expectedValue)
+			actualJSON, _ := json.Marshal(actualValue)
+
+			if string(expectedJSON) != string(actualJSON) {
+				t.Errorf("expected %s, got %s", expectedJSON, actualJSON)
+			}
+		})
+	}
+}
+
+func TestNewListOptions(t *testing.T) {
+	opts := linodego.NewListOptions(2, And(Eq("id", 123)))
+	if opts.Page != 2 || opts.Filter != `{"+and":[{"id":123}]}` {
+		t.Errorf("unexpected list options: page %d, filter %s", opts.Page, opts.Filter)
+	}
+
+	if opts := linodego.NewListOptions(0, `{"id": 123}`); opts.Filter != `{"id": 123}` {
+		t.Errorf("expected the raw filter to be kept, got %s", opts.Filter)
+	}
+
+	if opts := linodego.NewListOptions(0, (*linodego.Filter)(nil)); opts.Filter != "" {
+		t.Errorf("expected no filter, got %s", opts.Filter)
+	}
+}
+
+func TestNewListOptionsMarshalError(t *testing.T) {
+	client := linodego.NewClient(nil)
+
+	_, err := client.ListInstances(context.Background(), linodego.NewListOptions(0, And(Eq("id", func() {}))))
+	if err == nil {
+		t.Fatal("expected an error for an unmarshallable filter")
+	}
+}
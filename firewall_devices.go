@@ -3,6 +3,9 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -15,8 +18,14 @@ type FirewallDeviceType string
 const (
 	FirewallDeviceLinode       FirewallDeviceType = "linode"
 	FirewallDeviceNodeBalancer FirewallDeviceType = "nodebalancer"
+
+	// NOTE: Linode Interfaces may not currently be available to all users.
+	FirewallDeviceLinodeInterface FirewallDeviceType = "linode_interface"
 )
 
+// firewallDeviceAttachConcurrency bounds the number of in-flight requests made by AttachFirewallToEntities
+const firewallDeviceAttachConcurrency = 4
+
 // FirewallDevice represents a device governed by a Firewall
 type FirewallDevice struct {
 	ID      int                  `json:"id"`
@@ -25,6 +34,13 @@ type FirewallDevice struct {
 	Updated *time.Time           `json:"-"`
 }
 
+// FirewallDeviceAttachResult is the outcome of attaching a single device in AttachFirewallToEntities
+type FirewallDeviceAttachResult struct {
+	Options FirewallDeviceCreateOptions
+	Device  *FirewallDevice
+	Err     error
+}
+
 // FirewallDeviceCreateOptions fields are those accepted by CreateFirewallDevice
 type FirewallDeviceCreateOptions struct {
 	ID   int                `json:"id"`
@@ -98,3 +114,44 @@ func (c *Client) DeleteFirewallDevice(ctx context.Context, firewallID, deviceID
 	err := doDELETERequest(ctx, c, e)
 	return err
 }
+
+// AttachFirewallToEntities associates many Devices with a given Firewall, making a bounded number of
+// concurrent requests. A result is returned for every device in the order they were given, and the
+// returned error joins the errors of all devices that failed to attach.
+func (c *Client) AttachFirewallToEntities(
+	ctx context.Context, firewallID int, devices []FirewallDeviceCreateOptions,
+) ([]FirewallDeviceAttachResult, error) {
+	results := make([]FirewallDeviceAttachResult, len(devices))
+	sem := make(chan struct{}, firewallDeviceAttachConcurrency)
+
+	var wg sync.WaitGroup
+
+	for i, opts := range devices {
+		wg.Add(1)
+
+		go func(i int, opts FirewallDeviceCreateOptions) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			device, err := c.CreateFirewallDevice(ctx, firewallID, opts)
+			if err != nil {
+				err = fmt.Errorf("failed to attach %s %d to firewall %d: %w", opts.Type, opts.ID, firewallID, err)
+			}
+
+			results[i] = FirewallDeviceAttachResult{Options: opts, Device: device, Err: err}
+		}(i, opts)
+	}
+
+	wg.Wait()
+
+	errs := make([]error, 0)
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+		}
+	}
+
+	return results, errors.Join(errs...)
+}
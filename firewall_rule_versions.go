@@ -0,0 +1,84 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// FirewallRuleVersionInfo describes a version of a Firewall's rules in the Firewall's history
+type FirewallRuleVersionInfo struct {
+	Version int            `json:"-"`
+	Status  FirewallStatus `json:"status"`
+	Updated *time.Time     `json:"-"`
+}
+
+// FirewallRuleVersion is the FirewallRuleSet of a Firewall at a version in its history
+type FirewallRuleVersion struct {
+	FirewallRuleSet
+
+	Version     int    `json:"version"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// UnmarshalJSON for FirewallRuleVersionInfo responses
+func (v *FirewallRuleVersionInfo) UnmarshalJSON(b []byte) error {
+	type Mask FirewallRuleVersionInfo
+
+	p := struct {
+		*Mask
+		Updated *parseabletime.ParseableTime `json:"updated"`
+		Rules   struct {
+			Version int `json:"version"`
+		} `json:"rules"`
+	}{
+		Mask: (*Mask)(v),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	v.Version = p.Rules.Version
+	v.Updated = (*time.Time)(p.Updated)
+
+	return nil
+}
+
+// ListFirewallRuleVersions lists the versions of the Firewall's rules, each of which is
+// created when the rules are updated
+func (c *Client) ListFirewallRuleVersions(ctx context.Context, firewallID int, opts *ListOptions) ([]FirewallRuleVersionInfo, error) {
+	e := formatAPIPath("networking/firewalls/%d/history", firewallID)
+	return getPaginatedResults[FirewallRuleVersionInfo](ctx, c, e, opts)
+}
+
+// GetFirewallRuleVersion gets the Firewall's rules as they were at the given version
+func (c *Client) GetFirewallRuleVersion(ctx context.Context, firewallID int, version int) (*FirewallRuleVersion, error) {
+	e := formatAPIPath("networking/firewalls/%d/history/rules/%d", firewallID, version)
+
+	response, err := doGETRequest[FirewallRuleVersion](ctx, c, e)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, fmt.Errorf("firewall %d has no rule version %d: %w", firewallID, version, err)
+		}
+
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// RevertFirewallRules reapplies the Firewall's rules as they were at the given version,
+// which creates a new version of its rules. Errors for versions that do not exist
+// satisfy IsNotFound.
+func (c *Client) RevertFirewallRules(ctx context.Context, firewallID int, version int) (*FirewallRuleSet, error) {
+	rules, err := c.GetFirewallRuleVersion(ctx, firewallID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.UpdateFirewallRules(ctx, firewallID, rules.FirewallRuleSet)
+}
@@ -8,13 +8,13 @@ require (
 	golang.org/x/oauth2 v0.23.0
 	golang.org/x/text v0.19.0
 	gopkg.in/ini.v1 v1.66.6
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/stretchr/testify v1.9.0
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 go 1.22
@@ -3,7 +3,9 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
+	"slices"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -20,6 +22,15 @@ const (
 	ImageStatusAvailable     ImageStatus = "available"
 )
 
+// ImageType represents the type of an Image.
+type ImageType string
+
+// ImageType options start with ImageType and include all Image types
+const (
+	ImageTypeManual    ImageType = "manual"
+	ImageTypeAutomatic ImageType = "automatic"
+)
+
 // ImageRegionStatus represents the status of an Image's replica.
 type ImageRegionStatus string
 
@@ -143,7 +154,8 @@ func (i Image) GetUpdateOptions() (iu ImageUpdateOptions) {
 	return
 }
 
-// ListImages lists Images.
+// ListImages lists Images. Images can be filtered by type, e.g. using
+// filter.Eq("type", ImageTypeAutomatic).
 func (c *Client) ListImages(ctx context.Context, opts *ListOptions) ([]Image, error) {
 	return getPaginatedResults[Image](
 		ctx,
@@ -247,3 +259,47 @@ func (c *Client) UploadImage(ctx context.Context, opts ImageUploadOptions) (*Ima
 
 	return image, c.UploadImageToURL(ctx, uploadURL, opts.Image)
 }
+
+// DeleteExpiredAutomaticImages deletes the automatic (recovery) Images created more than olderThan ago,
+// returning the Images deleted. Images with a replica which is still replicating are skipped.
+// If dryRun is set, the Images which would be deleted are returned without deleting them.
+// If a deletion fails, the Images deleted so far are returned along with the error.
+func (c *Client) DeleteExpiredAutomaticImages(ctx context.Context, olderThan time.Duration, dryRun bool) ([]Image, error) {
+	f := Filter{}
+	f.AddField(Eq, "type", ImageTypeAutomatic)
+
+	images, err := c.ListImages(ctx, NewListOptions(0, &f))
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(-olderThan)
+
+	var deleted []Image
+
+	for _, image := range images {
+		if !image.isExpiredAutomatic(cutoff) || image.isReplicating() {
+			continue
+		}
+
+		if !dryRun {
+			if err := c.DeleteImage(ctx, image.ID); err != nil {
+				return deleted, fmt.Errorf("failed to delete image %s: %w", image.ID, err)
+			}
+		}
+
+		deleted = append(deleted, image)
+	}
+
+	return deleted, nil
+}
+
+func (i Image) isExpiredAutomatic(cutoff time.Time) bool {
+	return i.Type == string(ImageTypeAutomatic) && i.Created != nil && i.Created.Before(cutoff)
+}
+
+func (i Image) isReplicating() bool {
+	return slices.ContainsFunc(i.Regions, func(r ImageRegion) bool {
+		return r.Status == ImageRegionStatusReplicating || r.Status == ImageRegionStatusPendingReplication
+	})
+}
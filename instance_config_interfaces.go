@@ -2,6 +2,11 @@ package linodego
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
 )
 
 // InstanceConfigInterface contains information about a configuration's network interface
@@ -16,6 +21,9 @@ type InstanceConfigInterface struct {
 	SubnetID    *int                   `json:"subnet_id"`
 	IPv4        *VPCIPv4               `json:"ipv4"`
 	IPRanges    []string               `json:"ip_ranges"`
+
+	// NOTE: IPv6 VPCs may not currently be available to all users.
+	IPv6 *InstanceConfigInterfaceIPv6 `json:"ipv6"`
 }
 
 type VPCIPv4 struct {
@@ -23,6 +31,49 @@ type VPCIPv4 struct {
 	NAT1To1 *string `json:"nat_1_1,omitempty"`
 }
 
+// InstanceConfigInterfaceIPv6 contains the IPv6 configuration of a VPC interface
+type InstanceConfigInterfaceIPv6 struct {
+	SLAAC    []InstanceConfigInterfaceIPv6SLAAC `json:"slaac"`
+	Ranges   []InstanceConfigInterfaceIPv6Range `json:"ranges"`
+	IsPublic bool                               `json:"is_public"`
+}
+
+// InstanceConfigInterfaceIPv6SLAAC is an IPv6 SLAAC range and the address assigned to the interface from it
+type InstanceConfigInterfaceIPv6SLAAC struct {
+	Range   string `json:"range"`
+	Address string `json:"address"`
+}
+
+// InstanceConfigInterfaceIPv6Range is an IPv6 range routed to an interface
+type InstanceConfigInterfaceIPv6Range struct {
+	Range string `json:"range"`
+}
+
+// InstanceConfigInterfaceIPv6Options are the IPv6 settings that can be used when creating or updating a VPC interface
+type InstanceConfigInterfaceIPv6Options struct {
+	SLAAC    *[]InstanceConfigInterfaceIPv6SLAACOptions `json:"slaac,omitempty"`
+	Ranges   *[]InstanceConfigInterfaceIPv6RangeOptions `json:"ranges,omitempty"`
+	IsPublic *bool                                      `json:"is_public,omitempty"`
+}
+
+// InstanceConfigInterfaceIPv6SLAACOptions are the SLAAC range settings of InstanceConfigInterfaceIPv6Options
+type InstanceConfigInterfaceIPv6SLAACOptions struct {
+	Range string `json:"range,omitempty"`
+}
+
+// InstanceConfigInterfaceIPv6RangeOptions are the range settings of InstanceConfigInterfaceIPv6Options
+type InstanceConfigInterfaceIPv6RangeOptions struct {
+	Range string `json:"range,omitempty"`
+}
+
+// InstanceConfigInterfaceIPv6RangeBinding describes the config interface an IPv6 range is routed to
+type InstanceConfigInterfaceIPv6RangeBinding struct {
+	Range       string
+	LinodeID    int
+	ConfigID    int
+	InterfaceID int
+}
+
 type InstanceConfigInterfaceCreateOptions struct {
 	IPAMAddress string                 `json:"ipam_address,omitempty"`
 	Label       string                 `json:"label,omitempty"`
@@ -31,12 +82,18 @@ type InstanceConfigInterfaceCreateOptions struct {
 	SubnetID    *int                   `json:"subnet_id,omitempty"`
 	IPv4        *VPCIPv4               `json:"ipv4,omitempty"`
 	IPRanges    []string               `json:"ip_ranges,omitempty"`
+
+	// NOTE: IPv6 VPCs may not currently be available to all users.
+	IPv6 *InstanceConfigInterfaceIPv6Options `json:"ipv6,omitempty"`
 }
 
 type InstanceConfigInterfaceUpdateOptions struct {
 	Primary  bool      `json:"primary,omitempty"`
 	IPv4     *VPCIPv4  `json:"ipv4,omitempty"`
 	IPRanges *[]string `json:"ip_ranges,omitempty"`
+
+	// NOTE: IPv6 VPCs may not currently be available to all users.
+	IPv6 *InstanceConfigInterfaceIPv6Options `json:"ipv6,omitempty"`
 }
 
 type InstanceConfigInterfacesReorderOptions struct {
@@ -72,6 +129,10 @@ func (i InstanceConfigInterface) GetCreateOptions() InstanceConfigInterfaceCreat
 		}
 	}
 
+	if i.Purpose == InterfacePurposeVPC && i.IPv6 != nil {
+		opts.IPv6 = i.IPv6.getOptions()
+	}
+
 	opts.IPAMAddress = i.IPAMAddress
 
 	return opts
@@ -98,9 +159,136 @@ func (i InstanceConfigInterface) GetUpdateOptions() InstanceConfigInterfaceUpdat
 		opts.IPRanges = &copiedIPRanges
 	}
 
+	if i.Purpose == InterfacePurposeVPC && i.IPv6 != nil {
+		opts.IPv6 = i.IPv6.getOptions()
+	}
+
 	return opts
 }
 
+// getOptions converts the IPv6 configuration of an interface to InstanceConfigInterfaceIPv6Options
+func (i InstanceConfigInterfaceIPv6) getOptions() *InstanceConfigInterfaceIPv6Options {
+	slaac := make([]InstanceConfigInterfaceIPv6SLAACOptions, len(i.SLAAC))
+	for index, s := range i.SLAAC {
+		slaac[index] = InstanceConfigInterfaceIPv6SLAACOptions{Range: s.Range}
+	}
+
+	ranges := make([]InstanceConfigInterfaceIPv6RangeOptions, len(i.Ranges))
+	for index, r := range i.Ranges {
+		ranges[index] = InstanceConfigInterfaceIPv6RangeOptions{Range: r.Range}
+	}
+
+	return &InstanceConfigInterfaceIPv6Options{
+		SLAAC:    &slaac,
+		Ranges:   &ranges,
+		IsPublic: copyBool(&i.IsPublic),
+	}
+}
+
+// VPCInterfaceOptions builds the InstanceConfigInterfaceCreateOptions for a VPC interface.
+// Use NewVPCInterfaceOptions to create one.
+type VPCInterfaceOptions struct {
+	subnetID int
+	primary  bool
+	address  string
+	nat1To1  *string
+	ipRanges []string
+}
+
+// NewVPCInterfaceOptions returns a VPCInterfaceOptions for an interface on the given VPC subnet
+func NewVPCInterfaceOptions(subnetID int) *VPCInterfaceOptions {
+	return &VPCInterfaceOptions{subnetID: subnetID}
+}
+
+// Primary sets whether the interface is the primary interface of the config
+func (o *VPCInterfaceOptions) Primary(primary bool) *VPCInterfaceOptions {
+	o.primary = primary
+	return o
+}
+
+// Address sets the IPv4 address of the interface within the VPC subnet
+func (o *VPCInterfaceOptions) Address(address string) *VPCInterfaceOptions {
+	o.address = address
+	return o
+}
+
+// NAT1To1 sets the public IPv4 address mapped 1:1 to the interface's VPC address
+func (o *VPCInterfaceOptions) NAT1To1(address string) *VPCInterfaceOptions {
+	o.nat1To1 = &address
+	return o
+}
+
+// NAT1To1Any maps any available public IPv4 address of the Linode 1:1 to the interface's VPC address
+func (o *VPCInterfaceOptions) NAT1To1Any() *VPCInterfaceOptions {
+	return o.NAT1To1("any")
+}
+
+// IPRanges adds IPv4 ranges in CIDR notation to be routed to the interface
+func (o *VPCInterfaceOptions) IPRanges(ranges ...string) *VPCInterfaceOptions {
+	o.ipRanges = append(o.ipRanges, ranges...)
+	return o
+}
+
+// Build validates the options and returns them as InstanceConfigInterfaceCreateOptions
+func (o *VPCInterfaceOptions) Build() (InstanceConfigInterfaceCreateOptions, error) {
+	if err := o.validate(); err != nil {
+		return InstanceConfigInterfaceCreateOptions{}, err
+	}
+
+	opts := InstanceConfigInterfaceCreateOptions{
+		Purpose:  InterfacePurposeVPC,
+		Primary:  o.primary,
+		SubnetID: copyInt(&o.subnetID),
+	}
+
+	if o.address != "" || o.nat1To1 != nil {
+		opts.IPv4 = &VPCIPv4{
+			VPC:     o.address,
+			NAT1To1: copyString(o.nat1To1),
+		}
+	}
+
+	if len(o.ipRanges) > 0 {
+		opts.IPRanges = append([]string(nil), o.ipRanges...)
+	}
+
+	return opts, nil
+}
+
+// validate returns an error for combinations of options that the API is known to reject
+func (o *VPCInterfaceOptions) validate() error {
+	if o.subnetID <= 0 {
+		return fmt.Errorf("invalid VPC subnet ID %d", o.subnetID)
+	}
+
+	if o.address != "" && !isIPv4(o.address) {
+		return fmt.Errorf("invalid VPC IPv4 address %q", o.address)
+	}
+
+	if o.nat1To1 != nil {
+		if o.address == "" {
+			return fmt.Errorf("NAT 1:1 requires a VPC IPv4 address")
+		}
+
+		if *o.nat1To1 != "any" && !isIPv4(*o.nat1To1) {
+			return fmt.Errorf("invalid NAT 1:1 address %q, expected an IPv4 address or \"any\"", *o.nat1To1)
+		}
+	}
+
+	for _, r := range o.ipRanges {
+		if ip, _, err := net.ParseCIDR(r); err != nil || ip.To4() == nil {
+			return fmt.Errorf("invalid IPv4 range %q", r)
+		}
+	}
+
+	return nil
+}
+
+func isIPv4(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.To4() != nil
+}
+
 func (c *Client) AppendInstanceConfigInterface(
 	ctx context.Context,
 	linodeID int,
@@ -206,3 +394,251 @@ func (c *Client) ReorderInstanceConfigInterfaces(
 
 	return err
 }
+
+// ListInstanceConfigInterfacesByPosition returns the interfaces of a config in order of their
+// position, i.e. the first interface is eth0 and the last is ethN. The IDs of a reordered slice
+// of the result can be passed to SetInstanceConfigInterfaceOrder.
+func (c *Client) ListInstanceConfigInterfacesByPosition(
+	ctx context.Context,
+	linodeID int,
+	configID int,
+) ([]InstanceConfigInterface, error) {
+	config, err := c.GetInstanceConfig(ctx, linodeID, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The position of each interface is its index in the config's interfaces
+	return config.Interfaces, nil
+}
+
+// SetInstanceConfigInterfaceOrder reorders the interfaces of a config to match the given IDs,
+// the first of which becomes eth0. Unlike ReorderInstanceConfigInterfaces, the IDs are first
+// checked to be exactly those of the config's current interfaces, returning an error naming
+// any missing, unexpected or duplicate IDs rather than sending a request the API would reject.
+func (c *Client) SetInstanceConfigInterfaceOrder(
+	ctx context.Context,
+	linodeID int,
+	configID int,
+	ids []int,
+) error {
+	interfaces, err := c.ListInstanceConfigInterfacesByPosition(ctx, linodeID, configID)
+	if err != nil {
+		return err
+	}
+
+	current := make([]int, len(interfaces))
+	for i, iface := range interfaces {
+		current[i] = iface.ID
+	}
+
+	missing, unexpected, duplicate := diffInterfaceIDs(current, ids)
+	if len(missing) > 0 || len(unexpected) > 0 || len(duplicate) > 0 {
+		return fmt.Errorf(
+			"interface IDs must match those of config %d %v: missing %v, unexpected %v, duplicate %v",
+			configID, current, missing, unexpected, duplicate,
+		)
+	}
+
+	return c.ReorderInstanceConfigInterfaces(ctx, linodeID, configID, InstanceConfigInterfacesReorderOptions{
+		IDs: ids,
+	})
+}
+
+// diffInterfaceIDs returns the IDs in current that are not in ids, the IDs in ids
+// that are not in current, and the IDs in current that are repeated in ids.
+func diffInterfaceIDs(current, ids []int) (missing, unexpected, duplicate []int) {
+	known := make(map[int]bool, len(current))
+	for _, id := range current {
+		known[id] = true
+	}
+
+	missing = make([]int, 0)
+	unexpected = make([]int, 0)
+	duplicate = make([]int, 0)
+
+	seen := make(map[int]bool, len(ids))
+
+	for _, id := range ids {
+		switch {
+		case !known[id]:
+			if !slices.Contains(unexpected, id) {
+				unexpected = append(unexpected, id)
+			}
+		case seen[id]:
+			if !slices.Contains(duplicate, id) {
+				duplicate = append(duplicate, id)
+			}
+		default:
+			seen[id] = true
+		}
+	}
+
+	for _, id := range current {
+		if !seen[id] {
+			missing = append(missing, id)
+		}
+	}
+
+	return missing, unexpected, duplicate
+}
+
+// ListInstanceInterfaceIPv6Ranges lists the IPv6 ranges routed to the config interfaces of a Linode
+// instance, with the Interface of each range set to the interface it is routed to.
+// NOTE: IPv6 VPCs may not currently be available to all users.
+func (c *Client) ListInstanceInterfaceIPv6Ranges(ctx context.Context, linodeID int) ([]IPv6Range, error) {
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	configs, err := c.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]IPv6Range, 0)
+
+	for _, config := range configs {
+		for _, iface := range config.Interfaces {
+			if iface.IPv6 == nil {
+				continue
+			}
+
+			for _, r := range iface.IPv6.Ranges {
+				ipRange := IPv6Range{
+					Range:  r.Range,
+					Region: instance.Region,
+					Interface: &InstanceConfigInterfaceIPv6RangeBinding{
+						Range:       r.Range,
+						LinodeID:    linodeID,
+						ConfigID:    config.ID,
+						InterfaceID: iface.ID,
+					},
+				}
+
+				// Interfaces list ranges in CIDR notation, unlike the IPv6 range endpoints
+				if prefix, err := netip.ParsePrefix(r.Range); err == nil {
+					ipRange.Range = prefix.Addr().String()
+					ipRange.Prefix = prefix.Bits()
+				}
+
+				result = append(result, ipRange)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// RebindInstanceConfigInterfaceIPv6Range moves an IPv6 range from the interface it is currently routed to,
+// e.g. the Interface of a range listed by ListInstanceInterfaceIPv6Ranges, to the given target interface.
+// Both interfaces must be VPC interfaces in the same region. As a range can only be routed
+// to one interface at a time, it is removed from the source interface before being added to the target;
+// if adding it to the target fails, it is restored to the source interface.
+// NOTE: IPv6 VPCs may not currently be available to all users.
+func (c *Client) RebindInstanceConfigInterfaceIPv6Range(
+	ctx context.Context,
+	from InstanceConfigInterfaceIPv6RangeBinding,
+	linodeID int,
+	configID int,
+	interfaceID int,
+) (*InstanceConfigInterface, error) {
+	source, err := c.GetInstanceConfigInterface(ctx, from.LinodeID, from.ConfigID, from.InterfaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if source.Purpose != InterfacePurposeVPC || source.IPv6 == nil {
+		return nil, fmt.Errorf("interface %d has no IPv6 configuration", from.InterfaceID)
+	}
+
+	target, err := c.GetInstanceConfigInterface(ctx, linodeID, configID, interfaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.Purpose != InterfacePurposeVPC {
+		return nil, fmt.Errorf("interface %d is not a VPC interface and cannot have IPv6 ranges", interfaceID)
+	}
+
+	if err := c.checkInstancesInSameRegion(ctx, from.LinodeID, linodeID); err != nil {
+		return nil, err
+	}
+
+	sourceOpts := source.GetUpdateOptions()
+
+	original := make([]InstanceConfigInterfaceIPv6RangeOptions, 0, len(source.IPv6.Ranges))
+	ranges := make([]InstanceConfigInterfaceIPv6RangeOptions, 0, len(source.IPv6.Ranges))
+
+	for _, r := range source.IPv6.Ranges {
+		original = append(original, InstanceConfigInterfaceIPv6RangeOptions{Range: r.Range})
+
+		if r.Range != from.Range {
+			ranges = append(ranges, InstanceConfigInterfaceIPv6RangeOptions{Range: r.Range})
+		}
+	}
+
+	sourceOpts.IPv6.Ranges = &ranges
+
+	if _, err := c.UpdateInstanceConfigInterface(
+		ctx, from.LinodeID, from.ConfigID, from.InterfaceID, sourceOpts,
+	); err != nil {
+		return nil, err
+	}
+
+	targetOpts := target.GetUpdateOptions()
+	if targetOpts.IPv6 == nil {
+		targetOpts.IPv6 = &InstanceConfigInterfaceIPv6Options{}
+	}
+
+	targetRanges := []InstanceConfigInterfaceIPv6RangeOptions{{Range: from.Range}}
+	if targetOpts.IPv6.Ranges != nil {
+		targetRanges = append(*targetOpts.IPv6.Ranges, targetRanges...)
+	}
+
+	targetOpts.IPv6.Ranges = &targetRanges
+
+	result, err := c.UpdateInstanceConfigInterface(ctx, linodeID, configID, interfaceID, targetOpts)
+	if err != nil {
+		sourceOpts.IPv6.Ranges = &original
+
+		if _, rollbackErr := c.UpdateInstanceConfigInterface(
+			ctx, from.LinodeID, from.ConfigID, from.InterfaceID, sourceOpts,
+		); rollbackErr != nil {
+			return nil, errors.Join(err, fmt.Errorf(
+				"failed to restore IPv6 range %s to interface %d: %w", from.Range, from.InterfaceID, rollbackErr,
+			))
+		}
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// checkInstancesInSameRegion returns an error if the given Linode instances are in different regions
+func (c *Client) checkInstancesInSameRegion(ctx context.Context, linodeID, otherLinodeID int) error {
+	if linodeID == otherLinodeID {
+		return nil
+	}
+
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return err
+	}
+
+	other, err := c.GetInstance(ctx, otherLinodeID)
+	if err != nil {
+		return err
+	}
+
+	if instance.Region != other.Region {
+		return fmt.Errorf(
+			"instance %d in %s and instance %d in %s must be in the same region",
+			linodeID, instance.Region, otherLinodeID, other.Region,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// defaultVPCMigrationRebootTimeout is used to wait for the reboot requested by
+// VPCMigrationOptions.Reboot when the context has no deadline
+const defaultVPCMigrationRebootTimeout = 10 * time.Minute
+
+// VPCMigrationOptions are the options used by MigrateConfigToVPC
+type VPCMigrationOptions struct {
+	// SubnetID is the VPC subnet the config's primary interface is attached to
+	SubnetID int
+
+	// KeepPublic keeps a public interface on the config as its second interface
+	KeepPublic bool
+
+	// NAT1To1 is the public IPv4 address mapped 1:1 to the VPC interface's address,
+	// or "any" to use any public IPv4 address of the Linode. No address is mapped if empty.
+	NAT1To1 string
+
+	// Reboot reboots the Linode into the config once it has been updated, waiting for
+	// it to be running again. The Linode is not rebooted if the config was already migrated.
+	Reboot bool
+}
+
+// MigrateConfigToVPC rewrites the interfaces of a config so that its primary interface is on
+// the given VPC subnet, optionally followed by a public interface. VLAN interfaces of the config
+// are kept after them. The config is read back once updated to verify its interfaces.
+//
+// The migration is idempotent: if the config's interfaces already match the options, the
+// config is neither updated nor rebooted. An error is returned without making any changes
+// if any config of the Linode has a VPC interface on a different subnet.
+func (c *Client) MigrateConfigToVPC(
+	ctx context.Context,
+	linodeID int,
+	configID int,
+	opts VPCMigrationOptions,
+) (*InstanceConfig, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	configs, err := c.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkVPCMigrationSubnet(configs, opts.SubnetID); err != nil {
+		return nil, fmt.Errorf("refusing to migrate config %d of instance %d: %w", configID, linodeID, err)
+	}
+
+	config, err := c.GetInstanceConfig(ctx, linodeID, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces := vpcMigrationInterfaces(config.Interfaces, opts)
+	if reflect.DeepEqual(getInstanceConfigInterfacesCreateOptionsList(config.Interfaces), interfaces) {
+		return config, nil
+	}
+
+	updateOpts := config.GetUpdateOptions()
+	updateOpts.Interfaces = interfaces
+
+	if _, err := c.UpdateInstanceConfig(ctx, linodeID, configID, updateOpts); err != nil {
+		return nil, err
+	}
+
+	if opts.Reboot {
+		timeout := defaultVPCMigrationRebootTimeout
+		if deadline, ok := ctx.Deadline(); ok {
+			timeout = time.Until(deadline)
+		}
+
+		if _, err := c.RebootInstanceAndWait(ctx, linodeID, configID, max(int(timeout.Seconds()), 1)); err != nil {
+			return nil, err
+		}
+	}
+
+	config, err = c.GetInstanceConfig(ctx, linodeID, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyVPCMigration(config.Interfaces, opts); err != nil {
+		return nil, fmt.Errorf("config %d of instance %d was not migrated: %w", configID, linodeID, err)
+	}
+
+	return config, nil
+}
+
+func (opts VPCMigrationOptions) validate() error {
+	if opts.SubnetID <= 0 {
+		return fmt.Errorf("invalid VPC subnet ID %d", opts.SubnetID)
+	}
+
+	if opts.NAT1To1 != "" && opts.NAT1To1 != "any" && !isIPv4(opts.NAT1To1) {
+		return fmt.Errorf("invalid NAT 1:1 address %q, expected an IPv4 address or \"any\"", opts.NAT1To1)
+	}
+
+	return nil
+}
+
+// checkVPCMigrationSubnet returns an error if any of the configs has a VPC interface
+// on a subnet other than subnetID
+func checkVPCMigrationSubnet(configs []InstanceConfig, subnetID int) error {
+	for _, config := range configs {
+		for _, iface := range config.Interfaces {
+			if iface.Purpose == InterfacePurposeVPC && iface.SubnetID != nil && *iface.SubnetID != subnetID {
+				return fmt.Errorf(
+					"config %d has a VPC interface on subnet %d, not subnet %d",
+					config.ID, *iface.SubnetID, subnetID,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// vpcMigrationInterfaces returns the interfaces of a config migrated according to opts.
+// The settings of any existing VPC interface on the subnet, such as its address, are kept
+// so that migrating a config twice results in the same interfaces.
+func vpcMigrationInterfaces(current []InstanceConfigInterface, opts VPCMigrationOptions) []InstanceConfigInterfaceCreateOptions {
+	vpc := InstanceConfigInterfaceCreateOptions{
+		Purpose:  InterfacePurposeVPC,
+		SubnetID: copyInt(&opts.SubnetID),
+	}
+
+	var vlans []InstanceConfigInterfaceCreateOptions
+
+	for _, iface := range current {
+		switch iface.Purpose {
+		case InterfacePurposeVPC:
+			if iface.SubnetID != nil && *iface.SubnetID == opts.SubnetID {
+				vpc = iface.GetCreateOptions()
+			}
+		case InterfacePurposeVLAN:
+			vlans = append(vlans, iface.GetCreateOptions())
+		}
+	}
+
+	vpc.Primary = true
+
+	var existingNAT *string
+	if vpc.IPv4 != nil {
+		existingNAT = vpc.IPv4.NAT1To1
+	}
+
+	var nat *string
+
+	switch {
+	case opts.NAT1To1 == "":
+	case opts.NAT1To1 == "any" && existingNAT != nil && *existingNAT != "":
+		// The API reports the address chosen for "any", which is kept
+		nat = copyString(existingNAT)
+	default:
+		nat = copyString(&opts.NAT1To1)
+	}
+
+	switch {
+	case nat != nil:
+		if vpc.IPv4 == nil {
+			vpc.IPv4 = &VPCIPv4{}
+		}
+
+		vpc.IPv4.NAT1To1 = nat
+	case vpc.IPv4 != nil:
+		vpc.IPv4.NAT1To1 = nil
+		if vpc.IPv4.VPC == "" {
+			vpc.IPv4 = nil
+		}
+	}
+
+	result := []InstanceConfigInterfaceCreateOptions{vpc}
+
+	if opts.KeepPublic {
+		result = append(result, InstanceConfigInterfaceCreateOptions{Purpose: InterfacePurposePublic})
+	}
+
+	return append(result, vlans...)
+}
+
+// verifyVPCMigration returns an error if the interfaces of a config do not match
+// those requested by opts
+func verifyVPCMigration(interfaces []InstanceConfigInterface, opts VPCMigrationOptions) error {
+	if len(interfaces) == 0 {
+		return fmt.Errorf("config has no interfaces")
+	}
+
+	primary := interfaces[0]
+	if primary.Purpose != InterfacePurposeVPC || primary.SubnetID == nil || *primary.SubnetID != opts.SubnetID {
+		return fmt.Errorf("first interface is not a VPC interface on subnet %d", opts.SubnetID)
+	}
+
+	if !primary.Primary {
+		return fmt.Errorf("VPC interface %d is not the primary interface", primary.ID)
+	}
+
+	if opts.NAT1To1 != "" {
+		if primary.IPv4 == nil || primary.IPv4.NAT1To1 == nil || *primary.IPv4.NAT1To1 == "" {
+			return fmt.Errorf("VPC interface %d has no NAT 1:1 address", primary.ID)
+		}
+
+		if opts.NAT1To1 != "any" && *primary.IPv4.NAT1To1 != opts.NAT1To1 {
+			return fmt.Errorf("VPC interface %d has NAT 1:1 address %s, not %s", primary.ID, *primary.IPv4.NAT1To1, opts.NAT1To1)
+		}
+	}
+
+	if opts.KeepPublic && (len(interfaces) < 2 || interfaces[1].Purpose != InterfacePurposePublic) {
+		return fmt.Errorf("second interface is not a public interface")
+	}
+
+	if !opts.KeepPublic {
+		for _, iface := range interfaces {
+			if iface.Purpose == InterfacePurposePublic {
+				return fmt.Errorf("public interface %d was not removed", iface.ID)
+			}
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -44,6 +46,52 @@ type InstanceConfigDeviceMap struct {
 	SDH *InstanceConfigDevice `json:"sdh,omitempty"`
 }
 
+// namedDevices returns the populated devices in the map keyed by device name, ordered sda through sdh
+func (m InstanceConfigDeviceMap) namedDevices() []namedConfigDevice {
+	all := []namedConfigDevice{
+		{"sda", m.SDA}, {"sdb", m.SDB}, {"sdc", m.SDC}, {"sdd", m.SDD},
+		{"sde", m.SDE}, {"sdf", m.SDF}, {"sdg", m.SDG}, {"sdh", m.SDH},
+	}
+
+	result := make([]namedConfigDevice, 0, len(all))
+	for _, d := range all {
+		if d.Device != nil {
+			result = append(result, d)
+		}
+	}
+
+	return result
+}
+
+// slot returns a pointer to the device field with the given name (e.g. "sda"), or nil if there is none
+func (m *InstanceConfigDeviceMap) slot(name string) **InstanceConfigDevice {
+	switch name {
+	case "sda":
+		return &m.SDA
+	case "sdb":
+		return &m.SDB
+	case "sdc":
+		return &m.SDC
+	case "sdd":
+		return &m.SDD
+	case "sde":
+		return &m.SDE
+	case "sdf":
+		return &m.SDF
+	case "sdg":
+		return &m.SDG
+	case "sdh":
+		return &m.SDH
+	}
+
+	return nil
+}
+
+type namedConfigDevice struct {
+	Name   string
+	Device *InstanceConfigDevice
+}
+
 // InstanceConfigHelpers are Instance Config options that control Linux distribution specific tweaks
 type InstanceConfigHelpers struct {
 	UpdateDBDisabled  bool `json:"updatedb_disabled"`
@@ -53,6 +101,16 @@ type InstanceConfigHelpers struct {
 	DevTmpFsAutomount bool `json:"devtmpfs_automount"`
 }
 
+// InstanceConfigHelpersOptions are the Instance Config helpers that can be set at creation or in updates.
+// Helpers left nil are omitted from the request so the server keeps its current value.
+type InstanceConfigHelpersOptions struct {
+	UpdateDBDisabled  *bool `json:"updatedb_disabled,omitempty"`
+	Distro            *bool `json:"distro,omitempty"`
+	ModulesDep        *bool `json:"modules_dep,omitempty"`
+	Network           *bool `json:"network,omitempty"`
+	DevTmpFsAutomount *bool `json:"devtmpfs_automount,omitempty"`
+}
+
 // ConfigInterfacePurpose options start with InterfacePurpose and include all known interface purpose types
 type ConfigInterfacePurpose string
 
@@ -67,7 +125,7 @@ type InstanceConfigCreateOptions struct {
 	Label       string                                 `json:"label,omitempty"`
 	Comments    string                                 `json:"comments,omitempty"`
 	Devices     InstanceConfigDeviceMap                `json:"devices"`
-	Helpers     *InstanceConfigHelpers                 `json:"helpers,omitempty"`
+	Helpers     *InstanceConfigHelpersOptions          `json:"helpers,omitempty"`
 	Interfaces  []InstanceConfigInterfaceCreateOptions `json:"interfaces"`
 	MemoryLimit int                                    `json:"memory_limit,omitempty"`
 	Kernel      string                                 `json:"kernel,omitempty"`
@@ -82,7 +140,7 @@ type InstanceConfigUpdateOptions struct {
 	Label      string                                 `json:"label,omitempty"`
 	Comments   string                                 `json:"comments"`
 	Devices    *InstanceConfigDeviceMap               `json:"devices,omitempty"`
-	Helpers    *InstanceConfigHelpers                 `json:"helpers,omitempty"`
+	Helpers    *InstanceConfigHelpersOptions          `json:"helpers,omitempty"`
 	Interfaces []InstanceConfigInterfaceCreateOptions `json:"interfaces"`
 	// MemoryLimit 0 means unlimitted, this is not omitted
 	MemoryLimit int    `json:"memory_limit"`
@@ -116,6 +174,21 @@ func (i *InstanceConfig) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// getOptions converts InstanceConfigHelpers to InstanceConfigHelpersOptions with every helper set
+func (h *InstanceConfigHelpers) getOptions() *InstanceConfigHelpersOptions {
+	if h == nil {
+		return nil
+	}
+
+	return &InstanceConfigHelpersOptions{
+		UpdateDBDisabled:  copyBool(&h.UpdateDBDisabled),
+		Distro:            copyBool(&h.Distro),
+		ModulesDep:        copyBool(&h.ModulesDep),
+		Network:           copyBool(&h.Network),
+		DevTmpFsAutomount: copyBool(&h.DevTmpFsAutomount),
+	}
+}
+
 // GetCreateOptions converts a InstanceConfig to InstanceConfigCreateOptions for use in CreateInstanceConfig
 func (i InstanceConfig) GetCreateOptions() InstanceConfigCreateOptions {
 	initrd := 0
@@ -126,7 +199,7 @@ func (i InstanceConfig) GetCreateOptions() InstanceConfigCreateOptions {
 		Label:       i.Label,
 		Comments:    i.Comments,
 		Devices:     *i.Devices,
-		Helpers:     i.Helpers,
+		Helpers:     i.Helpers.getOptions(),
 		Interfaces:  getInstanceConfigInterfacesCreateOptionsList(i.Interfaces),
 		MemoryLimit: i.MemoryLimit,
 		Kernel:      i.Kernel,
@@ -143,7 +216,7 @@ func (i InstanceConfig) GetUpdateOptions() InstanceConfigUpdateOptions {
 		Label:       i.Label,
 		Comments:    i.Comments,
 		Devices:     i.Devices,
-		Helpers:     i.Helpers,
+		Helpers:     i.Helpers.getOptions(),
 		Interfaces:  getInstanceConfigInterfacesCreateOptionsList(i.Interfaces),
 		MemoryLimit: i.MemoryLimit,
 		Kernel:      i.Kernel,
@@ -202,9 +275,94 @@ func (c *Client) RenameInstanceConfig(ctx context.Context, linodeID int, configI
 	return c.UpdateInstanceConfig(ctx, linodeID, configID, InstanceConfigUpdateOptions{Label: label})
 }
 
+// UpdateInstanceConfigHelpers updates only the helpers of an InstanceConfig.
+// Helpers left nil in the given options keep their current value. Unlike UpdateInstanceConfig,
+// no other config fields are sent.
+func (c *Client) UpdateInstanceConfigHelpers(
+	ctx context.Context,
+	linodeID, configID int,
+	helpers InstanceConfigHelpersOptions,
+) (*InstanceConfig, error) {
+	opts := map[string]any{
+		"helpers": helpers,
+	}
+
+	e := formatAPIPath("linode/instances/%d/configs/%d", linodeID, configID)
+	response, err := doPUTRequest[InstanceConfig](ctx, c, e, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 // DeleteInstanceConfig deletes a Linode InstanceConfig
 func (c *Client) DeleteInstanceConfig(ctx context.Context, linodeID int, configID int) error {
 	e := formatAPIPath("linode/instances/%d/configs/%d", linodeID, configID)
 	err := doDELETERequest(ctx, c, e)
 	return err
 }
+
+// SwapInstanceConfigRootDisk updates the InstanceConfig to boot from the disk with the given id,
+// allowing blue/green style disk upgrades. If the disk is already attached to the config under
+// another device, it trades places with the current root disk. If reboot is true, the Instance
+// is rebooted into the updated config.
+func (c *Client) SwapInstanceConfigRootDisk(
+	ctx context.Context,
+	linodeID, configID, newRootDiskID int,
+	reboot bool,
+) (*InstanceConfig, error) {
+	disk, err := c.GetInstanceDisk(ctx, linodeID, newRootDiskID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case disk.Filesystem == FilesystemSwap || disk.Filesystem == FilesystemInitrd:
+		return nil, fmt.Errorf("disk %d has filesystem %s and is not bootable", disk.ID, disk.Filesystem)
+	case disk.Status != DiskReady:
+		return nil, fmt.Errorf("disk %d is not ready (status: %s)", disk.ID, disk.Status)
+	}
+
+	config, err := c.GetInstanceConfig(ctx, linodeID, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	var devices InstanceConfigDeviceMap
+	if config.Devices != nil {
+		devices = *config.Devices
+	}
+
+	rootName := strings.TrimPrefix(config.RootDevice, "/dev/")
+
+	rootSlot := devices.slot(rootName)
+	if rootSlot == nil {
+		return nil, fmt.Errorf("config %d has unsupported root device %q", configID, config.RootDevice)
+	}
+
+	for _, d := range devices.namedDevices() {
+		if d.Name != rootName && d.Device.DiskID == newRootDiskID {
+			*devices.slot(d.Name) = *rootSlot
+		}
+	}
+
+	*rootSlot = &InstanceConfigDevice{DiskID: newRootDiskID}
+
+	// The update options do not omit every unset field, so the rest of the config is resent unchanged
+	opts := config.GetUpdateOptions()
+	opts.Devices = &devices
+
+	updated, err := c.UpdateInstanceConfig(ctx, linodeID, configID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if reboot {
+		if err := c.RebootInstance(ctx, linodeID, configID); err != nil {
+			return updated, err
+		}
+	}
+
+	return updated, nil
+}
@@ -3,6 +3,8 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -65,6 +67,15 @@ type InstanceDiskUpdateOptions struct {
 	Label string `json:"label"`
 }
 
+// InstanceDiskCloneOptions are optional settings applied to a disk cloned by CloneInstanceDisk
+type InstanceDiskCloneOptions struct {
+	// Label is the label of the cloned disk, defaulting to that of the source disk
+	Label string
+
+	// Size is the size in MB of the cloned disk, defaulting to that of the source disk
+	Size int
+}
+
 // ListInstanceDisks lists InstanceDisks
 func (c *Client) ListInstanceDisks(ctx context.Context, linodeID int, opts *ListOptions) ([]InstanceDisk, error) {
 	response, err := getPaginatedResults[InstanceDisk](ctx, c, formatAPIPath("linode/instances/%d/disks", linodeID), opts)
@@ -110,6 +121,10 @@ func (c *Client) GetInstanceDisk(ctx context.Context, linodeID int, diskID int)
 
 // CreateInstanceDisk creates a new InstanceDisk for the given Instance
 func (c *Client) CreateInstanceDisk(ctx context.Context, linodeID int, opts InstanceDiskCreateOptions) (*InstanceDisk, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("linode/instances/%d/disks", linodeID)
 	response, err := doPOSTRequest[InstanceDisk](ctx, c, e, opts)
 	if err != nil {
@@ -119,6 +134,34 @@ func (c *Client) CreateInstanceDisk(ctx context.Context, linodeID int, opts Inst
 	return response, nil
 }
 
+// validate returns an error for combinations of options that the API is known to reject
+func (o InstanceDiskCreateOptions) validate() error {
+	filesystem := DiskFilesystem(o.Filesystem)
+	if filesystem != "" && !filesystem.IsValid() {
+		return fmt.Errorf("invalid disk filesystem %q", o.Filesystem)
+	}
+
+	if o.Image != "" {
+		switch filesystem {
+		case FilesystemRaw, FilesystemSwap, FilesystemInitrd:
+			return fmt.Errorf("disk filesystem %s cannot be used with image %s", filesystem, o.Image)
+		}
+
+		return nil
+	}
+
+	switch {
+	case o.RootPass != "":
+		return fmt.Errorf("disk root password requires an image")
+	case len(o.AuthorizedKeys) > 0 || len(o.AuthorizedUsers) > 0:
+		return fmt.Errorf("disk authorized keys and users require an image")
+	case o.StackscriptID != 0 || len(o.StackscriptData) > 0:
+		return fmt.Errorf("disk stackscript requires an image")
+	}
+
+	return nil
+}
+
 // UpdateInstanceDisk creates a new InstanceDisk for the given Instance
 func (c *Client) UpdateInstanceDisk(ctx context.Context, linodeID int, diskID int, opts InstanceDiskUpdateOptions) (*InstanceDisk, error) {
 	e := formatAPIPath("linode/instances/%d/disks/%d", linodeID, diskID)
@@ -165,3 +208,128 @@ func (c *Client) DeleteInstanceDisk(ctx context.Context, linodeID int, diskID in
 	err := doDELETERequest(ctx, c, e)
 	return err
 }
+
+// CloneInstanceDisk clones a disk of one Instance onto another Instance in the same region,
+// or onto the same Instance if sourceLinodeID and destLinodeID are equal, and returns the new disk.
+// The destination Instance must have enough unallocated disk space for the source disk, and for
+// the requested size if larger. If a label or size is given, CloneInstanceDisk waits for the clone
+// to complete before applying them, so the context should allow for this.
+func (c *Client) CloneInstanceDisk(
+	ctx context.Context,
+	sourceLinodeID, diskID, destLinodeID int,
+	opts InstanceDiskCloneOptions,
+) (*InstanceDisk, error) {
+	source, err := c.GetInstance(ctx, sourceLinodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	dest := source
+	if destLinodeID != sourceLinodeID {
+		if dest, err = c.GetInstance(ctx, destLinodeID); err != nil {
+			return nil, err
+		}
+	}
+
+	if source.Region != dest.Region {
+		return nil, fmt.Errorf(
+			"cannot clone disk %d of instance %d in %s to instance %d in %s: instances must be in the same region",
+			diskID, sourceLinodeID, source.Region, destLinodeID, dest.Region,
+		)
+	}
+
+	disk, err := c.GetInstanceDisk(ctx, sourceLinodeID, diskID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := c.ListInstanceDisks(ctx, destLinodeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkInstanceDiskSpace(dest, existing, max(disk.Size, opts.Size)); err != nil {
+		return nil, err
+	}
+
+	var clone *InstanceDisk
+
+	if destLinodeID == sourceLinodeID {
+		e := formatAPIPath("linode/instances/%d/disks/%d/clone", sourceLinodeID, diskID)
+		if clone, err = doPOSTRequest[InstanceDisk, any](ctx, c, e); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := c.CloneInstance(ctx, sourceLinodeID, InstanceCloneOptions{
+			LinodeID: destLinodeID,
+			Disks:    []int{diskID},
+		}); err != nil {
+			return nil, err
+		}
+
+		if clone, err = c.findClonedInstanceDisk(ctx, destLinodeID, existing); err != nil {
+			return nil, err
+		}
+	}
+
+	if (opts.Label == "" || opts.Label == clone.Label) && (opts.Size == 0 || opts.Size == clone.Size) {
+		return clone, nil
+	}
+
+	if clone, err = c.WaitForInstanceDiskStatusCtx(ctx, destLinodeID, clone.ID, DiskReady); err != nil {
+		return nil, err
+	}
+
+	if opts.Label != "" && opts.Label != clone.Label {
+		if clone, err = c.RenameInstanceDisk(ctx, destLinodeID, clone.ID, opts.Label); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Size != 0 && opts.Size != clone.Size {
+		if err := c.ResizeInstanceDisk(ctx, destLinodeID, clone.ID, opts.Size); err != nil {
+			return nil, err
+		}
+
+		return c.GetInstanceDisk(ctx, destLinodeID, clone.ID)
+	}
+
+	return clone, nil
+}
+
+// findClonedInstanceDisk returns the disk of an Instance which is not among the existing disks
+func (c *Client) findClonedInstanceDisk(ctx context.Context, linodeID int, existing []InstanceDisk) (*InstanceDisk, error) {
+	disks, err := c.ListInstanceDisks(ctx, linodeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, disk := range disks {
+		if !slices.ContainsFunc(existing, func(e InstanceDisk) bool { return e.ID == disk.ID }) {
+			return &disk, nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to find the cloned disk on instance %d", linodeID)
+}
+
+// checkInstanceDiskSpace returns an error if an Instance does not have size MB of unallocated disk space
+func checkInstanceDiskSpace(instance *Instance, disks []InstanceDisk, size int) error {
+	if instance.Specs == nil {
+		return nil
+	}
+
+	free := instance.Specs.Disk
+	for _, disk := range disks {
+		free -= disk.Size
+	}
+
+	if free < size {
+		return fmt.Errorf(
+			"instance %d has %d MB of unallocated disk space, but %d MB is required",
+			instance.ID, free, size,
+		)
+	}
+
+	return nil
+}
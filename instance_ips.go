@@ -2,6 +2,11 @@ package linodego
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
 )
 
 // InstanceIPAddressResponse contains the IPv4 and IPv6 details for an Instance
@@ -10,6 +15,16 @@ type InstanceIPAddressResponse struct {
 	IPv6 *InstanceIPv6Response `json:"ipv6"`
 }
 
+// PrimaryIPv6 returns the SLAAC address of an Instance, or an empty string
+// if the Instance has no IPv6 SLAAC address.
+func PrimaryIPv6(ips *InstanceIPAddressResponse) string {
+	if ips == nil || ips.IPv6 == nil || ips.IPv6.SLAAC == nil {
+		return ""
+	}
+
+	return ips.IPv6.SLAAC.Address
+}
+
 // InstanceIPv4Response contains the details of all IPv4 addresses associated with an Instance
 type InstanceIPv4Response struct {
 	Public   []*InstanceIP `json:"public"`
@@ -32,6 +47,32 @@ type InstanceIP struct {
 	Region     string             `json:"region"`
 	VPCNAT1To1 *InstanceIPNAT1To1 `json:"vpc_nat_1_1"`
 	Reserved   bool               `json:"reserved"`
+
+	// Created and Updated are only returned for some addresses, e.g. reserved IPs
+	Created *time.Time `json:"-"`
+	Updated *time.Time `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *InstanceIP) UnmarshalJSON(b []byte) error {
+	type Mask InstanceIP
+
+	p := struct {
+		*Mask
+		Created *parseabletime.ParseableTime `json:"created"`
+		Updated *parseabletime.ParseableTime `json:"updated"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.Created = (*time.Time)(p.Created)
+	i.Updated = (*time.Time)(p.Updated)
+
+	return nil
 }
 
 // VPCIP represents a private IP address in a VPC subnet with additional networking details
@@ -77,6 +118,10 @@ type IPv6Range struct {
 	// These fields are only returned by GetIPv6Range(...)
 	IsBGP   bool  `json:"is_bgp"`
 	Linodes []int `json:"linodes"`
+
+	// Interface is the VPC config interface the range is routed to. It is not returned
+	// by the API, and is only set by ListInstanceInterfaceIPv6Ranges(...)
+	Interface *InstanceConfigInterfaceIPv6RangeBinding `json:"-"`
 }
 
 type InstanceReserveIPOptions struct {
@@ -109,6 +154,10 @@ func (c *Client) GetInstanceIPAddresses(ctx context.Context, linodeID int) (*Ins
 
 // GetInstanceIPAddress gets the IPAddress for a Linode instance matching a supplied IP address
 func (c *Client) GetInstanceIPAddress(ctx context.Context, linodeID int, ipaddress string) (*InstanceIP, error) {
+	if err := validateIPAddress(ipaddress, false); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("linode/instances/%d/ips/%s", linodeID, ipaddress)
 	response, err := doGETRequest[InstanceIP](ctx, c, e)
 	if err != nil {
@@ -145,6 +194,22 @@ func (c *Client) UpdateInstanceIPAddress(ctx context.Context, linodeID int, ipAd
 	return response, nil
 }
 
+// UpdateInstanceSLAACRDNS sets the reverse DNS of an Instance's IPv6 SLAAC address,
+// which is the only IPv6 address on an Instance that RDNS applies to.
+func (c *Client) UpdateInstanceSLAACRDNS(ctx context.Context, linodeID int, rdns *string) (*InstanceIP, error) {
+	ips, err := c.GetInstanceIPAddresses(ctx, linodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	address := PrimaryIPv6(ips)
+	if address == "" {
+		return nil, fmt.Errorf("instance %d has no IPv6 SLAAC address", linodeID)
+	}
+
+	return c.UpdateInstanceIPAddress(ctx, linodeID, address, IPAddressUpdateOptions{RDNS: rdns})
+}
+
 func (c *Client) DeleteInstanceIPAddress(ctx context.Context, linodeID int, ipAddress string) error {
 	e := formatAPIPath("linode/instances/%d/ips/%s", linodeID, ipAddress)
 	err := doDELETERequest(ctx, c, e)
@@ -153,6 +218,10 @@ func (c *Client) DeleteInstanceIPAddress(ctx context.Context, linodeID int, ipAd
 
 // Function to add additional reserved IPV4 addresses to an existing linode
 func (c *Client) AssignInstanceReservedIP(ctx context.Context, linodeID int, opts InstanceReserveIPOptions) (*InstanceIP, error) {
+	if opts.Type != IPTypeIPv4.String() {
+		return nil, fmt.Errorf("invalid reserved IP type %q: must be %q", opts.Type, IPTypeIPv4)
+	}
+
 	endpoint := formatAPIPath("linode/instances/%d/ips", linodeID)
 	response, err := doPOSTRequest[InstanceIP](ctx, c, endpoint, opts)
 	if err != nil {
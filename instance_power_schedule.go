@@ -0,0 +1,269 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const (
+	defaultPowerScheduleRetries    = 5
+	defaultPowerScheduleRetryDelay = 30 * time.Second
+)
+
+// PowerScheduleNoRetries can be set as PowerSchedule.Retries to disable retries,
+// as a zero value uses the default number of retries
+const PowerScheduleNoRetries = -1
+
+// PowerAction is an action taken by a PowerSchedule
+type PowerAction string
+
+// PowerAction enums start with Power
+const (
+	PowerBoot     PowerAction = "boot"
+	PowerShutdown PowerAction = "shutdown"
+)
+
+// PowerScheduleClock provides the current time and timers to a PowerSchedule,
+// allowing the passage of time to be controlled in tests.
+type PowerScheduleClock = Clock
+
+// PowerSchedule configures the daily times at which ScheduleInstancePower boots and shuts down an Instance
+type PowerSchedule struct {
+	// BootAt and ShutdownAt are the wall clock times of day at which the Instance is booted and
+	// shut down, given as the hours and minutes since midnight, e.g. 8*time.Hour for 08:00.
+	// They are not affected by daylight saving time changes in Location.
+	BootAt     time.Duration
+	ShutdownAt time.Duration
+
+	// Location is the time zone of BootAt and ShutdownAt; defaults to UTC
+	Location *time.Location
+
+	// Weekdays are the days on which the schedule applies; defaults to every day
+	Weekdays []time.Weekday
+
+	// Retries is the number of times an action failing with a transient error is retried; defaults to 5.
+	// Set it to PowerScheduleNoRetries, or any negative value, to disable retries.
+	Retries int
+
+	// RetryDelay is the delay between retries of a failed action; defaults to 30 seconds
+	RetryDelay time.Duration
+
+	// OnAction, if set, is called after each action with the error it failed with, if any
+	OnAction func(action PowerAction, err error)
+
+	// Clock defaults to the system clock
+	Clock PowerScheduleClock
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// ScheduleInstancePower boots and shuts down an Instance daily at the times given by the schedule,
+// e.g. to stop development Instances overnight. The schedule runs in a goroutine until ctx is done;
+// an error is returned only if the schedule is invalid. Actions are taken only at the scheduled times,
+// so an Instance is not booted or shut down when the schedule starts. Actions failing with a transient
+// error, i.e. because the Instance is busy, the API is rate limiting or failing, or the request could
+// not be made, are retried according to the schedule, including after the client's own retries.
+// Other errors, e.g. the Instance not being found, are not retried, and an action failing because the
+// Instance is already booted or shut down is treated as successful.
+func (c *Client) ScheduleInstancePower(ctx context.Context, linodeID int, schedule PowerSchedule) error {
+	if err := schedule.validate(); err != nil {
+		return err
+	}
+
+	if schedule.Location == nil {
+		schedule.Location = time.UTC
+	}
+
+	switch {
+	case schedule.Retries == 0:
+		schedule.Retries = defaultPowerScheduleRetries
+	case schedule.Retries < 0:
+		schedule.Retries = 0
+	}
+
+	if schedule.RetryDelay == 0 {
+		schedule.RetryDelay = defaultPowerScheduleRetryDelay
+	}
+
+	if schedule.Clock == nil {
+		schedule.Clock = systemClock{}
+	}
+
+	go func() {
+		from := schedule.Clock.Now()
+
+		for {
+			at, action := schedule.next(from)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-schedule.Clock.After(at.Sub(schedule.Clock.Now())):
+			}
+
+			err := c.runPowerAction(ctx, linodeID, action, schedule)
+			if ctx.Err() != nil {
+				return
+			}
+
+			// Never schedule the same action twice, even if the clock is behind the timer
+			from = at
+			if now := schedule.Clock.Now(); now.After(at) {
+				from = now
+			}
+
+			if schedule.OnAction != nil {
+				schedule.OnAction(action, err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (c *Client) runPowerAction(ctx context.Context, linodeID int, action PowerAction, schedule PowerSchedule) error {
+	var err error
+
+	for attempt := 0; attempt <= schedule.Retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-schedule.Clock.After(schedule.RetryDelay):
+			}
+		}
+
+		switch action {
+		case PowerBoot:
+			err = c.BootInstance(ctx, linodeID, 0)
+		case PowerShutdown:
+			err = c.ShutdownInstance(ctx, linodeID)
+		}
+
+		if err == nil {
+			return nil
+		}
+
+		if !isTransientPowerError(err) {
+			if c.instanceInPowerState(ctx, linodeID, action) {
+				return nil
+			}
+
+			return fmt.Errorf("failed to %s instance %d: %w", action, linodeID, err)
+		}
+	}
+
+	return fmt.Errorf("failed to %s instance %d after %d attempts: %w", action, linodeID, schedule.Retries+1, err)
+}
+
+// isTransientPowerError reports whether a power action may succeed if retried, i.e. it failed
+// because the Instance is busy, the API is rate limiting or failing, or the request could not be made
+func isTransientPowerError(err error) bool {
+	e, ok := asError(err)
+	if !ok {
+		return false
+	}
+
+	switch code := e.StatusCode(); {
+	case code == ErrorFromError, code == ErrorFromCircuitBreaker:
+		return true
+	case code == http.StatusTooManyRequests, code >= http.StatusInternalServerError:
+		return true
+	case code == http.StatusBadRequest:
+		return slices.ContainsFunc(e.Reasons, func(r APIErrorReason) bool {
+			return r.Reason == "Linode busy."
+		})
+	}
+
+	return false
+}
+
+// instanceInPowerState reports whether the Instance is already in, or moving to, the state of the action
+func (c *Client) instanceInPowerState(ctx context.Context, linodeID int, action PowerAction) bool {
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return false
+	}
+
+	switch action {
+	case PowerBoot:
+		return instance.Status == InstanceRunning || instance.Status == InstanceBooting
+	case PowerShutdown:
+		return instance.Status == InstanceOffline || instance.Status == InstanceShuttingDown
+	}
+
+	return false
+}
+
+func (s PowerSchedule) validate() error {
+	for _, offset := range []time.Duration{s.BootAt, s.ShutdownAt} {
+		if offset < 0 || offset >= 24*time.Hour {
+			return fmt.Errorf("power schedule time %s must be between 0 and 24 hours", offset)
+		}
+	}
+
+	if s.BootAt == s.ShutdownAt {
+		return fmt.Errorf("power schedule boot and shutdown times must differ")
+	}
+
+	for _, day := range s.Weekdays {
+		if day < time.Sunday || day > time.Saturday {
+			return fmt.Errorf("power schedule weekday %d is invalid", day)
+		}
+	}
+
+	if s.RetryDelay < 0 {
+		return fmt.Errorf("power schedule retry delay must not be negative")
+	}
+
+	return nil
+}
+
+// next returns the first scheduled action after now
+func (s PowerSchedule) next(now time.Time) (time.Time, PowerAction) {
+	now = now.In(s.Location)
+
+	// A week from now always includes a scheduled day
+	for day := 0; day <= 7; day++ {
+		midnight := time.Date(now.Year(), now.Month(), now.Day()+day, 0, 0, 0, 0, s.Location)
+		if len(s.Weekdays) > 0 && !slices.Contains(s.Weekdays, midnight.Weekday()) {
+			continue
+		}
+
+		events := []struct {
+			at     time.Time
+			action PowerAction
+		}{
+			{s.timeOfDay(midnight, s.BootAt), PowerBoot},
+			{s.timeOfDay(midnight, s.ShutdownAt), PowerShutdown},
+		}
+
+		if events[1].at.Before(events[0].at) {
+			events[0], events[1] = events[1], events[0]
+		}
+
+		for _, event := range events {
+			if event.at.After(now) {
+				return event.at, event.action
+			}
+		}
+	}
+
+	return time.Time{}, ""
+}
+
+// timeOfDay returns the wall clock time on the given day that is offset from midnight, which
+// differs from midnight.Add(offset) on days when daylight saving time starts or ends
+func (s PowerSchedule) timeOfDay(midnight time.Time, offset time.Duration) time.Time {
+	hours := int(offset / time.Hour)
+	minutes := int(offset % time.Hour / time.Minute)
+	seconds := int(offset % time.Minute / time.Second)
+
+	return time.Date(midnight.Year(), midnight.Month(), midnight.Day(), hours, minutes, seconds, 0, s.Location)
+}
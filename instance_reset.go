@@ -0,0 +1,187 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// defaultInstanceResetTimeout bounds ResetInstance when InstanceResetOptions.Timeout is not set
+const defaultInstanceResetTimeout = 15 * time.Minute
+
+// InstanceResetOptions are the options accepted by ResetInstance
+type InstanceResetOptions struct {
+	// KeepLabel retains the Instance's label; otherwise it is reset to the default "linode<ID>"
+	KeepLabel bool
+
+	// Timeout bounds the entire reset, including waiting for disks to be deleted; defaults to 15 minutes
+	Timeout time.Duration
+
+	// WaitOptions configure the waits for the Instance to shut down and for its disks to be deleted
+	WaitOptions []WaitOption
+}
+
+// InstanceResetReport describes what was removed from an Instance by ResetInstance
+type InstanceResetReport struct {
+	ConfigsDeleted  []int    `json:"configs_deleted"`
+	DisksDeleted    []int    `json:"disks_deleted"`
+	IPsRemoved      []string `json:"ips_removed"`
+	TagsRemoved     []string `json:"tags_removed"`
+	DefaultConfigID int      `json:"default_config_id"`
+}
+
+// ResetInstance wipes an Instance so that it can be reused: it is shut down, all of its configs
+// and disks are deleted and replaced by a single empty config, all IPv4 addresses other than
+// its primary public address (the first address of Instance.IPv4) are removed, and its tags
+// are stripped. Instances belonging to an
+// LKE cluster are refused, as they are managed by the cluster.
+//
+// The returned report describes what was removed, and is returned alongside any error
+// to indicate how far the reset progressed.
+func (c *Client) ResetInstance(ctx context.Context, linodeID int, opts InstanceResetOptions) (*InstanceResetReport, error) {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultInstanceResetTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if instance.LKEClusterID != 0 {
+		return nil, fmt.Errorf("instance %d belongs to LKE cluster %d and cannot be reset", linodeID, instance.LKEClusterID)
+	}
+
+	report := &InstanceResetReport{
+		ConfigsDeleted: make([]int, 0),
+		DisksDeleted:   make([]int, 0),
+		IPsRemoved:     make([]string, 0),
+		TagsRemoved:    make([]string, 0),
+	}
+
+	if instance.Status != InstanceOffline {
+		if err := c.ShutdownInstance(ctx, linodeID); err != nil {
+			return report, err
+		}
+
+		if _, err := c.WaitForInstanceStatus(ctx, linodeID, InstanceOffline, int(timeout.Seconds()), opts.WaitOptions...); err != nil {
+			return report, err
+		}
+	}
+
+	configs, err := c.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		return report, err
+	}
+
+	for _, config := range configs {
+		if err := c.DeleteInstanceConfig(ctx, linodeID, config.ID); err != nil {
+			return report, err
+		}
+
+		report.ConfigsDeleted = append(report.ConfigsDeleted, config.ID)
+	}
+
+	disks, err := c.ListInstanceDisks(ctx, linodeID, nil)
+	if err != nil {
+		return report, err
+	}
+
+	// Disks are deleted one at a time, as an Instance can only have one disk
+	// operation in progress and space is not freed until the deletion completes.
+	for _, disk := range disks {
+		if err := c.DeleteInstanceDisk(ctx, linodeID, disk.ID); err != nil {
+			return report, err
+		}
+
+		if err := c.waitForInstanceDiskDeleted(ctx, linodeID, disk.ID, opts.WaitOptions...); err != nil {
+			return report, err
+		}
+
+		report.DisksDeleted = append(report.DisksDeleted, disk.ID)
+	}
+
+	config, err := c.CreateInstanceConfig(ctx, linodeID, InstanceConfigCreateOptions{
+		Label: "Default Config",
+	})
+	if err != nil {
+		return report, err
+	}
+
+	report.DefaultConfigID = config.ID
+
+	ips, err := c.GetInstanceIPAddresses(ctx, linodeID)
+	if err != nil {
+		return report, err
+	}
+
+	if ips.IPv4 != nil {
+		removable := append([]*InstanceIP{}, ips.IPv4.Private...)
+
+		// The public addresses are not listed in any particular order, so the primary
+		// address is identified by the Instance. If it has none, none are removed.
+		if len(instance.IPv4) > 0 && instance.IPv4[0] != nil {
+			primary := instance.IPv4[0].String()
+
+			for _, ip := range ips.IPv4.Public {
+				if ip.Address != primary {
+					removable = append(removable, ip)
+				}
+			}
+		}
+
+		for _, ip := range removable {
+			if err := c.DeleteInstanceIPAddress(ctx, linodeID, ip.Address); err != nil {
+				return report, err
+			}
+
+			report.IPsRemoved = append(report.IPsRemoved, ip.Address)
+		}
+	}
+
+	updateOpts := InstanceUpdateOptions{
+		Tags: &[]string{},
+	}
+
+	if !opts.KeepLabel {
+		updateOpts.Label = fmt.Sprintf("linode%d", linodeID)
+	}
+
+	if _, err := c.UpdateInstance(ctx, linodeID, updateOpts); err != nil {
+		return report, err
+	}
+
+	report.TagsRemoved = append(report.TagsRemoved, instance.Tags...)
+
+	return report, nil
+}
+
+// waitForInstanceDiskDeleted polls the given disk until it no longer exists.
+func (c *Client) waitForInstanceDiskDeleted(ctx context.Context, linodeID, diskID int, opts ...WaitOption) error {
+	w := c.newWaiter(opts)
+
+	err := w.poll(ctx, func() (bool, error) {
+		disk, err := c.GetInstanceDisk(ctx, linodeID, diskID)
+		if IsNotFound(err) {
+			return true, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		w.report(string(disk.Status), nil)
+
+		return false, nil
+	})
+	if err != nil && errors.Is(err, ctx.Err()) {
+		return fmt.Errorf("failed to wait for disk %d to be deleted: %w", diskID, err)
+	}
+
+	return err
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -51,7 +52,7 @@ type InstanceSnapshotDisk struct {
 type InstanceSnapshotStatus string
 
 // InstanceSnapshotStatus constants reflect the current status of an Instance Snapshot
-var (
+const (
 	SnapshotPaused              InstanceSnapshotStatus = "paused"
 	SnapshotPending             InstanceSnapshotStatus = "pending"
 	SnapshotRunning             InstanceSnapshotStatus = "running"
@@ -61,6 +62,18 @@ var (
 	SnapshotUserAborted         InstanceSnapshotStatus = "userAborted"
 )
 
+// InstanceBackupRestoreProgress represents the progress of a backup restore to an Instance
+type InstanceBackupRestoreProgress struct {
+	EventID         int
+	Status          EventStatus
+	PercentComplete int
+}
+
+// isFailed returns whether the snapshot has stopped without completing
+func (s InstanceSnapshotStatus) isFailed() bool {
+	return s == SnapshotFailed || s == SnapshotUserAborted
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (i *InstanceSnapshot) UnmarshalJSON(b []byte) error {
 	type Mask InstanceSnapshot
@@ -141,3 +154,36 @@ func (c *Client) RestoreInstanceBackup(ctx context.Context, linodeID int, backup
 	_, err := doPOSTRequest[InstanceBackup](ctx, c, e, opts)
 	return err
 }
+
+// GetInstanceBackupRestoreProgress returns the progress of the most recent backup restore to
+// the given Linode that was started at or after since. If no restore has been started, nil is returned.
+func (c *Client) GetInstanceBackupRestoreProgress(ctx context.Context, linodeID int, since time.Time) (*InstanceBackupRestoreProgress, error) {
+	f := Filter{
+		Order:   Descending,
+		OrderBy: "created",
+	}
+	f.AddField(Eq, "action", ActionBackupsRestore)
+	f.AddField(Eq, "entity.id", linodeID)
+	f.AddField(Eq, "entity.type", EntityLinode)
+	f.AddField(Gte, "created", since.UTC().Format("2006-01-02T15:04:05"))
+
+	filter, err := f.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := c.ListEvents(ctx, NewListOptions(1, string(filter)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list restore events: %w", err)
+	}
+
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	return &InstanceBackupRestoreProgress{
+		EventID:         events[0].ID,
+		Status:          events[0].Status,
+		PercentComplete: events[0].PercentComplete,
+	}, nil
+}
@@ -2,6 +2,8 @@ package linodego
 
 import (
 	"context"
+	"fmt"
+	"time"
 )
 
 // StatsNet represents a network stats object
@@ -32,7 +34,7 @@ type InstanceStats struct {
 	Data  InstanceStatsData `json:"data"`
 }
 
-// GetInstanceStats gets the template with the provided ID
+// GetInstanceStats gets the stats of the Instance with the provided ID for the last 24 hours
 func (c *Client) GetInstanceStats(ctx context.Context, linodeID int) (*InstanceStats, error) {
 	e := formatAPIPath("linode/instances/%d/stats", linodeID)
 	response, err := doGETRequest[InstanceStats](ctx, c, e)
@@ -43,8 +45,20 @@ func (c *Client) GetInstanceStats(ctx context.Context, linodeID int) (*InstanceS
 	return response, nil
 }
 
-// GetInstanceStatsByDate gets the template with the provided ID, year, and month
+// GetInstanceStatsByDate gets the stats of the Instance with the provided ID during the given year and month.
+// An error is returned without making a request if the month is invalid or in the future (in UTC).
 func (c *Client) GetInstanceStatsByDate(ctx context.Context, linodeID int, year int, month int) (*InstanceStats, error) {
+	if month < 1 || month > 12 {
+		return nil, fmt.Errorf("invalid month %d: must be between 1 and 12", month)
+	}
+
+	now := time.Now().UTC()
+	current := InstanceTransferMonth{Year: now.Year(), Month: now.Month()}
+
+	if current.before(InstanceTransferMonth{Year: year, Month: time.Month(month)}) {
+		return nil, fmt.Errorf("stats for %d-%02d are not available: the month is in the future", year, month)
+	}
+
 	e := formatAPIPath("linode/instances/%d/stats/%d/%d", linodeID, year, month)
 	response, err := doGETRequest[InstanceStats](ctx, c, e)
 	if err != nil {
@@ -0,0 +1,100 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// InstanceTransferMonthly represents the network transfer of a Linode Instance during a given month
+type InstanceTransferMonthly struct {
+	// Bytes of inbound transfer
+	BytesIn int `json:"bytes_in"`
+
+	// Bytes of outbound transfer
+	BytesOut int `json:"bytes_out"`
+
+	// Bytes of total transfer
+	BytesTotal int `json:"bytes_total"`
+}
+
+// InstanceTransferMonth identifies a month for which transfer stats are available
+type InstanceTransferMonth struct {
+	Year  int
+	Month time.Month
+}
+
+// ListInstanceTransferMonths lists the months for which transfer stats are available for an
+// Instance, from the month it was created up to and including the current month (in UTC).
+func (c *Client) ListInstanceTransferMonths(ctx context.Context, linodeID int) ([]InstanceTransferMonth, error) {
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if instance.Created == nil {
+		return nil, fmt.Errorf("instance %d has no creation date", linodeID)
+	}
+
+	return instanceTransferMonths(*instance.Created, time.Now()), nil
+}
+
+// GetInstanceTransferMonthly gets the network transfer of an Instance during the given month.
+// An error is returned if the month is outside of those listed by ListInstanceTransferMonths.
+func (c *Client) GetInstanceTransferMonthly(
+	ctx context.Context,
+	linodeID, year, month int,
+) (*InstanceTransferMonthly, error) {
+	if month < 1 || month > 12 {
+		return nil, fmt.Errorf("invalid month %d: must be between 1 and 12", month)
+	}
+
+	months, err := c.ListInstanceTransferMonths(ctx, linodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	first, last := months[0], months[len(months)-1]
+	requested := InstanceTransferMonth{Year: year, Month: time.Month(month)}
+
+	if requested.before(first) || last.before(requested) {
+		return nil, fmt.Errorf(
+			"transfer for instance %d is only available from %d-%02d to %d-%02d: %d-%02d requested",
+			linodeID, first.Year, first.Month, last.Year, last.Month, year, month,
+		)
+	}
+
+	e := formatAPIPath("linode/instances/%d/transfer/%d/%d", linodeID, year, month)
+	response, err := doGETRequest[InstanceTransferMonthly](ctx, c, e)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (m InstanceTransferMonth) before(other InstanceTransferMonth) bool {
+	return m.Year < other.Year || (m.Year == other.Year && m.Month < other.Month)
+}
+
+// instanceTransferMonths returns each month from that of created to that of now, inclusive
+func instanceTransferMonths(created, now time.Time) []InstanceTransferMonth {
+	created, now = created.UTC(), now.UTC()
+
+	current := InstanceTransferMonth{Year: created.Year(), Month: created.Month()}
+	last := InstanceTransferMonth{Year: now.Year(), Month: now.Month()}
+
+	months := []InstanceTransferMonth{current}
+
+	for current.before(last) {
+		current.Month++
+		if current.Month > time.December {
+			current.Year++
+			current.Month = time.January
+		}
+
+		months = append(months, current)
+	}
+
+	return months
+}
@@ -4,6 +4,15 @@ import (
 	"context"
 )
 
+// VolumeAttachment describes where a Volume is referenced on the Instance it is attached to.
+// A ConfigID of 0 indicates the Volume is attached to the Instance but no Config Profile
+// references it in its device map, e.g. it was attached without persist_across_boots.
+type VolumeAttachment struct {
+	LinodeID int
+	ConfigID int
+	Device   string
+}
+
 // ListInstanceVolumes lists InstanceVolumes
 func (c *Client) ListInstanceVolumes(ctx context.Context, linodeID int, opts *ListOptions) ([]Volume, error) {
 	response, err := getPaginatedResults[Volume](ctx, c, formatAPIPath("linode/instances/%d/volumes", linodeID), opts)
@@ -13,3 +22,49 @@ func (c *Client) ListInstanceVolumes(ctx context.Context, linodeID int, opts *Li
 
 	return response, nil
 }
+
+// FindVolumeAttachments returns the Instance Config devices that reference the given Volume.
+// An empty result is returned if the Volume is detached. If the Volume is attached but not
+// referenced by any of the Instance's Configs, a single VolumeAttachment with a ConfigID of 0
+// is returned.
+func (c *Client) FindVolumeAttachments(ctx context.Context, volumeID int) ([]VolumeAttachment, error) {
+	volume, err := c.GetVolume(ctx, volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]VolumeAttachment, 0)
+
+	if volume.LinodeID == nil || *volume.LinodeID == 0 {
+		return result, nil
+	}
+
+	linodeID := *volume.LinodeID
+
+	configs, err := c.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, config := range configs {
+		if config.Devices == nil {
+			continue
+		}
+
+		for _, d := range config.Devices.namedDevices() {
+			if d.Device.VolumeID == volumeID {
+				result = append(result, VolumeAttachment{
+					LinodeID: linodeID,
+					ConfigID: config.ID,
+					Device:   d.Name,
+				})
+			}
+		}
+	}
+
+	if len(result) == 0 {
+		result = append(result, VolumeAttachment{LinodeID: linodeID})
+	}
+
+	return result, nil
+}
@@ -3,6 +3,8 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net"
 	"time"
 
@@ -39,6 +41,16 @@ const (
 	ColdMigration InstanceMigrationType = "cold"
 )
 
+// InstanceMaintenancePolicy constants start with InstanceMaintenancePolicy and describe what
+// happens to a Linode Instance during host maintenance
+type InstanceMaintenancePolicy string
+
+// InstanceMaintenancePolicy constants reflect the maintenance policies a Linode Instance may use
+const (
+	InstanceMaintenancePolicyMigrate    InstanceMaintenancePolicy = "linode/migrate"
+	InstanceMaintenancePolicyPowerOffOn InstanceMaintenancePolicy = "linode/power_off_on"
+)
+
 // Instance represents a linode object
 type Instance struct {
 	ID              int             `json:"id"`
@@ -68,6 +80,9 @@ type Instance struct {
 	DiskEncryption InstanceDiskEncryption `json:"disk_encryption"`
 
 	LKEClusterID int `json:"lke_cluster_id"`
+
+	// NOTE: Maintenance policies may not currently be available to all users.
+	MaintenancePolicy InstanceMaintenancePolicy `json:"maintenance_policy"`
 }
 
 // InstanceSpec represents a linode spec
@@ -90,12 +105,20 @@ type InstanceAlert struct {
 
 // InstanceBackup represents backup settings for an instance
 type InstanceBackup struct {
-	Available bool `json:"available,omitempty"` // read-only
-	Enabled   bool `json:"enabled,omitempty"`   // read-only
-	Schedule  struct {
-		Day    string `json:"day,omitempty"`
-		Window string `json:"window,omitempty"`
-	} `json:"schedule,omitempty"`
+	Available      bool                   `json:"available,omitempty"` // read-only
+	Enabled        bool                   `json:"enabled,omitempty"`   // read-only
+	Schedule       InstanceBackupSchedule `json:"schedule,omitempty"`
+	LastSuccessful *time.Time             `json:"-"` // read-only
+}
+
+// InstanceBackupSchedule is when the automatic backups of an instance are taken. It can be
+// changed by setting Backups.Schedule in InstanceUpdateOptions.
+type InstanceBackupSchedule struct {
+	// The day of the week weekly backups are taken, e.g. "Sunday", or "Scheduling" to let Linode choose
+	Day string `json:"day,omitempty"`
+
+	// The two-hour window in UTC daily backups are taken, e.g. "W0" for 00:00-02:00, or "Scheduling"
+	Window string `json:"window,omitempty"`
 }
 
 type InstanceDiskEncryption string
@@ -115,6 +138,18 @@ type InstanceTransfer struct {
 
 	// GB of transfer this instance adds to the Transfer pool
 	Quota int `json:"quota"`
+
+	// Transfer pool stats of the instance for each region it contributes to
+	RegionTransfers []InstanceTransferRegion `json:"region_transfers"`
+}
+
+// InstanceTransferRegion represents a Linode Instance's network utilization for the current month
+// in a given region.
+type InstanceTransferRegion struct {
+	ID       string `json:"id"`
+	Billable int    `json:"billable"`
+	Quota    int    `json:"quota"`
+	Used     int    `json:"used"`
 }
 
 // InstancePlacementGroup represents information about the placement group
@@ -158,6 +193,9 @@ type InstanceCreateOptions struct {
 	// NOTE: Placement Groups may not currently be available to all users.
 	PlacementGroup *InstanceCreatePlacementGroupOptions `json:"placement_group,omitempty"`
 
+	// NOTE: Maintenance policies may not currently be available to all users.
+	MaintenancePolicy InstanceMaintenancePolicy `json:"maintenance_policy,omitempty"`
+
 	// Creation fields that need to be set explicitly false, "", or 0 use pointers
 	SwapSize *int  `json:"swap_size,omitempty"`
 	Booted   *bool `json:"booted,omitempty"`
@@ -168,6 +206,37 @@ type InstanceCreateOptions struct {
 	IPv4 []string `json:"ipv4,omitempty"`
 }
 
+// MaxInstanceCreateIPv4 is the number of addresses the API currently accepts in
+// InstanceCreateOptions.IPv4, which may only contain a single reserved public IPv4 address.
+// This is expected to be raised as the API allows additional allocations at creation.
+const MaxInstanceCreateIPv4 = 1
+
+// instanceCreateIPv4Limits is the maximum number of addresses accepted in
+// InstanceCreateOptions.IPv4 by each API version. It is only consulted when
+// strict validation is enabled; versions not listed are not validated.
+var instanceCreateIPv4Limits = map[string]int{
+	"v4":     MaxInstanceCreateIPv4,
+	"v4beta": MaxInstanceCreateIPv4,
+}
+
+// validateCreateIPv4 checks the IPv4 addresses of a create request against
+// the limit of the client's API version.
+func (c *Client) validateCreateIPv4(addresses []string) error {
+	if !c.strictValidation {
+		return nil
+	}
+
+	limit, ok := instanceCreateIPv4Limits[c.GetAPIVersion()]
+	if !ok || len(addresses) <= limit {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"at most %d IPv4 address(es) may be given when creating an instance using API %s, got %d",
+		limit, c.GetAPIVersion(), len(addresses),
+	)
+}
+
 // InstanceCreatePlacementGroupOptions represents the placement group
 // to create this Linode under.
 type InstanceCreatePlacementGroupOptions struct {
@@ -183,6 +252,9 @@ type InstanceUpdateOptions struct {
 	WatchdogEnabled *bool           `json:"watchdog_enabled,omitempty"`
 	Tags            *[]string       `json:"tags,omitempty"`
 
+	// NOTE: Maintenance policies may not currently be available to all users.
+	MaintenancePolicy *InstanceMaintenancePolicy `json:"maintenance_policy,omitempty"`
+
 	// Deprecated: group is a deprecated property denoting a group label for the Linode.
 	Group *string `json:"group,omitempty"`
 }
@@ -209,9 +281,29 @@ func (i *Instance) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (backup *InstanceBackup) UnmarshalJSON(b []byte) error {
+	type Mask InstanceBackup
+
+	p := struct {
+		*Mask
+		LastSuccessful *parseabletime.ParseableTime `json:"last_successful"`
+	}{
+		Mask: (*Mask)(backup),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	backup.LastSuccessful = (*time.Time)(p.LastSuccessful)
+
+	return nil
+}
+
 // GetUpdateOptions converts an Instance to InstanceUpdateOptions for use in UpdateInstance
 func (i *Instance) GetUpdateOptions() InstanceUpdateOptions {
-	return InstanceUpdateOptions{
+	opts := InstanceUpdateOptions{
 		Label:           i.Label,
 		Group:           &i.Group,
 		Backups:         i.Backups,
@@ -219,6 +311,12 @@ func (i *Instance) GetUpdateOptions() InstanceUpdateOptions {
 		WatchdogEnabled: &i.WatchdogEnabled,
 		Tags:            &i.Tags,
 	}
+
+	if i.MaintenancePolicy != "" {
+		opts.MaintenancePolicy = &i.MaintenancePolicy
+	}
+
+	return opts
 }
 
 // InstanceCloneOptions is an options struct sent when Cloning an Instance
@@ -254,9 +352,18 @@ type InstanceMigrateOptions struct {
 	Type   InstanceMigrationType `json:"type,omitempty"`
 	Region string                `json:"region,omitempty"`
 
+	// Upgrade, if set, upgrades the Instance to the latest hardware generation during the migration
+	Upgrade *bool `json:"upgrade,omitempty"`
+
 	PlacementGroup *InstanceCreatePlacementGroupOptions `json:"placement_group,omitempty"`
 }
 
+// InstanceMutateOptions is an options struct used when mutating an instance
+type InstanceMutateOptions struct {
+	// When enabled, the instance's disks are resized along with it if it has no more than one data disk and one swap disk
+	AllowAutoDiskResize *bool `json:"allow_auto_disk_resize,omitempty"`
+}
+
 // ListInstances lists linode instances
 func (c *Client) ListInstances(ctx context.Context, opts *ListOptions) ([]Instance, error) {
 	response, err := getPaginatedResults[Instance](ctx, c, "linode/instances", opts)
@@ -267,6 +374,15 @@ func (c *Client) ListInstances(ctx context.Context, opts *ListOptions) ([]Instan
 	return response, nil
 }
 
+// ListInstancesByGroup lists the linode instances in the given legacy display group.
+// Deprecated: group is a deprecated property denoting a group label for the Linode; prefer tags.
+func (c *Client) ListInstancesByGroup(ctx context.Context, group string) ([]Instance, error) {
+	f := Filter{}
+	f.AddField(Eq, "group", group)
+
+	return c.ListInstances(ctx, NewListOptions(0, &f))
+}
+
 // GetInstance gets the instance with the provided ID
 func (c *Client) GetInstance(ctx context.Context, linodeID int) (*Instance, error) {
 	e := formatAPIPath("linode/instances/%d", linodeID)
@@ -278,7 +394,7 @@ func (c *Client) GetInstance(ctx context.Context, linodeID int) (*Instance, erro
 	return response, nil
 }
 
-// GetInstanceTransfer gets the instance with the provided ID
+// GetInstanceTransfer gets the transfer pool stats of the instance with the provided ID for the current month
 func (c *Client) GetInstanceTransfer(ctx context.Context, linodeID int) (*InstanceTransfer, error) {
 	e := formatAPIPath("linode/instances/%d/transfer", linodeID)
 	response, err := doGETRequest[InstanceTransfer](ctx, c, e)
@@ -291,6 +407,15 @@ func (c *Client) GetInstanceTransfer(ctx context.Context, linodeID int) (*Instan
 
 // CreateInstance creates a Linode instance
 func (c *Client) CreateInstance(ctx context.Context, opts InstanceCreateOptions) (*Instance, error) {
+	if err := errors.Join(
+		c.payloadLimits.checkInstanceLabel(opts.Label),
+		c.payloadLimits.checkMetadata(opts.Metadata),
+		c.payloadLimits.checkStackScriptData(opts.StackScriptData),
+		c.validateCreateIPv4(opts.IPv4),
+	); err != nil {
+		return nil, err
+	}
+
 	e := "linode/instances"
 	response, err := doPOSTRequest[Instance](ctx, c, e, opts)
 	if err != nil {
@@ -300,6 +425,36 @@ func (c *Client) CreateInstance(ctx context.Context, opts InstanceCreateOptions)
 	return response, nil
 }
 
+// CreateInstanceWithRegionFallback attempts to create a Linode instance in each of the given regions in order,
+// ignoring opts.Region. Regions failing with an error classified as APIErrorCodeRegionCapacity are skipped.
+// The created instance is returned along with the region it was created in.
+func (c *Client) CreateInstanceWithRegionFallback(
+	ctx context.Context, opts InstanceCreateOptions, regions []string,
+) (*Instance, string, error) {
+	if len(regions) == 0 {
+		return nil, "", fmt.Errorf("no regions provided")
+	}
+
+	errs := make([]error, 0, len(regions))
+
+	for _, region := range regions {
+		opts.Region = region
+
+		instance, err := c.CreateInstance(ctx, opts)
+		if err == nil {
+			return instance, region, nil
+		}
+
+		if ErrorCode(err) != APIErrorCodeRegionCapacity {
+			return nil, "", err
+		}
+
+		errs = append(errs, fmt.Errorf("region %s: %w", region, err))
+	}
+
+	return nil, "", fmt.Errorf("failed to create instance in any region: %w", errors.Join(errs...))
+}
+
 // UpdateInstance creates a Linode instance
 func (c *Client) UpdateInstance(ctx context.Context, linodeID int, opts InstanceUpdateOptions) (*Instance, error) {
 	e := formatAPIPath("linode/instances/%d", linodeID)
@@ -324,7 +479,8 @@ func (c *Client) DeleteInstance(ctx context.Context, linodeID int) error {
 }
 
 // BootInstance will boot a Linode instance
-// A configID of 0 will cause Linode to choose the last/best config
+// A configID of 0 will cause Linode to choose the last/best config, which is only
+// predictable for instances with a single config. Use BootInstanceWithConfig otherwise.
 func (c *Client) BootInstance(ctx context.Context, linodeID int, configID int) error {
 	opts := make(map[string]int)
 
@@ -337,6 +493,17 @@ func (c *Client) BootInstance(ctx context.Context, linodeID int, configID int) e
 	return err
 }
 
+// BootInstanceWithConfig will boot a Linode instance using the given config.
+// Unlike BootInstance, a configID is required and an error is returned without
+// making a request if it is not given.
+func (c *Client) BootInstanceWithConfig(ctx context.Context, linodeID int, configID int) error {
+	if configID <= 0 {
+		return fmt.Errorf("a config ID is required to boot instance %d: got %d", linodeID, configID)
+	}
+
+	return c.BootInstance(ctx, linodeID, configID)
+}
+
 // CloneInstance clone an existing Instances Disks and Configuration profiles to another Linode Instance
 func (c *Client) CloneInstance(ctx context.Context, linodeID int, opts InstanceCloneOptions) (*Instance, error) {
 	e := formatAPIPath("linode/instances/%d/clone", linodeID)
@@ -376,11 +543,27 @@ type InstanceRebuildOptions struct {
 
 	// NOTE: Disk encryption may not currently be available to all users.
 	DiskEncryption InstanceDiskEncryption `json:"disk_encryption,omitempty"`
+
+	// NOTE: Maintenance policies may not currently be available to all users.
+	MaintenancePolicy InstanceMaintenancePolicy `json:"maintenance_policy,omitempty"`
 }
 
 // RebuildInstance Deletes all Disks and Configs on this Linode,
 // then deploys a new Image to this Linode with the given attributes.
 func (c *Client) RebuildInstance(ctx context.Context, linodeID int, opts InstanceRebuildOptions) (*Instance, error) {
+	if err := errors.Join(
+		c.payloadLimits.checkMetadata(opts.Metadata),
+		c.payloadLimits.checkStackScriptData(opts.StackScriptData),
+	); err != nil {
+		return nil, err
+	}
+
+	if c.strictValidation && opts.Image != "" {
+		if err := c.CheckRebuildImageCompatibility(ctx, linodeID, opts.Image); err != nil {
+			return nil, err
+		}
+	}
+
 	e := formatAPIPath("linode/instances/%d/rebuild", linodeID)
 	response, err := doPOSTRequest[Instance](ctx, c, e, opts)
 	if err != nil {
@@ -390,6 +573,54 @@ func (c *Client) RebuildInstance(ctx context.Context, linodeID int, opts Instanc
 	return response, nil
 }
 
+// CheckRebuildImageCompatibility checks that the Instance can be rebuilt from the given Image:
+// a private Image must be available, and an Image with replicas must have an available replica
+// in the Instance's region. It is run by RebuildInstance when strict validation is enabled.
+func (c *Client) CheckRebuildImageCompatibility(ctx context.Context, linodeID int, imageID string) error {
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return err
+	}
+
+	image, err := c.GetImage(ctx, imageID)
+	if err != nil {
+		if IsNotFound(err) {
+			return fmt.Errorf("image %s does not exist: %w", imageID, err)
+		}
+
+		return err
+	}
+
+	if !image.IsPublic && image.Status != ImageStatusAvailable {
+		return fmt.Errorf("image %s is %s; wait for it to become %s before rebuilding", imageID, image.Status, ImageStatusAvailable)
+	}
+
+	// Images without region information are available in every region
+	if len(image.Regions) == 0 {
+		return nil
+	}
+
+	for _, region := range image.Regions {
+		if region.Region != instance.Region {
+			continue
+		}
+
+		if region.Status != ImageRegionStatusAvailable {
+			return fmt.Errorf(
+				"image %s is %s in region %s; wait for it to become %s before rebuilding instance %d",
+				imageID, region.Status, instance.Region, ImageRegionStatusAvailable, linodeID,
+			)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf(
+		"image %s is not replicated to region %s of instance %d; replicate it using ReplicateImage before rebuilding",
+		imageID, instance.Region, linodeID,
+	)
+}
+
 // InstanceRescueOptions fields are those accepted by RescueInstance
 type InstanceRescueOptions struct {
 	Devices InstanceConfigDeviceMap `json:"devices"`
@@ -405,8 +636,33 @@ func (c *Client) RescueInstance(ctx context.Context, linodeID int, opts Instance
 	return err
 }
 
+// defaultExitRescueModeTimeout bounds ExitRescueMode when the context has no deadline
+const defaultExitRescueModeTimeout = 10 * time.Minute
+
+// ExitRescueMode reboots an instance out of Rescue Mode into the given config, then waits
+// for its linode_reboot Event to finish and for it to be running, as RebootInstanceAndWait
+// does. Waiting on the Event is needed as an instance in Rescue Mode is already running.
+// The returned Instance is the running instance. If ctx has no deadline, waiting is
+// bounded by a default of 10 minutes.
+func (c *Client) ExitRescueMode(ctx context.Context, linodeID int, configID int) (*Instance, error) {
+	if configID <= 0 {
+		return nil, fmt.Errorf("a config ID is required to exit rescue mode on instance %d: got %d", linodeID, configID)
+	}
+
+	timeout := defaultExitRescueModeTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	return c.RebootInstanceAndWait(ctx, linodeID, configID, max(int(timeout.Seconds()), 1))
+}
+
 // ResizeInstance resizes an instance to new Linode type
 func (c *Client) ResizeInstance(ctx context.Context, linodeID int, opts InstanceResizeOptions) error {
+	if err := validateMigrationType(opts.MigrationType); err != nil {
+		return err
+	}
+
 	e := formatAPIPath("linode/instances/%d/resize", linodeID)
 	_, err := doPOSTRequest[Instance](ctx, c, e, opts)
 	return err
@@ -417,18 +673,94 @@ func (c *Client) ShutdownInstance(ctx context.Context, id int) error {
 	return c.simpleInstanceAction(ctx, "shutdown", id)
 }
 
+// BootInstanceAndWait boots a Linode instance, then waits for its linode_boot Event to finish
+// and for it to be running. It returns the running Instance. If the boot fails, the returned
+// error wraps ErrEventFailed; if timeoutSeconds or the deadline of ctx elapses first, it wraps
+// the context's error. A configID of 0 behaves as it does for BootInstance.
+func (c *Client) BootInstanceAndWait(ctx context.Context, linodeID int, configID int, timeoutSeconds int) (*Instance, error) {
+	return c.instanceActionAndWait(ctx, linodeID, ActionLinodeBoot, InstanceRunning, timeoutSeconds, func(ctx context.Context) error {
+		return c.BootInstance(ctx, linodeID, configID)
+	})
+}
+
+// RebootInstanceAndWait reboots a Linode instance, then waits for its linode_reboot Event to
+// finish and for it to be running. It returns the running Instance. Errors are reported as they
+// are by BootInstanceAndWait.
+func (c *Client) RebootInstanceAndWait(ctx context.Context, linodeID int, configID int, timeoutSeconds int) (*Instance, error) {
+	return c.instanceActionAndWait(ctx, linodeID, ActionLinodeReboot, InstanceRunning, timeoutSeconds, func(ctx context.Context) error {
+		return c.RebootInstance(ctx, linodeID, configID)
+	})
+}
+
+// ShutdownInstanceAndWait shuts down a Linode instance, then waits for its linode_shutdown Event
+// to finish and for it to be offline. It returns the offline Instance. Errors are reported as they
+// are by BootInstanceAndWait.
+func (c *Client) ShutdownInstanceAndWait(ctx context.Context, linodeID int, timeoutSeconds int) (*Instance, error) {
+	return c.instanceActionAndWait(ctx, linodeID, ActionLinodeShutdown, InstanceOffline, timeoutSeconds, func(ctx context.Context) error {
+		return c.ShutdownInstance(ctx, linodeID)
+	})
+}
+
+// instanceActionAndWait performs an Instance action, then waits for its Event to finish
+// and for the Instance to reach the given status
+func (c *Client) instanceActionAndWait(
+	ctx context.Context,
+	linodeID int,
+	action EventAction,
+	status InstanceStatus,
+	timeoutSeconds int,
+	do func(ctx context.Context) error,
+) (*Instance, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	start := time.Now()
+
+	if err := do(ctx); err != nil {
+		return nil, err
+	}
+
+	event, err := c.WaitForEventFinished(ctx, linodeID, EntityLinode, action, start, timeoutSeconds)
+	if err != nil {
+		if event != nil && event.Status == EventFailed {
+			return nil, fmt.Errorf("%w: %s of instance %d (event %d): %s", ErrEventFailed, action, linodeID, event.ID, event.Message)
+		}
+
+		return nil, err
+	}
+
+	return c.WaitForInstanceStatus(ctx, linodeID, status, timeoutSeconds)
+}
+
 // MutateInstance Upgrades a Linode to its next generation.
-func (c *Client) MutateInstance(ctx context.Context, id int) error {
-	return c.simpleInstanceAction(ctx, "mutate", id)
+// An upgrade is available if the Successor of the Instance's LinodeType is set.
+func (c *Client) MutateInstance(ctx context.Context, linodeID int, opts InstanceMutateOptions) error {
+	e := formatAPIPath("linode/instances/%d/mutate", linodeID)
+	_, err := doPOSTRequest[Instance](ctx, c, e, opts)
+	return err
 }
 
 // MigrateInstance - Migrate an instance
 func (c *Client) MigrateInstance(ctx context.Context, linodeID int, opts InstanceMigrateOptions) error {
+	if err := validateMigrationType(opts.Type); err != nil {
+		return err
+	}
+
 	e := formatAPIPath("linode/instances/%d/migrate", linodeID)
 	_, err := doPOSTRequest[Instance](ctx, c, e, opts)
 	return err
 }
 
+// validateMigrationType returns an error if a migration type was given but is not a known value.
+// An empty migration type is allowed and lets the API choose its default.
+func validateMigrationType(migrationType InstanceMigrationType) error {
+	if migrationType == "" || migrationType.IsValid() {
+		return nil
+	}
+
+	return fmt.Errorf("invalid migration type %q: must be one of %q, %q", migrationType, WarmMigration, ColdMigration)
+}
+
 // simpleInstanceAction is a helper for Instance actions that take no parameters
 // and return empty responses `{}` unless they return a standard error
 func (c *Client) simpleInstanceAction(ctx context.Context, action string, linodeID int) error {
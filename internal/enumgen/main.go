@@ -0,0 +1,223 @@
+// Command enumgen generates String, IsValid and Parse helpers for every exported
+// string-based enum type in the linodego package.
+//
+// An enum type is any exported `type X string` declaration with at least one
+// typed constant (or package-level var) of that type. It is run via go generate
+// from the repository root:
+//
+//	go generate ./...
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	outputFile     = "enums_gen.go"
+	outputTestFile = "enums_gen_test.go"
+)
+
+type enumValue struct {
+	Name  string
+	Value string
+}
+
+type enumType struct {
+	Name   string
+	Values []enumValue
+}
+
+func main() {
+	dir := "."
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+
+	enums, err := collectEnums(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := writeSource(filepath.Join(dir, outputFile), renderEnums(enums)); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := writeSource(filepath.Join(dir, outputTestFile), renderEnumTests(enums)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// collectEnums parses the non-test, non-generated Go files of dir and returns the
+// enum types found, sorted by name.
+func collectEnums(dir string) ([]enumType, error) {
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && name != outputFile
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, ok := pkgs["linodego"]
+	if !ok {
+		return nil, fmt.Errorf("package linodego not found in %s", dir)
+	}
+
+	stringTypes := make(map[string]bool)
+	values := make(map[string][]enumValue)
+
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					if ident, ok := s.Type.(*ast.Ident); ok && ident.Name == "string" &&
+						!s.Assign.IsValid() && s.Name.IsExported() {
+						stringTypes[s.Name.Name] = true
+					}
+				case *ast.ValueSpec:
+					collectValues(gen.Tok, s, values)
+				}
+			}
+		}
+	}
+
+	enums := make([]enumType, 0, len(stringTypes))
+
+	for name := range stringTypes {
+		if len(values[name]) == 0 {
+			continue
+		}
+
+		enums = append(enums, enumType{Name: name, Values: dedupeValues(values[name])})
+	}
+
+	sort.Slice(enums, func(i, j int) bool {
+		return enums[i].Name < enums[j].Name
+	})
+
+	return enums, nil
+}
+
+// collectValues records the exported, string-literal values declared in spec
+// under the name of their declared type.
+func collectValues(tok token.Token, spec *ast.ValueSpec, values map[string][]enumValue) {
+	if tok != token.CONST && tok != token.VAR {
+		return
+	}
+
+	typ, ok := spec.Type.(*ast.Ident)
+	if !ok {
+		return
+	}
+
+	for i, name := range spec.Names {
+		if !name.IsExported() || i >= len(spec.Values) {
+			continue
+		}
+
+		lit, ok := spec.Values[i].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+
+		value, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			continue
+		}
+
+		values[typ.Name] = append(values[typ.Name], enumValue{Name: name.Name, Value: value})
+	}
+}
+
+// dedupeValues drops values that repeat an earlier one, since duplicate
+// constants cannot appear together in a switch statement.
+func dedupeValues(values []enumValue) []enumValue {
+	seen := make(map[string]bool, len(values))
+	result := make([]enumValue, 0, len(values))
+
+	for _, v := range values {
+		if seen[v.Value] {
+			continue
+		}
+
+		seen[v.Value] = true
+
+		result = append(result, v)
+	}
+
+	return result
+}
+
+func renderEnums(enums []enumType) []byte {
+	var b bytes.Buffer
+
+	b.WriteString("// Code generated by internal/enumgen; DO NOT EDIT.\n\npackage linodego\n\nimport \"fmt\"\n")
+
+	for _, e := range enums {
+		fmt.Fprintf(&b, "\n// String returns the string representation of the %s.\n", e.Name)
+		fmt.Fprintf(&b, "func (v %s) String() string {\n\treturn string(v)\n}\n", e.Name)
+
+		fmt.Fprintf(&b, "\n// IsValid reports whether the %s is one of its known values.\n", e.Name)
+		fmt.Fprintf(&b, "func (v %s) IsValid() bool {\n\tswitch v {\n\tcase %s:\n\t\treturn true\n\t}\n\n\treturn false\n}\n",
+			e.Name, joinNames(e.Values))
+
+		fmt.Fprintf(&b, "\n// Parse%[1]s converts s to a %[1]s, returning an error if it is not a known value.\n", e.Name)
+		fmt.Fprintf(&b, "func Parse%[1]s(s string) (%[1]s, error) {\n\tv := %[1]s(s)\n\tif !v.IsValid() {\n"+
+			"\t\treturn \"\", fmt.Errorf(\"invalid %[1]s %%q\", s)\n\t}\n\n\treturn v, nil\n}\n", e.Name)
+	}
+
+	return b.Bytes()
+}
+
+func renderEnumTests(enums []enumType) []byte {
+	var b bytes.Buffer
+
+	b.WriteString("// Code generated by internal/enumgen; DO NOT EDIT.\n\npackage linodego\n\n" +
+		"import \"testing\"\n\nfunc TestGeneratedEnums(t *testing.T) {\n")
+
+	for _, e := range enums {
+		fmt.Fprintf(&b, "\tt.Run(%q, func(t *testing.T) {\n", e.Name)
+		fmt.Fprintf(&b, "\t\ttestEnumRoundTrip(t, Parse%s, []%s{%s})\n\t})\n", e.Name, e.Name, joinNames(e.Values))
+	}
+
+	b.WriteString("}\n")
+
+	return b.Bytes()
+}
+
+func joinNames(values []enumValue) string {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = v.Name
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func writeSource(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", path, err)
+	}
+
+	return os.WriteFile(path, formatted, 0o644)
+}
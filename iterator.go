@@ -0,0 +1,166 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrStopIteration may be returned by the function passed to ForEach to stop
+// iterating without an error
+var ErrStopIteration = errors.New("stop iteration")
+
+// ListFunc is a function that lists a page of results, such as Client.ListInstances
+type ListFunc[T any] func(ctx context.Context, opts *ListOptions) ([]T, error)
+
+// Iterator lazily iterates over the results of a paginated List endpoint, fetching
+// each page only when the previous page has been consumed. For example:
+//
+//	it := client.InstancesIterator(nil)
+//	for it.HasNext() {
+//		instance, ok, err := it.Next(ctx)
+//		if err != nil {
+//			return err
+//		}
+//		if !ok {
+//			break
+//		}
+//		...
+//	}
+type Iterator[T any] struct {
+	list ListFunc[T]
+	opts ListOptions
+
+	// page is the last page fetched, and pages and results the total number
+	// of pages and results, which are only known once fetched is set.
+	page    int
+	pages   int
+	results int
+	fetched bool
+
+	buffer []T
+}
+
+// NewIterator returns an Iterator over the results of the given List function. Any
+// function listing a sub-resource can be adapted using a closure. The page in opts,
+// if set, is the first page fetched.
+func NewIterator[T any](list ListFunc[T], opts *ListOptions) *Iterator[T] {
+	it := &Iterator[T]{list: list}
+
+	if opts != nil {
+		it.opts = *opts
+
+		if opts.PageOptions != nil && opts.Page > 0 {
+			it.page = opts.Page - 1
+		}
+	}
+
+	return it
+}
+
+// HasNext reports whether there may be more results. It returns true before the
+// first page has been fetched even if the endpoint has no results, in which case
+// Next returns false.
+func (it *Iterator[T]) HasNext() bool {
+	return len(it.buffer) > 0 || !it.fetched || it.page < it.pages
+}
+
+// Next returns the next result, fetching the next page if required. The returned
+// bool is false once all results have been returned. An error is returned if a page
+// could not be fetched or the context has been cancelled.
+func (it *Iterator[T]) Next(ctx context.Context) (T, bool, error) {
+	var zero T
+
+	if err := ctx.Err(); err != nil {
+		return zero, false, err
+	}
+
+	for len(it.buffer) == 0 {
+		if !it.HasNext() {
+			return zero, false, nil
+		}
+
+		opts := it.opts
+		opts.PageOptions = &PageOptions{Page: it.page + 1}
+
+		results, err := it.list(ctx, &opts)
+		if err != nil {
+			return zero, false, err
+		}
+
+		it.page = opts.Page
+		it.pages = opts.Pages
+		it.results = opts.Results
+		it.fetched = true
+		it.buffer = results
+	}
+
+	result := it.buffer[0]
+	it.buffer = it.buffer[1:]
+
+	// Release the page once it has been consumed, rather than while the next is fetched
+	if len(it.buffer) == 0 {
+		it.buffer = nil
+	}
+
+	return result, true, nil
+}
+
+// Results returns the total number of results of the endpoint, which is only
+// known once the first page has been fetched; until then it returns 0.
+func (it *Iterator[T]) Results() int {
+	return it.results
+}
+
+// ForEach calls fn with each result of the given List function in order using an Iterator,
+// so at most one page of results is held in memory. Iteration stops early if fn returns an
+// error: ErrStopIteration stops it without error and any other error is returned. The page
+// in opts, if set, is the first page fetched.
+func ForEach[T any](ctx context.Context, list ListFunc[T], opts *ListOptions, fn func(T) error) error {
+	it := NewIterator(list, opts)
+
+	for {
+		result, ok, err := it.Next(ctx)
+		if err != nil || !ok {
+			return err
+		}
+
+		if err := fn(result); err != nil {
+			if errors.Is(err, ErrStopIteration) {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
+
+// ForEachInstance calls fn with each Instance on the account; see ForEach
+func (c *Client) ForEachInstance(ctx context.Context, opts *ListOptions, fn func(Instance) error) error {
+	return ForEach(ctx, c.ListInstances, opts, fn)
+}
+
+// ForEachVolume calls fn with each Volume on the account; see ForEach
+func (c *Client) ForEachVolume(ctx context.Context, opts *ListOptions, fn func(Volume) error) error {
+	return ForEach(ctx, c.ListVolumes, opts, fn)
+}
+
+// ForEachEvent calls fn with each Event on the account; see ForEach
+func (c *Client) ForEachEvent(ctx context.Context, opts *ListOptions, fn func(Event) error) error {
+	return ForEach(ctx, c.ListEvents, opts, fn)
+}
+
+// InstancesIterator returns an Iterator over the Instances on the account. The filter
+// and page size in opts apply to every page fetched.
+func (c *Client) InstancesIterator(opts *ListOptions) *Iterator[Instance] {
+	return NewIterator(c.ListInstances, opts)
+}
+
+// VolumesIterator returns an Iterator over the Volumes on the account
+func (c *Client) VolumesIterator(opts *ListOptions) *Iterator[Volume] {
+	return NewIterator(c.ListVolumes, opts)
+}
+
+// EventsIterator returns an Iterator over the Events on the account
+func (c *Client) EventsIterator(opts *ListOptions) *Iterator[Event] {
+	return NewIterator(c.ListEvents, opts)
+}
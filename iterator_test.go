@@ -0,0 +1,115 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+// BenchmarkForEachInstance compares the peak heap usage of streaming Instances through
+// ForEachInstance against materializing them all with ListInstances
+func BenchmarkForEachInstance(b *testing.B) {
+	const pageSize = 100
+
+	instances := make([]Instance, 5000)
+	for i := range instances {
+		instances[i] = Instance{ID: i, Label: fmt.Sprintf("instance-%d", i), Region: "us-east", Tags: []string{"bench"}}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		page = max(page, 1)
+
+		end := min(page*pageSize, len(instances))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(paginatedResponse[Instance]{
+			Page:    page,
+			Pages:   (len(instances) + pageSize - 1) / pageSize,
+			Results: len(instances),
+			Data:    instances[(page-1)*pageSize : end],
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	b.Run("ListInstances", func(b *testing.B) {
+		b.ReportAllocs()
+
+		var peak heapPeak
+		for range b.N {
+			peak.reset()
+
+			result, err := client.ListInstances(context.Background(), &ListOptions{PageSize: pageSize})
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			peak.sample()
+			runtime.KeepAlive(result)
+		}
+
+		peak.report(b)
+	})
+
+	b.Run("ForEachInstance", func(b *testing.B) {
+		b.ReportAllocs()
+
+		var peak heapPeak
+		for range b.N {
+			peak.reset()
+
+			seen := 0
+			err := client.ForEachInstance(context.Background(), &ListOptions{PageSize: pageSize}, func(Instance) error {
+				seen++
+				if seen%pageSize == 0 {
+					peak.sample()
+				}
+
+				return nil
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		peak.report(b)
+	})
+}
+
+// heapPeak tracks the largest increase in live heap usage sampled since the last reset
+type heapPeak struct {
+	base uint64
+	peak uint64
+}
+
+func (h *heapPeak) reset() {
+	runtime.GC()
+
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+
+	h.base = stats.HeapAlloc
+}
+
+func (h *heapPeak) sample() {
+	runtime.GC()
+
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+
+	if stats.HeapAlloc > h.base {
+		h.peak = max(h.peak, stats.HeapAlloc-h.base)
+	}
+}
+
+func (h *heapPeak) report(b *testing.B) {
+	b.ReportMetric(float64(h.peak)/1024, "peak-KiB")
+}
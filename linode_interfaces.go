@@ -0,0 +1,300 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// LinodeInterface represents a Linode Interface attached to an Instance.
+// Unlike InstanceConfigInterfaces, Linode Interfaces are managed independently of
+// Instance Configs and carry their own addressing and default route settings.
+type LinodeInterface struct {
+	ID           int                    `json:"id"`
+	Version      int                    `json:"version"`
+	MACAddress   string                 `json:"mac_address"`
+	DefaultRoute *InterfaceDefaultRoute `json:"default_route"`
+	Public       *PublicInterface       `json:"public"`
+	VPC          *VPCInterface          `json:"vpc"`
+	VLAN         *VLANInterface         `json:"vlan"`
+	Created      *time.Time             `json:"-"`
+	Updated      *time.Time             `json:"-"`
+}
+
+// InterfaceDefaultRoute indicates whether a Linode Interface is the IPv4 and/or
+// IPv6 default route for its Instance.
+type InterfaceDefaultRoute struct {
+	IPv4 *bool `json:"ipv4,omitempty"`
+	IPv6 *bool `json:"ipv6,omitempty"`
+}
+
+// PublicInterface contains the addressing of a public Linode Interface.
+type PublicInterface struct {
+	IPv4 *PublicInterfaceIPv4 `json:"ipv4"`
+	IPv6 *PublicInterfaceIPv6 `json:"ipv6"`
+}
+
+type PublicInterfaceIPv4 struct {
+	Addresses []PublicInterfaceIPv4Address `json:"addresses"`
+	Shared    []PublicInterfaceIPv4Shared  `json:"shared"`
+}
+
+type PublicInterfaceIPv4Address struct {
+	Address string `json:"address"`
+	Primary bool   `json:"primary"`
+}
+
+type PublicInterfaceIPv4Shared struct {
+	Address  string `json:"address"`
+	LinodeID int    `json:"linode_id"`
+}
+
+type PublicInterfaceIPv6 struct {
+	Ranges []PublicInterfaceIPv6Range `json:"ranges"`
+	Shared []PublicInterfaceIPv6Range `json:"shared"`
+	SLAAC  []PublicInterfaceIPv6SLAAC `json:"slaac"`
+}
+
+type PublicInterfaceIPv6Range struct {
+	Range       string  `json:"range"`
+	RouteTarget *string `json:"route_target"`
+}
+
+type PublicInterfaceIPv6SLAAC struct {
+	Address string `json:"address"`
+	Prefix  int    `json:"prefix"`
+}
+
+// VPCInterface contains the addressing of a VPC Linode Interface.
+type VPCInterface struct {
+	VPCID    int              `json:"vpc_id"`
+	SubnetID int              `json:"subnet_id"`
+	IPv4     VPCInterfaceIPv4 `json:"ipv4"`
+}
+
+type VPCInterfaceIPv4 struct {
+	Addresses []VPCInterfaceIPv4Address `json:"addresses"`
+	Ranges    []VPCInterfaceIPv4Range   `json:"ranges"`
+}
+
+type VPCInterfaceIPv4Address struct {
+	Address        string  `json:"address"`
+	Primary        bool    `json:"primary"`
+	NAT1To1Address *string `json:"nat_1_1_address"`
+}
+
+type VPCInterfaceIPv4Range struct {
+	Range string `json:"range"`
+}
+
+// VLANInterface contains the configuration of a VLAN Linode Interface.
+type VLANInterface struct {
+	VLANLabel   string  `json:"vlan_label"`
+	IPAMAddress *string `json:"ipam_address"`
+}
+
+// LinodeInterfaceCreateOptions fields are those accepted by CreateInterface.
+// Exactly one of Public, VPC or VLAN should be set.
+type LinodeInterfaceCreateOptions struct {
+	FirewallID   *int                   `json:"firewall_id,omitempty"`
+	DefaultRoute *InterfaceDefaultRoute `json:"default_route,omitempty"`
+	Public       *PublicInterface       `json:"public,omitempty"`
+	VPC          *VPCInterface          `json:"vpc,omitempty"`
+	VLAN         *VLANInterface         `json:"vlan,omitempty"`
+}
+
+// LinodeInterfaceUpdateOptions fields are those accepted by UpdateInterface.
+type LinodeInterfaceUpdateOptions struct {
+	DefaultRoute *InterfaceDefaultRoute `json:"default_route,omitempty"`
+	Public       *PublicInterface       `json:"public,omitempty"`
+	VPC          *VPCInterface          `json:"vpc,omitempty"`
+}
+
+// InterfaceSettings represents the Linode Interface settings of an Instance.
+type InterfaceSettings struct {
+	NetworkHelper bool                         `json:"network_helper"`
+	DefaultRoute  InterfaceDefaultRouteSetting `json:"default_route"`
+}
+
+// InterfaceDefaultRouteSetting describes which Linode Interfaces are, and may be,
+// the IPv4 and IPv6 default routes for an Instance.
+type InterfaceDefaultRouteSetting struct {
+	IPv4InterfaceID          *int  `json:"ipv4_interface_id"`
+	IPv4EligibleInterfaceIDs []int `json:"ipv4_eligible_interface_ids"`
+	IPv6InterfaceID          *int  `json:"ipv6_interface_id"`
+	IPv6EligibleInterfaceIDs []int `json:"ipv6_eligible_interface_ids"`
+}
+
+// InterfaceSettingsUpdateOptions fields are those accepted by UpdateInterfaceSettings.
+type InterfaceSettingsUpdateOptions struct {
+	NetworkHelper *bool                               `json:"network_helper,omitempty"`
+	DefaultRoute  *InterfaceDefaultRouteSettingUpdate `json:"default_route,omitempty"`
+}
+
+type InterfaceDefaultRouteSettingUpdate struct {
+	IPv4InterfaceID *int `json:"ipv4_interface_id,omitempty"`
+	IPv6InterfaceID *int `json:"ipv6_interface_id,omitempty"`
+}
+
+// GetUpdateOptions converts a LinodeInterface to LinodeInterfaceUpdateOptions for use in UpdateInterface
+func (i LinodeInterface) GetUpdateOptions() LinodeInterfaceUpdateOptions {
+	return LinodeInterfaceUpdateOptions{
+		DefaultRoute: i.DefaultRoute,
+		Public:       i.Public,
+		VPC:          i.VPC,
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *LinodeInterface) UnmarshalJSON(b []byte) error {
+	type Mask LinodeInterface
+
+	p := struct {
+		*Mask
+		Created *parseabletime.ParseableTime `json:"created"`
+		Updated *parseabletime.ParseableTime `json:"updated"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.Created = (*time.Time)(p.Created)
+	i.Updated = (*time.Time)(p.Updated)
+
+	return nil
+}
+
+// ListInterfaces lists the Linode Interfaces of the Instance with the specified id
+func (c *Client) ListInterfaces(ctx context.Context, linodeID int) ([]LinodeInterface, error) {
+	e := formatAPIPath("linode/instances/%d/interfaces", linodeID)
+	response, err := doGETRequest[struct {
+		Interfaces []LinodeInterface `json:"interfaces"`
+	}](ctx, c, e)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Interfaces, nil
+}
+
+// GetInterface gets the Linode Interface with the specified id
+func (c *Client) GetInterface(ctx context.Context, linodeID int, interfaceID int) (*LinodeInterface, error) {
+	e := formatAPIPath("linode/instances/%d/interfaces/%d", linodeID, interfaceID)
+	return doGETRequest[LinodeInterface](ctx, c, e)
+}
+
+// CreateInterface creates a Linode Interface on the Instance with the specified id
+func (c *Client) CreateInterface(ctx context.Context, linodeID int, opts LinodeInterfaceCreateOptions) (*LinodeInterface, error) {
+	e := formatAPIPath("linode/instances/%d/interfaces", linodeID)
+	return doPOSTRequest[LinodeInterface](ctx, c, e, opts)
+}
+
+// UpdateInterface updates the Linode Interface with the specified id
+func (c *Client) UpdateInterface(ctx context.Context, linodeID int, interfaceID int, opts LinodeInterfaceUpdateOptions) (*LinodeInterface, error) {
+	e := formatAPIPath("linode/instances/%d/interfaces/%d", linodeID, interfaceID)
+	return doPUTRequest[LinodeInterface](ctx, c, e, opts)
+}
+
+// DeleteInterface deletes the Linode Interface with the specified id
+func (c *Client) DeleteInterface(ctx context.Context, linodeID int, interfaceID int) error {
+	e := formatAPIPath("linode/instances/%d/interfaces/%d", linodeID, interfaceID)
+	return doDELETERequest(ctx, c, e)
+}
+
+// GetInterfaceSettings gets the Linode Interface settings of the Instance with the specified id
+func (c *Client) GetInterfaceSettings(ctx context.Context, linodeID int) (*InterfaceSettings, error) {
+	e := formatAPIPath("linode/instances/%d/interfaces/settings", linodeID)
+	return doGETRequest[InterfaceSettings](ctx, c, e)
+}
+
+// UpdateInterfaceSettings updates the Linode Interface settings of the Instance with the specified id
+func (c *Client) UpdateInterfaceSettings(ctx context.Context, linodeID int, opts InterfaceSettingsUpdateOptions) (*InterfaceSettings, error) {
+	e := formatAPIPath("linode/instances/%d/interfaces/settings", linodeID)
+	return doPUTRequest[InterfaceSettings](ctx, c, e, opts)
+}
+
+// SetDefaultRouteInterface makes the specified Linode Interface the IPv4 and/or IPv6
+// default route of the Instance. Both routes are changed in a single settings update,
+// so the Instance is never left with only one of them moved.
+// An error is returned if the interface is not eligible for a requested route.
+func (c *Client) SetDefaultRouteInterface(ctx context.Context, linodeID, interfaceID int, v4, v6 bool) (*InterfaceSettings, error) {
+	if !v4 && !v6 {
+		return nil, fmt.Errorf("at least one of IPv4 or IPv6 must be requested")
+	}
+
+	settings, err := c.GetInterfaceSettings(ctx, linodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.updateDefaultRouteInterface(ctx, linodeID, interfaceID, settings.DefaultRoute, v4, v6)
+}
+
+// SetPrimaryInterface makes the specified Linode Interface the primary interface of the
+// Instance, carrying its default route. The interface must be eligible to be the IPv4
+// default route, and also becomes the IPv6 default route if it is eligible for it.
+// An Instance has a single default route of each type, so the previous primary interface
+// stops being the default route in the same settings update.
+func (c *Client) SetPrimaryInterface(ctx context.Context, linodeID, interfaceID int) (*InterfaceSettings, error) {
+	settings, err := c.GetInterfaceSettings(ctx, linodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	route := settings.DefaultRoute
+	v6 := slices.Contains(route.IPv6EligibleInterfaceIDs, interfaceID)
+
+	if route.IPv4InterfaceID != nil && *route.IPv4InterfaceID == interfaceID &&
+		(!v6 || route.IPv6InterfaceID != nil && *route.IPv6InterfaceID == interfaceID) {
+		return settings, nil
+	}
+
+	settings, err = c.updateDefaultRouteInterface(ctx, linodeID, interfaceID, route, true, v6)
+	if err != nil {
+		return nil, err
+	}
+
+	if id := settings.DefaultRoute.IPv4InterfaceID; id == nil || *id != interfaceID {
+		return nil, fmt.Errorf("interface %d did not become the primary interface of instance %d", interfaceID, linodeID)
+	}
+
+	return settings, nil
+}
+
+// updateDefaultRouteInterface moves the requested default routes of an Instance with
+// the given current route settings to the specified Linode Interface
+func (c *Client) updateDefaultRouteInterface(
+	ctx context.Context,
+	linodeID, interfaceID int,
+	route InterfaceDefaultRouteSetting,
+	v4, v6 bool,
+) (*InterfaceSettings, error) {
+	update := InterfaceDefaultRouteSettingUpdate{}
+
+	if v4 {
+		if !slices.Contains(route.IPv4EligibleInterfaceIDs, interfaceID) {
+			return nil, fmt.Errorf("interface %d is not eligible to be the IPv4 default route", interfaceID)
+		}
+
+		update.IPv4InterfaceID = &interfaceID
+	}
+
+	if v6 {
+		if !slices.Contains(route.IPv6EligibleInterfaceIDs, interfaceID) {
+			return nil, fmt.Errorf("interface %d is not eligible to be the IPv6 default route", interfaceID)
+		}
+
+		update.IPv6InterfaceID = &interfaceID
+	}
+
+	return c.UpdateInterfaceSettings(ctx, linodeID, InterfaceSettingsUpdateOptions{
+		DefaultRoute: &update,
+	})
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -17,6 +18,12 @@ const (
 	LKEClusterNotReady LKEClusterStatus = "not_ready"
 )
 
+// LKECluster tiers are the values of LKECluster.Tier
+const (
+	LKEClusterTierStandard   = "standard"
+	LKEClusterTierEnterprise = "enterprise"
+)
+
 // LKECluster represents a LKECluster object
 type LKECluster struct {
 	ID           int                    `json:"id"`
@@ -28,6 +35,9 @@ type LKECluster struct {
 	K8sVersion   string                 `json:"k8s_version"`
 	Tags         []string               `json:"tags"`
 	ControlPlane LKEClusterControlPlane `json:"control_plane"`
+
+	// NOTE: LKE Enterprise may not currently be available to all users.
+	Tier string `json:"tier"`
 }
 
 // LKEClusterCreateOptions fields are those accepted by CreateLKECluster
@@ -38,6 +48,10 @@ type LKEClusterCreateOptions struct {
 	K8sVersion   string                         `json:"k8s_version"`
 	Tags         []string                       `json:"tags,omitempty"`
 	ControlPlane *LKEClusterControlPlaneOptions `json:"control_plane,omitempty"`
+
+	// Tier is LKEClusterTierStandard (the default) or LKEClusterTierEnterprise.
+	// NOTE: LKE Enterprise may not currently be available to all users.
+	Tier string `json:"tier,omitempty"`
 }
 
 // LKEClusterUpdateOptions fields are those accepted by UpdateLKECluster
@@ -102,6 +116,7 @@ func (i LKECluster) GetCreateOptions() (o LKEClusterCreateOptions) {
 	o.Region = i.Region
 	o.K8sVersion = i.K8sVersion
 	o.Tags = i.Tags
+	o.Tier = i.Tier
 
 	isHA := i.ControlPlane.HighAvailability
 
@@ -201,6 +216,10 @@ func (c *Client) GetLKECluster(ctx context.Context, clusterID int) (*LKECluster,
 
 // CreateLKECluster creates a LKECluster
 func (c *Client) CreateLKECluster(ctx context.Context, opts LKEClusterCreateOptions) (*LKECluster, error) {
+	if err := opts.validateTier(); err != nil {
+		return nil, err
+	}
+
 	e := "lke/clusters"
 	response, err := doPOSTRequest[LKECluster](ctx, c, e, opts)
 	if err != nil {
@@ -210,6 +229,31 @@ func (c *Client) CreateLKECluster(ctx context.Context, opts LKEClusterCreateOpti
 	return response, nil
 }
 
+// validateTier returns an error naming the first field set that is only available
+// for LKE Enterprise clusters, if the options are for a standard cluster
+func (opts LKEClusterCreateOptions) validateTier() error {
+	if opts.Tier == LKEClusterTierEnterprise {
+		return nil
+	}
+
+	for i, pool := range opts.NodePools {
+		field := ""
+
+		switch {
+		case pool.K8sVersion != nil:
+			field = "k8s_version"
+		case pool.UpdateStrategy != nil:
+			field = "update_strategy"
+		default:
+			continue
+		}
+
+		return fmt.Errorf("node_pools[%d].%s is only available for %s LKE clusters", i, field, LKEClusterTierEnterprise)
+	}
+
+	return nil
+}
+
 // UpdateLKECluster updates the LKECluster with the specified id
 func (c *Client) UpdateLKECluster(ctx context.Context, clusterID int, opts LKEClusterUpdateOptions) (*LKECluster, error) {
 	e := formatAPIPath("lke/clusters/%d", clusterID)
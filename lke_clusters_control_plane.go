@@ -1,6 +1,11 @@
 package linodego
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+)
 
 // LKEClusterControlPlane fields contained within the `control_plane` attribute of an LKE cluster.
 type LKEClusterControlPlane struct {
@@ -16,6 +21,7 @@ type LKEClusterControlPlaneACLAddresses struct {
 
 // LKEClusterControlPlaneACL describes the ACL configuration
 // for an LKE cluster's control plane.
+// Addresses is nil if no addresses have been configured.
 // NOTE: Control Plane ACLs may not currently be available to all users.
 type LKEClusterControlPlaneACL struct {
 	Enabled   bool                                `json:"enabled"`
@@ -24,6 +30,8 @@ type LKEClusterControlPlaneACL struct {
 
 // LKEClusterControlPlaneACLAddressesOptions are the options used to
 // specify the allowed IP ranges for an LKE cluster's control plane.
+// Each entry is an address or a CIDR of the matching IP version.
+// A nil list leaves the current addresses unchanged, while an empty list clears them.
 type LKEClusterControlPlaneACLAddressesOptions struct {
 	IPv4 *[]string `json:"ipv4,omitempty"`
 	IPv6 *[]string `json:"ipv6,omitempty"`
@@ -70,13 +78,17 @@ func (c *Client) GetLKEClusterControlPlaneACL(ctx context.Context, clusterID int
 }
 
 // UpdateLKEClusterControlPlaneACL updates the ACL configuration for the
-// given cluster's control plane.
+// given cluster's control plane. Addresses are validated before the request is made.
 // NOTE: Control Plane ACLs may not currently be available to all users.
 func (c *Client) UpdateLKEClusterControlPlaneACL(
 	ctx context.Context,
 	clusterID int,
 	opts LKEClusterControlPlaneACLUpdateOptions,
 ) (*LKEClusterControlPlaneACLResponse, error) {
+	if err := opts.ACL.validate(); err != nil {
+		return nil, err
+	}
+
 	return doPUTRequest[LKEClusterControlPlaneACLResponse](
 		ctx,
 		c,
@@ -85,6 +97,49 @@ func (c *Client) UpdateLKEClusterControlPlaneACL(
 	)
 }
 
+// validate checks that each address is an address or CIDR of the expected IP version
+func (o LKEClusterControlPlaneACLOptions) validate() error {
+	if o.Addresses == nil {
+		return nil
+	}
+
+	if o.Addresses.IPv4 != nil {
+		for _, address := range *o.Addresses.IPv4 {
+			if !isACLAddress(address, true) {
+				return fmt.Errorf("invalid IPv4 address or CIDR in control plane ACL: %q", address)
+			}
+		}
+	}
+
+	if o.Addresses.IPv6 != nil {
+		for _, address := range *o.Addresses.IPv6 {
+			if !isACLAddress(address, false) {
+				return fmt.Errorf("invalid IPv6 address or CIDR in control plane ACL: %q", address)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isACLAddress reports whether address is an IPv4 (or IPv6) address or CIDR
+func isACLAddress(address string, ipv4 bool) bool {
+	addr, err := netip.ParseAddr(address)
+
+	if strings.Contains(address, "/") {
+		var prefix netip.Prefix
+
+		prefix, err = netip.ParsePrefix(address)
+		addr = prefix.Addr()
+	}
+
+	if err != nil {
+		return false
+	}
+
+	return addr.Is4() == ipv4 && !addr.Is4In6()
+}
+
 // DeleteLKEClusterControlPlaneACL deletes the ACL configuration for the
 // given cluster's control plane.
 func (c *Client) DeleteLKEClusterControlPlaneACL(
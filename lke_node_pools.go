@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"fmt"
 )
 
 // LKELinodeStatus constants start with LKELinode and include
@@ -33,6 +34,15 @@ type LKENodePoolLinode struct {
 	Status     LKELinodeStatus `json:"status"`
 }
 
+// LKENodePoolUpdateStrategy constants start with LKENodePoolUpdateStrategy and include
+// all known strategies for updating the nodes of an LKENodePool
+type LKENodePoolUpdateStrategy string
+
+const (
+	LKENodePoolUpdateStrategyRollingUpdate LKENodePoolUpdateStrategy = "rolling_update"
+	LKENodePoolUpdateStrategyOnRecycle     LKENodePoolUpdateStrategy = "on_recycle"
+)
+
 // LKENodePoolTaintEffect represents the effect value of a taint
 type LKENodePoolTaintEffect string
 
@@ -67,6 +77,11 @@ type LKENodePool struct {
 
 	// NOTE: Disk encryption may not currently be available to all users.
 	DiskEncryption InstanceDiskEncryption `json:"disk_encryption,omitempty"`
+
+	// K8sVersion and UpdateStrategy are only set for pools of LKE Enterprise clusters.
+	// NOTE: LKE Enterprise may not currently be available to all users.
+	K8sVersion     *string                    `json:"k8s_version,omitempty"`
+	UpdateStrategy *LKENodePoolUpdateStrategy `json:"update_strategy,omitempty"`
 }
 
 // LKENodePoolCreateOptions fields are those accepted by CreateLKENodePool
@@ -79,6 +94,11 @@ type LKENodePoolCreateOptions struct {
 	Taints []LKENodePoolTaint `json:"taints"`
 
 	Autoscaler *LKENodePoolAutoscaler `json:"autoscaler,omitempty"`
+
+	// K8sVersion and UpdateStrategy may only be set for pools of LKE Enterprise clusters.
+	// NOTE: LKE Enterprise may not currently be available to all users.
+	K8sVersion     *string                    `json:"k8s_version,omitempty"`
+	UpdateStrategy *LKENodePoolUpdateStrategy `json:"update_strategy,omitempty"`
 }
 
 // LKENodePoolUpdateOptions fields are those accepted by UpdateLKENodePoolUpdate
@@ -100,6 +120,8 @@ func (l LKENodePool) GetCreateOptions() (o LKENodePoolCreateOptions) {
 	o.Labels = l.Labels
 	o.Taints = l.Taints
 	o.Autoscaler = &l.Autoscaler
+	o.K8sVersion = copyString(l.K8sVersion)
+	o.UpdateStrategy = l.UpdateStrategy
 	return
 }
 
@@ -156,6 +178,43 @@ func (c *Client) UpdateLKENodePool(ctx context.Context, clusterID, poolID int, o
 	return response, nil
 }
 
+// UpdateLKENodePoolAutoscaler updates only the autoscaler of the LKENodePool with the specified id,
+// leaving its count, tags, labels and taints unchanged. When enabling the autoscaler, Min must be
+// at least 1 and no greater than Max; otherwise an error is returned without making a request.
+func (c *Client) UpdateLKENodePoolAutoscaler(
+	ctx context.Context,
+	clusterID, poolID int,
+	autoscaler LKENodePoolAutoscaler,
+) (*LKENodePool, error) {
+	if autoscaler.Enabled {
+		switch {
+		case autoscaler.Min < 1:
+			return nil, fmt.Errorf("autoscaler min %d must be at least 1", autoscaler.Min)
+		case autoscaler.Min > autoscaler.Max:
+			return nil, fmt.Errorf("autoscaler min %d must not be greater than max %d", autoscaler.Min, autoscaler.Max)
+		}
+	}
+
+	return c.UpdateLKENodePool(ctx, clusterID, poolID, LKENodePoolUpdateOptions{Autoscaler: &autoscaler})
+}
+
+// RecycleLKENodePool recycles all nodes in the specified LKENodePool, replacing
+// them with new Linodes running the latest image. The API accepts the request
+// without waiting for the nodes to be replaced.
+func (c *Client) RecycleLKENodePool(ctx context.Context, clusterID, poolID int) error {
+	e := formatAPIPath("lke/clusters/%d/pools/%d/recycle", clusterID, poolID)
+	_, err := doPOSTRequest[LKENodePool, any](ctx, c, e)
+	return err
+}
+
+// RecycleLKENodePoolNode recycles a given node from a node pool, replacing it
+// with a new Linode running the latest image
+func (c *Client) RecycleLKENodePoolNode(ctx context.Context, clusterID int, nodeID string) error {
+	e := formatAPIPath("lke/clusters/%d/nodes/%s/recycle", clusterID, nodeID)
+	_, err := doPOSTRequest[LKENodePoolLinode, any](ctx, c, e)
+	return err
+}
+
 // DeleteLKENodePool deletes the LKENodePool with the specified id
 func (c *Client) DeleteLKENodePool(ctx context.Context, clusterID, poolID int) error {
 	e := formatAPIPath("lke/clusters/%d/pools/%d", clusterID, poolID)
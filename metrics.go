@@ -0,0 +1,180 @@
+package linodego
+
+import (
+	"errors"
+	"expvar"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// MetricsCollector is notified of every request sent to the API, including each retry.
+// Implementations must be safe for concurrent use.
+type MetricsCollector interface {
+	// ObserveRequest is called once a request completes. endpointTemplate is the path of the
+	// request relative to the API version, with IDs replaced by "{id}", e.g.
+	// "/linode/instances/{id}/disks/{id}". status is 0 if no response was received, and
+	// retries is the number of attempts that preceded this one.
+	ObserveRequest(endpointTemplate, method string, status int, duration time.Duration, retries int)
+}
+
+// SetMetricsCollector sets the MetricsCollector notified of every request made by the client.
+// A nil collector disables metrics collection, which is the default.
+func (c *Client) SetMetricsCollector(collector MetricsCollector) *Client {
+	c.metrics.set(collector)
+	return c
+}
+
+// metricsObserver is shared by all copies of a Client, as its hooks are registered on the resty client
+type metricsObserver struct {
+	mu        sync.RWMutex
+	collector MetricsCollector
+}
+
+func newMetricsObserver(rc *resty.Client) *metricsObserver {
+	m := &metricsObserver{}
+
+	rc.OnAfterResponse(func(rc *resty.Client, r *resty.Response) error {
+		m.observe(rc, r)
+		return nil
+	})
+
+	// Attempts which failed with an error are not passed to OnAfterResponse hooks. They are observed
+	// by checkRetryConditionals as each attempt is made, but retry conditions are only checked if
+	// retries are enabled and the request's context has not ended.
+	rc.OnError(func(_ *resty.Request, err error) {
+		var respErr *resty.ResponseError
+		if !errors.As(err, &respErr) {
+			return
+		}
+
+		if rc.RetryCount == 0 || respErr.Response.Request.Context().Err() != nil {
+			m.observe(rc, respErr.Response)
+		}
+	})
+
+	return m
+}
+
+func (m *metricsObserver) set(collector MetricsCollector) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.collector = collector
+}
+
+func (m *metricsObserver) get() MetricsCollector {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.collector
+}
+
+func (m *metricsObserver) observe(rc *resty.Client, r *resty.Response) {
+	collector := m.get()
+	if collector == nil || r == nil || r.Request == nil {
+		return
+	}
+
+	collector.ObserveRequest(
+		endpointTemplate(rc.BaseURL, r.Request.URL),
+		r.Request.Method,
+		r.StatusCode(),
+		r.Time(),
+		max(r.Request.Attempt-1, 0),
+	)
+}
+
+// endpointTemplate returns the path of requestURL relative to baseURL, with
+// numeric IDs, UUIDs and IP addresses replaced by "{id}".
+func endpointTemplate(baseURL, requestURL string) string {
+	requestPath := requestURL
+	if u, err := url.Parse(requestURL); err == nil {
+		requestPath = u.Path
+	}
+
+	if u, err := url.Parse(baseURL); err == nil {
+		requestPath = strings.TrimPrefix(requestPath, strings.TrimSuffix(u.Path, "/"))
+	}
+
+	segments := strings.Split(strings.Trim(requestPath, "/"), "/")
+	for i, segment := range segments {
+		if isEndpointID(segment) {
+			segments[i] = "{id}"
+		}
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+func isEndpointID(segment string) bool {
+	if _, err := strconv.Atoi(segment); err == nil {
+		return true
+	}
+
+	if net.ParseIP(segment) != nil {
+		return true
+	}
+
+	// e.g. Child Account EUUIDs and Database certificate IDs
+	return len(segment) == 36 && strings.Count(segment, "-") == 4
+}
+
+// ExpvarMetricsCollector is a MetricsCollector publishing request counts and latencies
+// using the expvar package, keyed by "METHOD endpointTemplate".
+type ExpvarMetricsCollector struct {
+	// Requests is the number of requests made
+	Requests *expvar.Map
+
+	// Errors is the number of requests failing with an error status, or without a response
+	Errors *expvar.Map
+
+	// Retries is the number of requests which were retries
+	Retries *expvar.Map
+
+	// DurationMillis is the total duration of requests in milliseconds
+	DurationMillis *expvar.Map
+}
+
+var _ MetricsCollector = (*ExpvarMetricsCollector)(nil)
+
+// NewExpvarMetricsCollector returns an ExpvarMetricsCollector published as an expvar Map
+// with the given name. Like expvar.Publish, it panics if the name is already in use.
+func NewExpvarMetricsCollector(name string) *ExpvarMetricsCollector {
+	m := &ExpvarMetricsCollector{
+		Requests:       new(expvar.Map).Init(),
+		Errors:         new(expvar.Map).Init(),
+		Retries:        new(expvar.Map).Init(),
+		DurationMillis: new(expvar.Map).Init(),
+	}
+
+	published := expvar.NewMap(name)
+	published.Set("requests", m.Requests)
+	published.Set("errors", m.Errors)
+	published.Set("retries", m.Retries)
+	published.Set("duration_ms", m.DurationMillis)
+
+	return m
+}
+
+// ObserveRequest implements MetricsCollector
+func (m *ExpvarMetricsCollector) ObserveRequest(endpointTemplate, method string, status int, duration time.Duration, retries int) {
+	key := method + " " + endpointTemplate
+
+	m.Requests.Add(key, 1)
+	m.DurationMillis.AddFloat(key, float64(duration)/float64(time.Millisecond))
+
+	if status == 0 || status >= http.StatusBadRequest {
+		m.Errors.Add(key, 1)
+	}
+
+	if retries > 0 {
+		m.Retries.Add(key, 1)
+	}
+}
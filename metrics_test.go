@@ -0,0 +1,20 @@
+package linodego
+
+import "testing"
+
+func TestEndpointTemplate(t *testing.T) {
+	tests := map[string]string{
+		"https://api.linode.com/v4/linode/instances/123/disks/456":                                    "/linode/instances/{id}/disks/{id}",
+		"https://api.linode.com/v4/linode/instances?page=2":                                           "/linode/instances",
+		"https://api.linode.com/v4/networking/ips/192.0.2.1":                                          "/networking/ips/{id}",
+		"https://api.linode.com/v4/networking/ips/2600:3c00::1":                                       "/networking/ips/{id}",
+		"https://api.linode.com/v4/account/child-accounts/A1BC2DEF-34GH-567I-J890-KLMN12O34P56/token": "/account/child-accounts/{id}/token",
+		"https://api.linode.com/v4/account/users/example-user":                                        "/account/users/example-user",
+	}
+
+	for requestURL, expected := range tests {
+		if actual := endpointTemplate("https://api.linode.com/v4", requestURL); actual != expected {
+			t.Errorf("expected %q for %q, got %q", expected, requestURL, actual)
+		}
+	}
+}
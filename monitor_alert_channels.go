@@ -0,0 +1,186 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// NotificationChannelType constants start with NotificationChannelType and include all known
+// ways a NotificationChannel delivers alerts
+type NotificationChannelType string
+
+const (
+	NotificationChannelTypeEmail   NotificationChannelType = "email"
+	NotificationChannelTypeWebhook NotificationChannelType = "webhook"
+)
+
+// NotificationChannel is a delivery target for the alerts raised by alert definitions
+// NOTE: The monitoring API may not currently be available to all users.
+type NotificationChannel struct {
+	ID          int                        `json:"id"`
+	Label       string                     `json:"label"`
+	ChannelType NotificationChannelType    `json:"channel_type"`
+	Type        AlertDefinitionType        `json:"type"`
+	Content     NotificationChannelContent `json:"content"`
+	Alerts      NotificationChannelAlerts  `json:"alerts"`
+	CreatedBy   string                     `json:"created_by"`
+	UpdatedBy   string                     `json:"updated_by"`
+	Created     *time.Time                 `json:"-"`
+	Updated     *time.Time                 `json:"-"`
+}
+
+// NotificationChannelContent is where a NotificationChannel delivers alerts,
+// with the field matching its ChannelType set
+type NotificationChannelContent struct {
+	Email   *NotificationChannelEmail   `json:"email,omitempty"`
+	Webhook *NotificationChannelWebhook `json:"webhook,omitempty"`
+}
+
+// NotificationChannelEmail are the recipients of the alerts delivered by an email NotificationChannel
+type NotificationChannelEmail struct {
+	EmailAddresses []string `json:"email_addresses"`
+	Subject        string   `json:"subject,omitempty"`
+	Message        string   `json:"message,omitempty"`
+}
+
+// NotificationChannelWebhook is the URL alerts are posted to by a webhook NotificationChannel
+type NotificationChannelWebhook struct {
+	WebhookURL  string                      `json:"webhook_url"`
+	HTTPHeaders []NotificationChannelHeader `json:"http_headers,omitempty"`
+}
+
+// NotificationChannelHeader is an HTTP header sent with the alerts posted to a webhook
+type NotificationChannelHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NotificationChannelAlerts summarizes the alert definitions a NotificationChannel is attached to
+type NotificationChannelAlerts struct {
+	URL        string `json:"url"`
+	Type       string `json:"type"`
+	AlertCount int    `json:"alert_count"`
+}
+
+// NotificationChannelCreateOptions fields are those accepted by CreateNotificationChannel
+type NotificationChannelCreateOptions struct {
+	Label       string                     `json:"label"`
+	ChannelType NotificationChannelType    `json:"channel_type"`
+	Content     NotificationChannelContent `json:"content"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *NotificationChannel) UnmarshalJSON(b []byte) error {
+	type Mask NotificationChannel
+
+	p := struct {
+		*Mask
+		Created *parseabletime.ParseableTime `json:"created"`
+		Updated *parseabletime.ParseableTime `json:"updated"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.Created = (*time.Time)(p.Created)
+	i.Updated = (*time.Time)(p.Updated)
+
+	return nil
+}
+
+// Validate checks that the options include a label and the content of their channel type:
+// email channels need at least one valid email address, and webhook channels an http or https URL.
+func (opts NotificationChannelCreateOptions) Validate() error {
+	if opts.Label == "" {
+		return fmt.Errorf("label is required")
+	}
+
+	switch opts.ChannelType {
+	case NotificationChannelTypeEmail:
+		if opts.Content.Email == nil || len(opts.Content.Email.EmailAddresses) == 0 {
+			return fmt.Errorf("content.email.email_addresses is required for %s channels", opts.ChannelType)
+		}
+
+		for _, address := range opts.Content.Email.EmailAddresses {
+			if parsed, err := mail.ParseAddress(address); err != nil || parsed.Address != address {
+				return fmt.Errorf("invalid email address %q", address)
+			}
+		}
+	case NotificationChannelTypeWebhook:
+		if opts.Content.Webhook == nil {
+			return fmt.Errorf("content.webhook.webhook_url is required for %s channels", opts.ChannelType)
+		}
+
+		u, err := url.Parse(opts.Content.Webhook.WebhookURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("invalid webhook URL %q: an http or https URL is required", opts.Content.Webhook.WebhookURL)
+		}
+	default:
+		return fmt.Errorf("unknown channel type %q", opts.ChannelType)
+	}
+
+	return nil
+}
+
+// ListNotificationChannels lists the notification channels alerts can be delivered to
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) ListNotificationChannels(ctx context.Context, opts *ListOptions) ([]NotificationChannel, error) {
+	return getPaginatedResults[NotificationChannel](ctx, c, "monitor/alert-channels", opts)
+}
+
+// GetNotificationChannel gets the notification channel with the provided ID
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) GetNotificationChannel(ctx context.Context, channelID int) (*NotificationChannel, error) {
+	e := formatAPIPath("monitor/alert-channels/%d", channelID)
+	return doGETRequest[NotificationChannel](ctx, c, e)
+}
+
+// CreateNotificationChannel creates a notification channel alerts can be delivered to
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) CreateNotificationChannel(ctx context.Context, opts NotificationChannelCreateOptions) (*NotificationChannel, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	return doPOSTRequest[NotificationChannel](ctx, c, "monitor/alert-channels", opts)
+}
+
+// AttachNotificationChannels adds the notification channels with the provided IDs to those the
+// alert definition delivers its alerts to. Channels already attached to it are left unchanged.
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) AttachNotificationChannels(
+	ctx context.Context,
+	serviceType MonitorServiceType,
+	alertID int,
+	channelIDs ...int,
+) (*AlertDefinition, error) {
+	alert, err := c.GetAlertDefinition(ctx, serviceType, alertID)
+	if err != nil {
+		return nil, err
+	}
+
+	attached := alert.GetCreateOptions().ChannelIDs
+	updated := slices.Clone(attached)
+
+	for _, id := range channelIDs {
+		if !slices.Contains(updated, id) {
+			updated = append(updated, id)
+		}
+	}
+
+	if len(updated) == len(attached) {
+		return alert, nil
+	}
+
+	return c.UpdateAlertDefinition(ctx, serviceType, alertID, AlertDefinitionUpdateOptions{ChannelIDs: updated})
+}
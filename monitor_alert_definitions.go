@@ -0,0 +1,253 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// AlertSeverity is the severity of the alerts raised by an AlertDefinition, from 0 (the most severe) to 3
+type AlertSeverity int
+
+const (
+	AlertSeveritySevere AlertSeverity = 0
+	AlertSeverityMedium AlertSeverity = 1
+	AlertSeverityLow    AlertSeverity = 2
+	AlertSeverityInfo   AlertSeverity = 3
+)
+
+// AlertDefinitionStatus constants start with AlertDefinitionStatus and include all known statuses of an AlertDefinition
+type AlertDefinitionStatus string
+
+const (
+	AlertDefinitionStatusEnabled    AlertDefinitionStatus = "enabled"
+	AlertDefinitionStatusDisabled   AlertDefinitionStatus = "disabled"
+	AlertDefinitionStatusInProgress AlertDefinitionStatus = "in progress"
+	AlertDefinitionStatusFailed     AlertDefinitionStatus = "failed"
+)
+
+// AlertDefinitionType constants start with AlertDefinitionType and include all known types of AlertDefinition
+type AlertDefinitionType string
+
+const (
+	AlertDefinitionTypeUser   AlertDefinitionType = "user"
+	AlertDefinitionTypeSystem AlertDefinitionType = "system"
+)
+
+// AlertRuleOperator constants start with AlertRuleOperator and include all known operators
+// comparing a metric to the threshold of an AlertRule
+type AlertRuleOperator string
+
+const (
+	AlertRuleOperatorEqual              AlertRuleOperator = "eq"
+	AlertRuleOperatorGreaterThan        AlertRuleOperator = "gt"
+	AlertRuleOperatorGreaterThanOrEqual AlertRuleOperator = "gte"
+	AlertRuleOperatorLessThan           AlertRuleOperator = "lt"
+	AlertRuleOperatorLessThanOrEqual    AlertRuleOperator = "lte"
+)
+
+// AlertDefinition is a definition of the conditions on the metrics of a service's entities
+// that raise an alert, and of the channels notified when they do
+// NOTE: The monitoring API may not currently be available to all users.
+type AlertDefinition struct {
+	ID                int                      `json:"id"`
+	Label             string                   `json:"label"`
+	Description       string                   `json:"description"`
+	Severity          AlertSeverity            `json:"severity"`
+	Type              AlertDefinitionType      `json:"type"`
+	ServiceType       MonitorServiceType       `json:"service_type"`
+	Status            AlertDefinitionStatus    `json:"status"`
+	EntityIDs         []string                 `json:"entity_ids"`
+	RuleCriteria      AlertRuleCriteria        `json:"rule_criteria"`
+	TriggerConditions AlertTriggerConditions   `json:"trigger_conditions"`
+	AlertChannels     []AlertDefinitionChannel `json:"alert_channels"`
+	CreatedBy         string                   `json:"created_by"`
+	UpdatedBy         string                   `json:"updated_by"`
+	Created           *time.Time               `json:"-"`
+	Updated           *time.Time               `json:"-"`
+}
+
+// AlertRuleCriteria are the rules of an AlertDefinition
+type AlertRuleCriteria struct {
+	Rules []AlertRule `json:"rules"`
+}
+
+// AlertRule compares the aggregated value of a metric to a threshold
+type AlertRule struct {
+	Metric            string                  `json:"metric"`
+	AggregateFunction MetricAggregateFunction `json:"aggregate_function"`
+	Operator          AlertRuleOperator       `json:"operator"`
+	Threshold         float64                 `json:"threshold"`
+	Label             string                  `json:"label,omitempty"`
+	Unit              string                  `json:"unit,omitempty"`
+	DimensionFilters  []AlertDimensionFilter  `json:"dimension_filters,omitempty"`
+}
+
+// AlertDimensionFilter restricts an AlertRule to the data points of a metric with a dimension
+// matching a value, e.g. only the "read" data points of a disk's "operation" dimension
+type AlertDimensionFilter struct {
+	DimensionLabel string `json:"dimension_label"`
+	Operator       string `json:"operator"`
+	Value          string `json:"value"`
+	Label          string `json:"label,omitempty"`
+}
+
+// AlertTriggerConditions determine when the rules of an AlertDefinition raise an alert: rules are
+// evaluated over EvaluationPeriodSeconds every PollingIntervalSeconds, and an alert is raised once
+// they have been met TriggerOccurrences times in a row
+type AlertTriggerConditions struct {
+	CriteriaCondition       string `json:"criteria_condition"`
+	EvaluationPeriodSeconds int    `json:"evaluation_period_seconds"`
+	PollingIntervalSeconds  int    `json:"polling_interval_seconds"`
+	TriggerOccurrences      int    `json:"trigger_occurrences"`
+}
+
+// AlertDefinitionChannel is a notification channel of an AlertDefinition
+type AlertDefinitionChannel struct {
+	ID    int    `json:"id"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	URL   string `json:"url"`
+}
+
+// AlertDefinitionCreateOptions fields are those accepted by CreateAlertDefinition
+type AlertDefinitionCreateOptions struct {
+	Label             string                 `json:"label"`
+	Description       string                 `json:"description,omitempty"`
+	Severity          AlertSeverity          `json:"severity"`
+	ChannelIDs        []int                  `json:"channel_ids"`
+	EntityIDs         []string               `json:"entity_ids,omitempty"`
+	RuleCriteria      AlertRuleCriteria      `json:"rule_criteria"`
+	TriggerConditions AlertTriggerConditions `json:"trigger_conditions"`
+}
+
+// AlertDefinitionUpdateOptions fields are those accepted by UpdateAlertDefinition
+type AlertDefinitionUpdateOptions struct {
+	Label             string                  `json:"label,omitempty"`
+	Description       *string                 `json:"description,omitempty"`
+	Severity          *AlertSeverity          `json:"severity,omitempty"`
+	Status            AlertDefinitionStatus   `json:"status,omitempty"`
+	ChannelIDs        []int                   `json:"channel_ids,omitempty"`
+	EntityIDs         *[]string               `json:"entity_ids,omitempty"`
+	RuleCriteria      *AlertRuleCriteria      `json:"rule_criteria,omitempty"`
+	TriggerConditions *AlertTriggerConditions `json:"trigger_conditions,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *AlertDefinition) UnmarshalJSON(b []byte) error {
+	type Mask AlertDefinition
+
+	p := struct {
+		*Mask
+		Created *parseabletime.ParseableTime `json:"created"`
+		Updated *parseabletime.ParseableTime `json:"updated"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.Created = (*time.Time)(p.Created)
+	i.Updated = (*time.Time)(p.Updated)
+
+	return nil
+}
+
+// GetCreateOptions converts an AlertDefinition to AlertDefinitionCreateOptions for use in CreateAlertDefinition
+func (i AlertDefinition) GetCreateOptions() AlertDefinitionCreateOptions {
+	channelIDs := make([]int, len(i.AlertChannels))
+	for j, channel := range i.AlertChannels {
+		channelIDs[j] = channel.ID
+	}
+
+	return AlertDefinitionCreateOptions{
+		Label:             i.Label,
+		Description:       i.Description,
+		Severity:          i.Severity,
+		ChannelIDs:        channelIDs,
+		EntityIDs:         i.EntityIDs,
+		RuleCriteria:      i.RuleCriteria,
+		TriggerConditions: i.TriggerConditions,
+	}
+}
+
+// Validate checks that the options include a label, at least one notification channel and
+// at least one rule, and that every rule has a metric, aggregate function and known operator.
+func (opts AlertDefinitionCreateOptions) Validate() error {
+	if opts.Label == "" {
+		return fmt.Errorf("label is required")
+	}
+
+	if len(opts.ChannelIDs) == 0 {
+		return fmt.Errorf("at least one channel ID is required")
+	}
+
+	if len(opts.RuleCriteria.Rules) == 0 {
+		return fmt.Errorf("at least one rule is required")
+	}
+
+	for i, rule := range opts.RuleCriteria.Rules {
+		switch {
+		case rule.Metric == "":
+			return fmt.Errorf("rule_criteria.rules[%d].metric is required", i)
+		case rule.AggregateFunction == "":
+			return fmt.Errorf("rule_criteria.rules[%d].aggregate_function is required", i)
+		case !rule.Operator.IsValid():
+			return fmt.Errorf("rule_criteria.rules[%d].operator %q is not a known operator", i, rule.Operator)
+		}
+	}
+
+	return nil
+}
+
+// ListAlertDefinitions lists the alert definitions of all services
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) ListAlertDefinitions(ctx context.Context, opts *ListOptions) ([]AlertDefinition, error) {
+	return getPaginatedResults[AlertDefinition](ctx, c, "monitor/alert-definitions", opts)
+}
+
+// GetAlertDefinition gets the alert definition of the service with the provided ID
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) GetAlertDefinition(ctx context.Context, serviceType MonitorServiceType, alertID int) (*AlertDefinition, error) {
+	e := formatAPIPath("monitor/services/%s/alert-definitions/%d", string(serviceType), alertID)
+	return doGETRequest[AlertDefinition](ctx, c, e)
+}
+
+// CreateAlertDefinition creates an alert definition for the service
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) CreateAlertDefinition(
+	ctx context.Context,
+	serviceType MonitorServiceType,
+	opts AlertDefinitionCreateOptions,
+) (*AlertDefinition, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	e := formatAPIPath("monitor/services/%s/alert-definitions", string(serviceType))
+	return doPOSTRequest[AlertDefinition](ctx, c, e, opts)
+}
+
+// UpdateAlertDefinition updates the alert definition of the service with the provided ID
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) UpdateAlertDefinition(
+	ctx context.Context,
+	serviceType MonitorServiceType,
+	alertID int,
+	opts AlertDefinitionUpdateOptions,
+) (*AlertDefinition, error) {
+	e := formatAPIPath("monitor/services/%s/alert-definitions/%d", string(serviceType), alertID)
+	return doPUTRequest[AlertDefinition](ctx, c, e, opts)
+}
+
+// DeleteAlertDefinition deletes the alert definition of the service with the provided ID
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) DeleteAlertDefinition(ctx context.Context, serviceType MonitorServiceType, alertID int) error {
+	e := formatAPIPath("monitor/services/%s/alert-definitions/%d", string(serviceType), alertID)
+	return doDELETERequest(ctx, c, e)
+}
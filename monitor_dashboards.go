@@ -0,0 +1,68 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// MonitorDashboard is a dashboard of metric widgets for a service of the monitoring (ACLP) API
+// NOTE: The monitoring API may not currently be available to all users.
+type MonitorDashboard struct {
+	ID          int                      `json:"id"`
+	Label       string                   `json:"label"`
+	ServiceType MonitorServiceType       `json:"service_type"`
+	Type        string                   `json:"type"`
+	Widgets     []MonitorDashboardWidget `json:"widgets"`
+	Created     *time.Time               `json:"-"`
+	Updated     *time.Time               `json:"-"`
+}
+
+// MonitorDashboardWidget is a chart of a metric on a MonitorDashboard
+type MonitorDashboardWidget struct {
+	Metric            string                  `json:"metric"`
+	Unit              string                  `json:"unit"`
+	Label             string                  `json:"label"`
+	Color             string                  `json:"color"`
+	Size              int                     `json:"size"`
+	ChartType         string                  `json:"chart_type"`
+	YLabel            string                  `json:"y_label"`
+	AggregateFunction MetricAggregateFunction `json:"aggregate_function"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *MonitorDashboard) UnmarshalJSON(b []byte) error {
+	type Mask MonitorDashboard
+
+	p := struct {
+		*Mask
+		Created *parseabletime.ParseableTime `json:"created"`
+		Updated *parseabletime.ParseableTime `json:"updated"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.Created = (*time.Time)(p.Created)
+	i.Updated = (*time.Time)(p.Updated)
+
+	return nil
+}
+
+// ListMonitorDashboards lists the dashboards of the monitoring (ACLP) API
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) ListMonitorDashboards(ctx context.Context, opts *ListOptions) ([]MonitorDashboard, error) {
+	return getPaginatedResults[MonitorDashboard](ctx, c, "monitor/dashboards", opts)
+}
+
+// GetMonitorDashboard gets the dashboard of the monitoring (ACLP) API with the provided ID
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) GetMonitorDashboard(ctx context.Context, dashboardID int) (*MonitorDashboard, error) {
+	e := formatAPIPath("monitor/dashboards/%d", dashboardID)
+	return doGETRequest[MonitorDashboard](ctx, c, e)
+}
@@ -0,0 +1,250 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultMonitorAPIURL is the default base URL of the monitoring (ACLP) metrics API
+const DefaultMonitorAPIURL = "https://monitor-api.linode.com/v2beta"
+
+// defaultMetricsWindow is used when MetricsQueryOptions.Window is not set
+const defaultMetricsWindow = time.Hour
+
+// MetricAggregateFunction constants start with MetricAggregate and include all
+// known functions aggregating the data points of a metric
+type MetricAggregateFunction string
+
+const (
+	MetricAggregateAverage MetricAggregateFunction = "avg"
+	MetricAggregateSum     MetricAggregateFunction = "sum"
+	MetricAggregateMin     MetricAggregateFunction = "min"
+	MetricAggregateMax     MetricAggregateFunction = "max"
+	MetricAggregateCount   MetricAggregateFunction = "count"
+)
+
+// MetricsQueryOptions select the metrics returned by GetInstanceMetrics
+type MetricsQueryOptions struct {
+	// Metrics are the names of the metrics to return, e.g. "cpu_usage"
+	Metrics []string
+
+	// Aggregation is the function aggregating data points; defaults to MetricAggregateAverage
+	Aggregation MetricAggregateFunction
+
+	// Window is how far back from now data points are returned; defaults to one hour.
+	// It must be a whole number of minutes.
+	Window time.Duration
+
+	// Granularity is the interval between data points, which is chosen by the API if 0.
+	// It must be a whole number of minutes.
+	Granularity time.Duration
+}
+
+// MetricSeries is the time series of a metric for an entity
+type MetricSeries struct {
+	// Name is the name of the metric, e.g. "cpu_usage"
+	Name string
+
+	// EntityID is the ID of the entity the metric was collected from
+	EntityID int
+
+	// Labels are all labels of the series reported by the API, including its name and entity
+	Labels map[string]string
+
+	// Points are the data points of the series, oldest first
+	Points []MetricPoint
+}
+
+// MetricPoint is a data point of a MetricSeries
+type MetricPoint struct {
+	Time  time.Time
+	Value float64
+}
+
+// metricsDuration is the representation of a duration used by the metrics API
+type metricsDuration struct {
+	Unit  string `json:"unit"`
+	Value int    `json:"value"`
+}
+
+type metricsQueryMetric struct {
+	Name              string                  `json:"name"`
+	AggregateFunction MetricAggregateFunction `json:"aggregate_function"`
+}
+
+type metricsQueryRequest struct {
+	EntityIDs            []int                `json:"entity_ids"`
+	Metrics              []metricsQueryMetric `json:"metrics"`
+	RelativeTimeDuration metricsDuration      `json:"relative_time_duration"`
+	TimeGranularity      *metricsDuration     `json:"time_granularity,omitempty"`
+}
+
+type metricsQueryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		Result []struct {
+			Metric map[string]any `json:"metric"`
+			Values [][2]any       `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+type monitorServiceToken struct {
+	Token string `json:"token"`
+}
+
+// SetMonitorAPIURL sets the base URL of the monitoring (ACLP) metrics API used by GetInstanceMetrics.
+// Defaults to DefaultMonitorAPIURL.
+func (c *Client) SetMonitorAPIURL(monitorURL string) *Client {
+	c.monitorURL = strings.TrimSuffix(monitorURL, "/")
+	return c
+}
+
+// GetInstanceMetrics gets time series of metrics such as CPU, memory, disk and network usage of
+// the Instance with the provided ID from the monitoring (ACLP) API, the replacement for the stats
+// returned by GetInstanceStats. A token for the metrics API is first created for the Instance.
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) GetInstanceMetrics(ctx context.Context, linodeID int, opts MetricsQueryOptions) ([]MetricSeries, error) {
+	request, err := opts.request(linodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := doPOSTRequest[monitorServiceToken](ctx, c, "monitor/services/linode/token", map[string][]int{
+		"entity_ids": {linodeID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	monitorURL := c.monitorURL
+	if monitorURL == "" {
+		monitorURL = DefaultMonitorAPIURL
+	}
+
+	var response metricsQueryResponse
+
+	req := c.R(ctx).
+		SetHeader("Authorization", "Bearer "+token.Token).
+		SetBody(request).
+		SetResult(&response)
+
+	if _, err := coupleAPIErrors(req.Post(monitorURL + "/monitor/services/linode/metrics")); err != nil {
+		return nil, err
+	}
+
+	return response.series()
+}
+
+// request returns the metrics API request for the options
+func (opts MetricsQueryOptions) request(entityID int) (*metricsQueryRequest, error) {
+	if len(opts.Metrics) == 0 {
+		return nil, fmt.Errorf("at least one metric is required")
+	}
+
+	aggregation := opts.Aggregation
+	if aggregation == "" {
+		aggregation = MetricAggregateAverage
+	}
+
+	window := opts.Window
+	if window == 0 {
+		window = defaultMetricsWindow
+	}
+
+	relative, err := newMetricsDuration(window)
+	if err != nil {
+		return nil, fmt.Errorf("invalid window: %w", err)
+	}
+
+	request := &metricsQueryRequest{
+		EntityIDs:            []int{entityID},
+		RelativeTimeDuration: relative,
+	}
+
+	if opts.Granularity != 0 {
+		granularity, err := newMetricsDuration(opts.Granularity)
+		if err != nil {
+			return nil, fmt.Errorf("invalid granularity: %w", err)
+		}
+
+		request.TimeGranularity = &granularity
+	}
+
+	for _, name := range opts.Metrics {
+		request.Metrics = append(request.Metrics, metricsQueryMetric{Name: name, AggregateFunction: aggregation})
+	}
+
+	return request, nil
+}
+
+// newMetricsDuration converts d to the largest unit of the metrics API dividing it
+func newMetricsDuration(d time.Duration) (metricsDuration, error) {
+	if d <= 0 || d%time.Minute != 0 {
+		return metricsDuration{}, fmt.Errorf("%s is not a positive whole number of minutes", d)
+	}
+
+	switch {
+	case d%(24*time.Hour) == 0:
+		return metricsDuration{Unit: "days", Value: int(d / (24 * time.Hour))}, nil
+	case d%time.Hour == 0:
+		return metricsDuration{Unit: "hr", Value: int(d / time.Hour)}, nil
+	default:
+		return metricsDuration{Unit: "min", Value: int(d / time.Minute)}, nil
+	}
+}
+
+// series parses the time series of the response, whose data points are pairs
+// of a Unix timestamp and a value formatted as a string
+func (r metricsQueryResponse) series() ([]MetricSeries, error) {
+	result := make([]MetricSeries, len(r.Data.Result))
+
+	for i, s := range r.Data.Result {
+		series := MetricSeries{
+			Labels: make(map[string]string, len(s.Metric)),
+			Points: make([]MetricPoint, len(s.Values)),
+		}
+
+		for k, v := range s.Metric {
+			series.Labels[k] = fmt.Sprint(v)
+		}
+
+		series.Name = series.Labels["metric_name"]
+		series.EntityID, _ = strconv.Atoi(eventEntityID(s.Metric["entity_id"]))
+
+		for j, v := range s.Values {
+			timestamp, ok := v[0].(float64)
+			if !ok {
+				return nil, fmt.Errorf("invalid timestamp %v of metric %s", v[0], series.Name)
+			}
+
+			value, err := parseMetricValue(v[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of metric %s: %w", series.Name, err)
+			}
+
+			series.Points[j] = MetricPoint{Time: time.Unix(int64(timestamp), 0).UTC(), Value: value}
+		}
+
+		result[i] = series
+	}
+
+	return result, nil
+}
+
+func parseMetricValue(v any) (float64, error) {
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	case json.Number:
+		return v.Float64()
+	default:
+		return 0, fmt.Errorf("unexpected value %v", v)
+	}
+}
@@ -0,0 +1,28 @@
+package linodego
+
+import (
+	"context"
+)
+
+// MonitorServiceType constants start with MonitorServiceType and include the types of
+// services supported by the monitoring (ACLP) API
+type MonitorServiceType string
+
+const (
+	MonitorServiceTypeDBaaS        MonitorServiceType = "dbaas"
+	MonitorServiceTypeLinode       MonitorServiceType = "linode"
+	MonitorServiceTypeNodeBalancer MonitorServiceType = "nodebalancer"
+)
+
+// MonitorService is a service whose metrics are available from the monitoring (ACLP) API
+// NOTE: The monitoring API may not currently be available to all users.
+type MonitorService struct {
+	ServiceType MonitorServiceType `json:"service_type"`
+	Label       string             `json:"label"`
+}
+
+// ListMonitorServices lists the services supported by the monitoring (ACLP) API
+// NOTE: The monitoring API may not currently be available to all users.
+func (c *Client) ListMonitorServices(ctx context.Context, opts *ListOptions) ([]MonitorService, error) {
+	return getPaginatedResults[MonitorService](ctx, c, "monitor/services", opts)
+}
@@ -0,0 +1,219 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+)
+
+// FailoverMethod is the mechanism a region uses to move shared addresses between Linodes
+type FailoverMethod string
+
+const (
+	// FailoverMethodBGP is used in regions where shared addresses are announced over BGP
+	// by a routing daemon such as lelastic running on each Linode.
+	FailoverMethodBGP FailoverMethod = "bgp"
+
+	// FailoverMethodClassic is used in regions where shared IPv4 addresses are moved using ARP
+	// and do not require a routing daemon. IPv6 ranges cannot be shared in these regions.
+	FailoverMethodClassic FailoverMethod = "classic"
+)
+
+// FailoverDaemonLelastic is the routing daemon Linode provides for BGP-based failover
+const FailoverDaemonLelastic = "lelastic"
+
+// FailoverOptions fields are those accepted by ConfigureFailover
+type FailoverOptions struct {
+	PrimaryLinodeID   int
+	SecondaryLinodeID int
+
+	// Address is a public IPv4 address or an IPv6 range in CIDR notation, e.g. "2600:3c03:e000:123::/64"
+	Address string
+
+	// Method is the failover method of the Linodes' region. The API only reports this for IPv6 ranges,
+	// so it is ignored for them. Defaults to FailoverMethodBGP for IPv4 addresses.
+	Method FailoverMethod
+}
+
+// FailoverGuidance describes the configuration required on the secondary Linode
+// for a shared address to fail over to it.
+type FailoverGuidance struct {
+	Method  FailoverMethod
+	Address string
+	Prefix  int
+
+	// Daemon is the routing daemon that must announce the address, or empty
+	// if no daemon is required.
+	Daemon string
+}
+
+// ConfigureFailover validates that an address of the primary Linode can be shared with the secondary
+// Linode and shares it, preserving addresses already shared with the secondary Linode.
+// The returned FailoverGuidance describes the configuration required on the secondary Linode.
+func (c *Client) ConfigureFailover(ctx context.Context, opts FailoverOptions) (*FailoverGuidance, error) {
+	primary, err := c.GetInstance(ctx, opts.PrimaryLinodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	secondary, err := c.GetInstance(ctx, opts.SecondaryLinodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if primary.Region != secondary.Region {
+		return nil, fmt.Errorf("linodes %d and %d must be in the same region to share addresses", primary.ID, secondary.ID)
+	}
+
+	method := opts.Method
+	if method == "" {
+		method = FailoverMethodBGP
+	}
+
+	if strings.Contains(opts.Address, "/") {
+		ipRange, err := c.GetIPv6Range(ctx, opts.Address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get IPv6 range %s: %w", opts.Address, err)
+		}
+
+		method = FailoverMethodClassic
+		if ipRange.IsBGP {
+			method = FailoverMethodBGP
+		}
+	}
+
+	prefix, err := validateFailoverAddress(opts.Address, method)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryIPs, err := c.GetInstanceIPAddresses(ctx, primary.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !failoverAddressAssigned(primaryIPs, opts.Address) {
+		return nil, fmt.Errorf("address %s is not assigned to linode %d", opts.Address, primary.ID)
+	}
+
+	ips, err := c.GetInstanceIPAddresses(ctx, secondary.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	shareOpts := IPAddressesShareOptions{
+		IPs:      sharedFailoverAddresses(ips, opts.Address),
+		LinodeID: secondary.ID,
+	}
+
+	if err := c.ShareIPAddresses(ctx, shareOpts); err != nil {
+		return nil, err
+	}
+
+	guidance := &FailoverGuidance{
+		Method:  method,
+		Address: opts.Address,
+		Prefix:  prefix,
+	}
+
+	if method == FailoverMethodBGP {
+		guidance.Daemon = FailoverDaemonLelastic
+	}
+
+	return guidance, nil
+}
+
+// validateFailoverAddress returns the prefix length of the given address
+// if it can be shared using the given failover method.
+func validateFailoverAddress(address string, method FailoverMethod) (int, error) {
+	if method != FailoverMethodBGP && method != FailoverMethodClassic {
+		return 0, fmt.Errorf("unknown failover method %q", method)
+	}
+
+	if !strings.Contains(address, "/") {
+		ip := net.ParseIP(address)
+
+		switch {
+		case ip == nil:
+			return 0, fmt.Errorf("invalid IP address %q", address)
+		case ip.To4() == nil:
+			return 0, fmt.Errorf("IPv6 address %s cannot be shared; share an IPv6 range instead", address)
+		case ip.IsPrivate():
+			return 0, fmt.Errorf("private IPv4 address %s cannot be shared", address)
+		}
+
+		return 32, nil
+	}
+
+	ip, network, err := net.ParseCIDR(address)
+	if err != nil {
+		return 0, fmt.Errorf("invalid IP range %q: %w", address, err)
+	}
+
+	if ip.To4() != nil {
+		return 0, fmt.Errorf("IPv4 range %s cannot be shared; share individual addresses instead", address)
+	}
+
+	if method != FailoverMethodBGP {
+		return 0, fmt.Errorf("IPv6 range %s can only be shared in regions using BGP-based failover", address)
+	}
+
+	prefix, _ := network.Mask.Size()
+	if prefix != 56 && prefix != 64 {
+		return 0, fmt.Errorf("IPv6 range %s cannot be shared; only /56 and /64 ranges are supported", address)
+	}
+
+	return prefix, nil
+}
+
+// failoverAddressAssigned reports whether the given address is one of a Linode's public IPv4
+// addresses, or an IPv6 range routed to the Linode rather than shared with it.
+func failoverAddressAssigned(ips *InstanceIPAddressResponse, address string) bool {
+	if !strings.Contains(address, "/") {
+		return ips.IPv4 != nil && slices.ContainsFunc(ips.IPv4.Public, func(ip *InstanceIP) bool {
+			return ip.Address == address
+		})
+	}
+
+	if ips.IPv6 == nil || ips.IPv6.SLAAC == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(ips.IPv6.Global, func(r IPv6Range) bool {
+		return fmt.Sprintf("%s/%d", r.Range, r.Prefix) == address && r.RouteTarget == ips.IPv6.SLAAC.Address
+	})
+}
+
+// sharedFailoverAddresses returns the addresses currently shared with a Linode
+// with the given address appended. The API replaces all shared addresses on each
+// share request, so existing shares must be resent.
+func sharedFailoverAddresses(ips *InstanceIPAddressResponse, address string) []string {
+	result := make([]string, 0)
+
+	if ips.IPv4 != nil {
+		for _, ip := range ips.IPv4.Shared {
+			if ip.Address != address {
+				result = append(result, ip.Address)
+			}
+		}
+	}
+
+	if ips.IPv6 != nil {
+		slaac := ""
+		if ips.IPv6.SLAAC != nil {
+			slaac = ips.IPv6.SLAAC.Address
+		}
+
+		// Ranges routed to another Linode's SLAAC address are shared with this Linode
+		for _, r := range ips.IPv6.Global {
+			rangeAddress := fmt.Sprintf("%s/%d", r.Range, r.Prefix)
+			if r.RouteTarget != slaac && rangeAddress != address {
+				result = append(result, rangeAddress)
+			}
+		}
+	}
+
+	return append(result, address)
+}
@@ -0,0 +1,63 @@
+package linodego
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateFailoverAddress(t *testing.T) {
+	testCases := []struct {
+		name    string
+		address string
+		method  FailoverMethod
+		prefix  int
+		wantErr bool
+	}{
+		{"ipv4 bgp", "45.79.1.2", FailoverMethodBGP, 32, false},
+		{"ipv4 classic", "45.79.1.2", FailoverMethodClassic, 32, false},
+		{"private ipv4", "192.168.1.2", FailoverMethodBGP, 0, true},
+		{"ipv4 range", "45.79.1.0/24", FailoverMethodBGP, 0, true},
+		{"ipv6 address", "2600:3c03::f03c:91ff:fe24:3a2f", FailoverMethodBGP, 0, true},
+		{"ipv6 /64 bgp", "2600:3c03:e000:123::/64", FailoverMethodBGP, 64, false},
+		{"ipv6 /56 bgp", "2600:3c03:e000:100::/56", FailoverMethodBGP, 56, false},
+		{"ipv6 /64 classic", "2600:3c03:e000:123::/64", FailoverMethodClassic, 0, true},
+		{"ipv6 /116 bgp", "2600:3c03:e000:123::1000/116", FailoverMethodBGP, 0, true},
+		{"invalid address", "not-an-ip", FailoverMethodBGP, 0, true},
+		{"unknown method", "45.79.1.2", FailoverMethod("arp"), 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prefix, err := validateFailoverAddress(tc.address, tc.method)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.prefix, prefix)
+		})
+	}
+}
+
+func TestSharedFailoverAddresses(t *testing.T) {
+	ips := &InstanceIPAddressResponse{
+		IPv4: &InstanceIPv4Response{
+			Shared: []*InstanceIP{{Address: "45.79.1.3"}},
+		},
+		IPv6: &InstanceIPv6Response{
+			SLAAC: &InstanceIP{Address: "2600:3c03::2"},
+			Global: []IPv6Range{
+				{Range: "2600:3c03:e000:1::", Prefix: 64, RouteTarget: "2600:3c03::2"},
+				{Range: "2600:3c03:e000:2::", Prefix: 64, RouteTarget: "2600:3c03::1"},
+			},
+		},
+	}
+
+	require.Equal(
+		t,
+		[]string{"45.79.1.3", "2600:3c03:e000:2::/64", "45.79.1.2"},
+		sharedFailoverAddresses(ips, "45.79.1.2"),
+	)
+}
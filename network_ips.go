@@ -2,16 +2,25 @@ package linodego
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/netip"
 )
 
+// ErrInvalidAddress is returned when an IP address passed to a method is not one it accepts.
+// The request is not made, rather than the API returning a confusing 404 for the malformed path.
+var ErrInvalidAddress = errors.New("invalid IP address")
+
 // IPAddressUpdateOptions fields are those accepted by UpdateToken
 type IPAddressUpdateOptions struct {
 	// The reverse DNS assigned to this address. For public IPv4 addresses, this will be set to a default value provided by Linode if set to nil.
+	// A nil RDNS is sent as an explicit null, which resets the reverse DNS of the address.
 	RDNS *string `json:"rdns"`
 }
 
 // LinodeIPAssignment stores an assignment between an IP address and a Linode instance.
 type LinodeIPAssignment struct {
+	// Address is an IPv4 address, or an IPv6 range in CIDR notation, e.g. "2600:3c01:e000:3e6::/64"
 	Address  string `json:"address"`
 	LinodeID int    `json:"linode_id"`
 }
@@ -41,7 +50,8 @@ func (i InstanceIP) GetUpdateOptions() (o IPAddressUpdateOptions) {
 	return
 }
 
-// ListIPAddresses lists IPAddresses
+// ListIPAddresses lists every IP address on the Account, along with the Linode it is
+// assigned to (if any), its type, region and whether it is reserved
 func (c *Client) ListIPAddresses(ctx context.Context, opts *ListOptions) ([]InstanceIP, error) {
 	response, err := getPaginatedResults[InstanceIP](ctx, c, "networking/ips", opts)
 	if err != nil {
@@ -51,6 +61,14 @@ func (c *Client) ListIPAddresses(ctx context.Context, opts *ListOptions) ([]Inst
 	return response, nil
 }
 
+// ListIPAddressesByRegion lists the IP addresses on the Account in the given region
+func (c *Client) ListIPAddressesByRegion(ctx context.Context, region string) ([]InstanceIP, error) {
+	f := Filter{}
+	f.AddField(Eq, "region", region)
+
+	return c.ListIPAddresses(ctx, NewListOptions(0, &f))
+}
+
 // GetIPAddress gets the template with the provided ID
 func (c *Client) GetIPAddress(ctx context.Context, id string) (*InstanceIP, error) {
 	e := formatAPIPath("networking/ips/%s", id)
@@ -62,8 +80,28 @@ func (c *Client) GetIPAddress(ctx context.Context, id string) (*InstanceIP, erro
 	return response, nil
 }
 
-// UpdateIPAddress updates the IPAddress with the specified id
+// validateIPAddress checks that address is an IP address without a zone, which must be
+// IPv4 if ipv4Only is set, returning an error wrapping ErrInvalidAddress otherwise.
+func validateIPAddress(address string, ipv4Only bool) error {
+	addr, err := netip.ParseAddr(address)
+	if err != nil || addr.Zone() != "" {
+		return fmt.Errorf("%w: %q", ErrInvalidAddress, address)
+	}
+
+	if ipv4Only && !addr.Is4() {
+		return fmt.Errorf("%w: %q is not an IPv4 address", ErrInvalidAddress, address)
+	}
+
+	return nil
+}
+
+// UpdateIPAddress updates the IPAddress with the specified id, which may be an IPv4 address or an
+// IPv6 address, such as one within a range routed to an Instance.
 func (c *Client) UpdateIPAddress(ctx context.Context, id string, opts IPAddressUpdateOptions) (*InstanceIP, error) {
+	if err := validateIPAddress(id, false); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("networking/ips/%s", id)
 	response, err := doPUTRequest[InstanceIP](ctx, c, e, opts)
 	if err != nil {
@@ -73,9 +111,33 @@ func (c *Client) UpdateIPAddress(ctx context.Context, id string, opts IPAddressU
 	return response, nil
 }
 
+// ResetIPAddressesRDNS resets the reverse DNS of each of the given addresses to its default,
+// returning the updated addresses. Every address is reset even if resetting another fails;
+// the returned error joins the errors of the addresses that could not be reset.
+func (c *Client) ResetIPAddressesRDNS(ctx context.Context, addresses ...string) ([]InstanceIP, error) {
+	results := make([]InstanceIP, 0, len(addresses))
+	errs := make([]error, 0)
+
+	for _, address := range addresses {
+		ip, err := c.UpdateIPAddress(ctx, address, IPAddressUpdateOptions{RDNS: nil})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to reset the RDNS of %s: %w", address, err))
+			continue
+		}
+
+		results = append(results, *ip)
+	}
+
+	return results, errors.Join(errs...)
+}
+
 // InstancesAssignIPs assigns multiple IPv4 addresses and/or IPv6 ranges to multiple Linodes in one Region.
 // This allows swapping, shuffling, or otherwise reorganizing IPs to your Linodes.
 func (c *Client) InstancesAssignIPs(ctx context.Context, opts LinodesAssignIPsOptions) error {
+	if len(opts.Assignments) == 0 {
+		return fmt.Errorf("at least one assignment is required")
+	}
+
 	e := "networking/ips/assign"
 	_, err := doPOSTRequest[InstanceIP](ctx, c, e, opts)
 	return err
@@ -2,6 +2,8 @@ package linodego
 
 import (
 	"context"
+	"slices"
+	"time"
 )
 
 // ReserveIPOptions represents the options for reserving an IP address
@@ -22,9 +24,41 @@ func (c *Client) ListReservedIPAddresses(ctx context.Context, opts *ListOptions)
 	return response, nil
 }
 
+// ListReservedIPAddressesInRegion retrieves the reserved IP addresses in a region, ordered by
+// when they were reserved, oldest first. Addresses without a creation time are listed last.
+// NOTE: Reserved IP feature may not currently be available to all users.
+func (c *Client) ListReservedIPAddressesInRegion(ctx context.Context, region string) ([]InstanceIP, error) {
+	f := Filter{}
+	f.AddField(Eq, "region", region)
+
+	ips, err := c.ListReservedIPAddresses(ctx, NewListOptions(0, &f))
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(ips, func(a, b InstanceIP) int {
+		switch {
+		case a.Created == nil && b.Created == nil:
+			return 0
+		case a.Created == nil:
+			return 1
+		case b.Created == nil:
+			return -1
+		default:
+			return a.Created.Compare(*b.Created)
+		}
+	})
+
+	return ips, nil
+}
+
 // GetReservedIPAddress retrieves details of a specific reserved IP address
 // NOTE: Reserved IP feature may not currently be available to all users.
 func (c *Client) GetReservedIPAddress(ctx context.Context, ipAddress string) (*InstanceIP, error) {
+	if err := validateIPAddress(ipAddress, true); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("networking/reserved/ips/%s", ipAddress)
 	response, err := doGETRequest[InstanceIP](ctx, c, e)
 	if err != nil {
@@ -49,6 +83,57 @@ func (c *Client) ReserveIPAddress(ctx context.Context, opts ReserveIPOptions) (*
 // DeleteReservedIPAddress deletes a reserved IP address
 // NOTE: Reserved IP feature may not currently be available to all users.
 func (c *Client) DeleteReservedIPAddress(ctx context.Context, ipAddress string) error {
+	if err := validateIPAddress(ipAddress, true); err != nil {
+		return err
+	}
+
 	e := formatAPIPath("networking/reserved/ips/%s", ipAddress)
 	return doDELETERequest(ctx, c, e)
 }
+
+// UnassignedReservedIP is a reserved IP address that is not assigned to a Linode
+// NOTE: Reserved IP feature may not currently be available to all users.
+type UnassignedReservedIP struct {
+	InstanceIP
+
+	// Age is how long the address has been reserved, or nil if the API did not return when it was reserved
+	Age *time.Duration
+}
+
+// ReservedIPAge returns how long the reserved IP address has been reserved. The second return
+// value is false if the API did not return when the address was reserved.
+func ReservedIPAge(ip InstanceIP) (time.Duration, bool) {
+	if ip.Created == nil {
+		return 0, false
+	}
+
+	return time.Since(*ip.Created), true
+}
+
+// ListUnassignedReservedIPs retrieves the reserved IP addresses that are not assigned
+// to a Linode, which continue to be billed while unattached, along with how long each
+// has been reserved when the API returns it.
+// NOTE: Reserved IP feature may not currently be available to all users.
+func (c *Client) ListUnassignedReservedIPs(ctx context.Context) ([]UnassignedReservedIP, error) {
+	ips, err := c.ListReservedIPAddresses(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]UnassignedReservedIP, 0, len(ips))
+
+	for _, ip := range ips {
+		if ip.LinodeID != 0 {
+			continue
+		}
+
+		unassigned := UnassignedReservedIP{InstanceIP: ip}
+		if age, ok := ReservedIPAge(ip); ok {
+			unassigned.Age = &age
+		}
+
+		result = append(result, unassigned)
+	}
+
+	return result, nil
+}
@@ -52,6 +52,21 @@ type NodeBalancerCreateOptions struct {
 	Configs            []*NodeBalancerConfigCreateOptions `json:"configs,omitempty"`
 	Tags               []string                           `json:"tags"`
 	FirewallID         int                                `json:"firewall_id,omitempty"`
+
+	// VPCs are the VPC subnets the NodeBalancer is attached to, allowing it to reach backend
+	// nodes by their VPC IPs.
+	// NOTE: VPC NodeBalancers may not currently be available to all users.
+	VPCs []NodeBalancerVPCOptions `json:"vpcs,omitempty"`
+}
+
+// NodeBalancerVPCOptions attach a NodeBalancer to a VPC subnet
+type NodeBalancerVPCOptions struct {
+	SubnetID int `json:"subnet_id"`
+
+	// IPv4Range is the range within the subnet the NodeBalancer's VPC addresses are taken from.
+	// If empty, a range is assigned automatically.
+	IPv4Range string `json:"ipv4_range,omitempty"`
+	IPv6Range string `json:"ipv6_range,omitempty"`
 }
 
 // NodeBalancerUpdateOptions are the options permitted for UpdateNodeBalancer
@@ -123,8 +138,22 @@ func (c *Client) GetNodeBalancer(ctx context.Context, nodebalancerID int) (*Node
 	return response, nil
 }
 
-// CreateNodeBalancer creates a NodeBalancer
+// CreateNodeBalancer creates a NodeBalancer.
+// When strict validation is enabled, the regions of the VPC subnets used by the NodeBalancer
+// and its backend nodes are checked against the NodeBalancer's region before it is created.
+// VPC backend nodes require the NodeBalancer to be attached to a VPC, and each config's nodes
+// must either all be VPC backends or all be public backends.
 func (c *Client) CreateNodeBalancer(ctx context.Context, opts NodeBalancerCreateOptions) (*NodeBalancer, error) {
+	if c.strictValidation {
+		if err := c.checkNodeBalancerSubnetRegions(ctx, opts.Region, opts.subnetIDs()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := opts.validateBackends(); err != nil {
+		return nil, err
+	}
+
 	e := "nodebalancers"
 	response, err := doPOSTRequest[NodeBalancer](ctx, c, e, opts)
 	if err != nil {
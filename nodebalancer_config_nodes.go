@@ -2,6 +2,8 @@ package linodego
 
 import (
 	"context"
+	"fmt"
+	"time"
 )
 
 // NodeBalancerNode objects represent a backend that can accept traffic for a NodeBalancer Config
@@ -14,6 +16,11 @@ type NodeBalancerNode struct {
 	Mode           NodeMode `json:"mode"`
 	ConfigID       int      `json:"config_id"`
 	NodeBalancerID int      `json:"nodebalancer_id"`
+
+	// VPCConfigID is the ID of the NodeBalancer VPC configuration the node is reached through,
+	// or 0 for nodes addressed by a private IP.
+	// NOTE: VPC NodeBalancers may not currently be available to all users.
+	VPCConfigID int `json:"vpc_config_id"`
 }
 
 // NodeMode is the mode a NodeBalancer should use when sending traffic to a NodeBalancer Node
@@ -39,6 +46,10 @@ type NodeBalancerNodeCreateOptions struct {
 	Label   string   `json:"label"`
 	Weight  int      `json:"weight,omitempty"`
 	Mode    NodeMode `json:"mode,omitempty"`
+
+	// SubnetID is the VPC subnet of a node addressed by its VPC IP.
+	// NOTE: VPC NodeBalancers may not currently be available to all users.
+	SubnetID int `json:"subnet_id,omitempty"`
 }
 
 // NodeBalancerNodeUpdateOptions fields are those accepted by UpdateNodeBalancerNode
@@ -47,6 +58,10 @@ type NodeBalancerNodeUpdateOptions struct {
 	Label   string   `json:"label,omitempty"`
 	Weight  int      `json:"weight,omitempty"`
 	Mode    NodeMode `json:"mode,omitempty"`
+
+	// SubnetID is the VPC subnet of a node addressed by its VPC IP.
+	// NOTE: VPC NodeBalancers may not currently be available to all users.
+	SubnetID int `json:"subnet_id,omitempty"`
 }
 
 // GetCreateOptions converts a NodeBalancerNode to NodeBalancerNodeCreateOptions for use in CreateNodeBalancerNode
@@ -90,8 +105,21 @@ func (c *Client) GetNodeBalancerNode(ctx context.Context, nodebalancerID int, co
 	return response, nil
 }
 
-// CreateNodeBalancerNode creates a NodeBalancerNode
+// CreateNodeBalancerNode creates a NodeBalancerNode.
+// When strict validation is enabled, the region of the node's VPC subnet is checked
+// against the NodeBalancer's region before the node is created.
 func (c *Client) CreateNodeBalancerNode(ctx context.Context, nodebalancerID int, configID int, opts NodeBalancerNodeCreateOptions) (*NodeBalancerNode, error) {
+	if c.strictValidation && opts.SubnetID != 0 {
+		nodebalancer, err := c.GetNodeBalancer(ctx, nodebalancerID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := c.checkNodeBalancerSubnetRegions(ctx, nodebalancer.Region, []int{opts.SubnetID}); err != nil {
+			return nil, err
+		}
+	}
+
 	e := formatAPIPath("nodebalancers/%d/configs/%d/nodes", nodebalancerID, configID)
 	response, err := doPOSTRequest[NodeBalancerNode](ctx, c, e, opts)
 	if err != nil {
@@ -118,3 +146,80 @@ func (c *Client) DeleteNodeBalancerNode(ctx context.Context, nodebalancerID int,
 	err := doDELETERequest(ctx, c, e)
 	return err
 }
+
+// DrainAndDeleteNodeBalancerNode sets the NodeBalancerNode to drain mode, waits for the
+// NodeBalancer to report no active connections or for drainTimeout to elapse, and then
+// deletes the node.
+//
+// The API only reports connection statistics for the NodeBalancer as a whole, so the
+// node is considered drained once the most recent connections sample reaches zero.
+// When stats are unavailable (e.g. for a newly created NodeBalancer) the full
+// drainTimeout is waited before deleting. If ctx ends first, the node is not deleted.
+func (c *Client) DrainAndDeleteNodeBalancerNode(
+	ctx context.Context,
+	nodebalancerID, configID, nodeID int,
+	drainTimeout time.Duration,
+	opts ...WaitOption,
+) error {
+	if _, err := c.UpdateNodeBalancerNode(ctx, nodebalancerID, configID, nodeID, NodeBalancerNodeUpdateOptions{
+		Mode: ModeDrain,
+	}); err != nil {
+		return err
+	}
+
+	if err := c.waitForNodeBalancerDrain(ctx, nodebalancerID, drainTimeout, opts...); err != nil {
+		return err
+	}
+
+	return c.DeleteNodeBalancerNode(ctx, nodebalancerID, configID, nodeID)
+}
+
+// waitForNodeBalancerDrain polls the NodeBalancer's stats until no active connections
+// are reported or drainTimeout elapses. It only returns an error if ctx itself ends.
+func (c *Client) waitForNodeBalancerDrain(
+	ctx context.Context,
+	nodebalancerID int,
+	drainTimeout time.Duration,
+	opts ...WaitOption,
+) error {
+	drainCtx, cancel := context.WithTimeout(ctx, drainTimeout)
+	defer cancel()
+
+	w := c.newWaiter(opts)
+
+	err := w.poll(drainCtx, func() (bool, error) {
+		stats, err := c.GetNodeBalancerStats(drainCtx, nodebalancerID)
+		if err != nil {
+			// Stats are unavailable, e.g. for a newly created NodeBalancer
+			w.report("", nil)
+			return false, nil
+		}
+
+		drained := stats.Data.connectionsDrained()
+		if drained {
+			w.report("drained", nil)
+		} else {
+			w.report("draining", nil)
+		}
+
+		return drained, nil
+	})
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("failed to wait for NodeBalancer %d to drain: %w", nodebalancerID, ctx.Err())
+	}
+
+	// The node is deleted once drainTimeout elapses, whether or not it has drained
+	return nil
+}
+
+// connectionsDrained reports whether the most recent connections sample is zero. Stats without
+// a connections sample are treated as unknown rather than drained.
+func (d NodeBalancerStatsData) connectionsDrained() bool {
+	if len(d.Connections) == 0 {
+		return false
+	}
+
+	sample := d.Connections[len(d.Connections)-1]
+
+	return len(sample) > 1 && sample[1] == 0
+}
@@ -2,6 +2,10 @@ package linodego
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
 )
 
 // NodeBalancerConfig objects allow a NodeBalancer to accept traffic on a new port
@@ -145,6 +149,129 @@ type NodeBalancerConfigRebuildNodeOptions struct {
 // NodeBalancerConfigUpdateOptions are permitted by UpdateNodeBalancerConfig
 type NodeBalancerConfigUpdateOptions NodeBalancerConfigCreateOptions
 
+// Bounds of the health check settings accepted by the API
+const (
+	nodeBalancerCheckIntervalMin = 2
+	nodeBalancerCheckIntervalMax = 3600
+	nodeBalancerCheckTimeoutMin  = 1
+	nodeBalancerCheckTimeoutMax  = 30
+	nodeBalancerCheckAttemptsMin = 1
+	nodeBalancerCheckAttemptsMax = 30
+)
+
+// Validate checks the health check settings and nodes of the options, returning an error describing
+// every setting the API would reject. HTTP checks require a CheckPath, HTTP body checks also
+// require a CheckBody, and the interval, timeout and attempts must be within the API's bounds.
+// Node addresses must be in the form host:port and node modes must be known NodeModes.
+// The nodes must either all be VPC backends, with a SubnetID, or all be public backends.
+func (opts NodeBalancerConfigCreateOptions) Validate() error {
+	errs := []error{
+		validateNodeBalancerCheckTarget(opts.Check, opts.CheckPath, opts.CheckBody),
+		validateNodeBalancerCheckTuning(opts.CheckInterval, opts.CheckTimeout, opts.CheckAttempts),
+		validateNodeBalancerNodeBackends(opts.Nodes),
+	}
+
+	for i, node := range opts.Nodes {
+		errs = append(errs, validateNodeBalancerNode(i, node))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate checks the health check settings and nodes of the options; see NodeBalancerConfigCreateOptions.Validate.
+func (opts NodeBalancerConfigRebuildOptions) Validate() error {
+	errs := []error{
+		validateNodeBalancerCheckTarget(opts.Check, opts.CheckPath, opts.CheckBody),
+		validateNodeBalancerCheckTuning(opts.CheckInterval, opts.CheckTimeout, opts.CheckAttempts),
+	}
+
+	nodes := make([]NodeBalancerNodeCreateOptions, len(opts.Nodes))
+
+	for i, node := range opts.Nodes {
+		nodes[i] = node.NodeBalancerNodeCreateOptions
+		errs = append(errs, validateNodeBalancerNode(i, nodes[i]))
+	}
+
+	return errors.Join(append(errs, validateNodeBalancerNodeBackends(nodes))...)
+}
+
+// Validate checks that the health check interval, timeout and attempts are within the API's bounds.
+// Unlike when creating a config, a CheckPath or CheckBody is not required as it may already be set.
+func (opts NodeBalancerConfigUpdateOptions) Validate() error {
+	return validateNodeBalancerCheckTuning(opts.CheckInterval, opts.CheckTimeout, opts.CheckAttempts)
+}
+
+func validateNodeBalancerCheckTarget(check ConfigCheck, path, body string) error {
+	if (check == CheckHTTP || check == CheckHTTPBody) && path == "" {
+		return fmt.Errorf("check_path is required for %s checks", check)
+	}
+
+	if check == CheckHTTPBody && body == "" {
+		return fmt.Errorf("check_body is required for %s checks", check)
+	}
+
+	return nil
+}
+
+// validateNodeBalancerNode validates the address and mode of the node at index i of a config's nodes.
+func validateNodeBalancerNode(i int, node NodeBalancerNodeCreateOptions) error {
+	errs := make([]error, 0)
+
+	host, port, err := net.SplitHostPort(node.Address)
+	if err == nil && host == "" {
+		err = errors.New("missing host")
+	}
+
+	if err == nil {
+		if p, perr := strconv.Atoi(port); perr != nil || p < 1 || p > 65535 {
+			err = fmt.Errorf("invalid port %q", port)
+		}
+	}
+
+	if err != nil {
+		errs = append(errs, fmt.Errorf("nodes[%d].address must be in the form host:port, got %q: %w", i, node.Address, err))
+	}
+
+	if node.Mode != "" && !node.Mode.IsValid() {
+		errs = append(errs, fmt.Errorf("nodes[%d].mode must be one of accept, reject, drain or backup, got %q", i, node.Mode))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateNodeBalancerNodeBackends checks that a config's nodes do not mix VPC and public backends
+func validateNodeBalancerNodeBackends(nodes []NodeBalancerNodeCreateOptions) error {
+	for i, node := range nodes {
+		if (node.SubnetID != 0) != (nodes[0].SubnetID != 0) {
+			return fmt.Errorf("nodes[0] and nodes[%d] mix VPC and public backends: "+
+				"either all or none of a config's nodes must have a subnet_id", i)
+		}
+	}
+
+	return nil
+}
+
+// validateNodeBalancerCheckTuning validates the given settings, where 0 indicates a setting is not being set.
+func validateNodeBalancerCheckTuning(interval, timeout, attempts int) error {
+	errs := make([]error, 0)
+
+	inRange := func(name string, value, lower, upper int) {
+		if value != 0 && (value < lower || value > upper) {
+			errs = append(errs, fmt.Errorf("%s must be between %d and %d, got %d", name, lower, upper, value))
+		}
+	}
+
+	inRange("check_interval", interval, nodeBalancerCheckIntervalMin, nodeBalancerCheckIntervalMax)
+	inRange("check_timeout", timeout, nodeBalancerCheckTimeoutMin, nodeBalancerCheckTimeoutMax)
+	inRange("check_attempts", attempts, nodeBalancerCheckAttemptsMin, nodeBalancerCheckAttemptsMax)
+
+	if interval != 0 && timeout != 0 && timeout >= interval {
+		errs = append(errs, fmt.Errorf("check_timeout (%d) must be less than check_interval (%d)", timeout, interval))
+	}
+
+	return errors.Join(errs...)
+}
+
 // GetCreateOptions converts a NodeBalancerConfig to NodeBalancerConfigCreateOptions for use in CreateNodeBalancerConfig
 func (i NodeBalancerConfig) GetCreateOptions() NodeBalancerConfigCreateOptions {
 	return NodeBalancerConfigCreateOptions{
@@ -232,6 +359,10 @@ func (c *Client) GetNodeBalancerConfig(ctx context.Context, nodebalancerID int,
 
 // CreateNodeBalancerConfig creates a NodeBalancerConfig
 func (c *Client) CreateNodeBalancerConfig(ctx context.Context, nodebalancerID int, opts NodeBalancerConfigCreateOptions) (*NodeBalancerConfig, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("nodebalancers/%d/configs", nodebalancerID)
 	response, err := doPOSTRequest[NodeBalancerConfig](ctx, c, e, opts)
 	if err != nil {
@@ -243,6 +374,10 @@ func (c *Client) CreateNodeBalancerConfig(ctx context.Context, nodebalancerID in
 
 // UpdateNodeBalancerConfig updates the NodeBalancerConfig with the specified id
 func (c *Client) UpdateNodeBalancerConfig(ctx context.Context, nodebalancerID int, configID int, opts NodeBalancerConfigUpdateOptions) (*NodeBalancerConfig, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("nodebalancers/%d/configs/%d", nodebalancerID, configID)
 	response, err := doPUTRequest[NodeBalancerConfig](ctx, c, e, opts)
 	if err != nil {
@@ -259,8 +394,14 @@ func (c *Client) DeleteNodeBalancerConfig(ctx context.Context, nodebalancerID in
 	return err
 }
 
-// RebuildNodeBalancerConfig updates the NodeBalancer with the specified id
+// RebuildNodeBalancerConfig updates the NodeBalancer config with the specified id, replacing its
+// nodes with opts.Nodes in a single request. Nodes with an ID are updated, nodes without one are
+// created and existing nodes that are not included are deleted.
 func (c *Client) RebuildNodeBalancerConfig(ctx context.Context, nodeBalancerID int, configID int, opts NodeBalancerConfigRebuildOptions) (*NodeBalancerConfig, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("nodebalancers/%d/configs/%d/rebuild", nodeBalancerID, configID)
 	response, err := doPOSTRequest[NodeBalancerConfig](ctx, c, e, opts)
 	if err != nil {
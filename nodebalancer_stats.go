@@ -10,7 +10,8 @@ type NodeBalancerStats struct {
 	Data  NodeBalancerStatsData `json:"data"`
 }
 
-// NodeBalancerStatsData represents a nodebalancer stats data object
+// NodeBalancerStatsData represents a nodebalancer stats data object,
+// with the connections and the traffic in and out of the NodeBalancer
 type NodeBalancerStatsData struct {
 	Connections [][]float64  `json:"connections"`
 	Traffic     StatsTraffic `json:"traffic"`
@@ -22,7 +23,8 @@ type StatsTraffic struct {
 	Out [][]float64 `json:"out"`
 }
 
-// GetNodeBalancerStats gets the template with the provided ID
+// GetNodeBalancerStats gets the connections and traffic stats of the NodeBalancer with the provided ID.
+// Each series is a list of [timestamp, value] pairs, as in InstanceStats.
 func (c *Client) GetNodeBalancerStats(ctx context.Context, nodebalancerID int) (*NodeBalancerStats, error) {
 	e := formatAPIPath("nodebalancers/%d/stats", nodebalancerID)
 	response, err := doGETRequest[NodeBalancerStats](ctx, c, e)
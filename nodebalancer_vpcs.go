@@ -0,0 +1,106 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+)
+
+// NodeBalancerVPCConfig describes a VPC subnet a NodeBalancer is attached to.
+// NOTE: VPC NodeBalancers may not currently be available to all users.
+type NodeBalancerVPCConfig struct {
+	ID             int    `json:"id"`
+	NodeBalancerID int    `json:"nodebalancer_id"`
+	VPCID          int    `json:"vpc_id"`
+	SubnetID       int    `json:"subnet_id"`
+	IPv4Range      string `json:"ipv4_range"`
+	IPv6Range      string `json:"ipv6_range"`
+}
+
+// ListNodeBalancerVPCConfigs lists the VPC subnets the NodeBalancer is attached to.
+// NOTE: VPC NodeBalancers may not currently be available to all users.
+func (c *Client) ListNodeBalancerVPCConfigs(ctx context.Context, nodebalancerID int, opts *ListOptions) ([]NodeBalancerVPCConfig, error) {
+	return getPaginatedResults[NodeBalancerVPCConfig](ctx, c, formatAPIPath("nodebalancers/%d/vpcs", nodebalancerID), opts)
+}
+
+// GetNodeBalancerVPCConfig gets the NodeBalancer VPC configuration with the provided ID.
+// NOTE: VPC NodeBalancers may not currently be available to all users.
+func (c *Client) GetNodeBalancerVPCConfig(ctx context.Context, nodebalancerID int, vpcConfigID int) (*NodeBalancerVPCConfig, error) {
+	e := formatAPIPath("nodebalancers/%d/vpcs/%d", nodebalancerID, vpcConfigID)
+	return doGETRequest[NodeBalancerVPCConfig](ctx, c, e)
+}
+
+// subnetIDs returns the VPC subnets referenced by the NodeBalancer and its backend nodes
+func (opts NodeBalancerCreateOptions) subnetIDs() []int {
+	var result []int
+
+	for _, vpc := range opts.VPCs {
+		result = append(result, vpc.SubnetID)
+	}
+
+	for _, config := range opts.Configs {
+		if config == nil {
+			continue
+		}
+
+		for _, node := range config.Nodes {
+			if node.SubnetID != 0 {
+				result = append(result, node.SubnetID)
+			}
+		}
+	}
+
+	return result
+}
+
+// validateBackends checks that VPC backend nodes are only used when the NodeBalancer is attached
+// to a VPC, and that the nodes of each config do not mix VPC and public backends
+func (opts NodeBalancerCreateOptions) validateBackends() error {
+	for i, config := range opts.Configs {
+		if config == nil {
+			continue
+		}
+
+		if err := validateNodeBalancerNodeBackends(config.Nodes); err != nil {
+			return fmt.Errorf("configs[%d]: %w", i, err)
+		}
+
+		if len(opts.VPCs) == 0 && len(config.Nodes) > 0 && config.Nodes[0].SubnetID != 0 {
+			return fmt.Errorf("configs[%d] has VPC backend nodes, but the NodeBalancer is not attached to a VPC", i)
+		}
+	}
+
+	return nil
+}
+
+// checkNodeBalancerSubnetRegions checks that each subnet belongs to a VPC in the given region
+func (c *Client) checkNodeBalancerSubnetRegions(ctx context.Context, region string, subnetIDs []int) error {
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+
+	vpcs, err := c.ListVPCs(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	subnetRegions := make(map[int]string)
+
+	for _, vpc := range vpcs {
+		for _, subnet := range vpc.Subnets {
+			subnetRegions[subnet.ID] = vpc.Region
+		}
+	}
+
+	for _, subnetID := range subnetIDs {
+		subnetRegion, ok := subnetRegions[subnetID]
+		if !ok {
+			return fmt.Errorf("VPC subnet %d does not exist", subnetID)
+		}
+
+		if subnetRegion != region {
+			return fmt.Errorf("VPC subnet %d is in region %s, but the NodeBalancer is in region %s", subnetID, subnetRegion, region)
+		}
+	}
+
+	return nil
+}
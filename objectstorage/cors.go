@@ -0,0 +1,54 @@
+package objectstorage
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+)
+
+// CORSRule is a single bucket CORS rule. The Linode API only exposes whether CORS is
+// enabled for a bucket, so rules are managed using the S3 cors subresource.
+type CORSRule struct {
+	ID             string   `xml:"ID,omitempty"`
+	AllowedOrigins []string `xml:"AllowedOrigin"`
+	AllowedMethods []string `xml:"AllowedMethod"`
+	AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+	ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+
+	// MaxAgeSeconds is how long browsers may cache the response to a preflight request
+	MaxAgeSeconds int `xml:"MaxAgeSeconds,omitempty"`
+}
+
+// corsConfiguration is the XML document used by the cors subresource
+type corsConfiguration struct {
+	XMLName xml.Name   `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CORSConfiguration"`
+	Rules   []CORSRule `xml:"CORSRule"`
+}
+
+// GetBucketCORS returns the CORS rules of a bucket.
+// A bucket without a CORS configuration returns no rules and no error.
+func (c *Client) GetBucketCORS(ctx context.Context, bucket string) ([]CORSRule, error) {
+	var result corsConfiguration
+
+	err := c.doRequest(ctx, http.MethodGet, c.bucketURL(bucket, "cors"), nil, &result)
+	if err != nil {
+		if IsErrorCode(err, "NoSuchCORSConfiguration") {
+			return []CORSRule{}, nil
+		}
+
+		return nil, err
+	}
+
+	return result.Rules, nil
+}
+
+// PutBucketCORS replaces the CORS rules of a bucket
+func (c *Client) PutBucketCORS(ctx context.Context, bucket string, rules []CORSRule) error {
+	body := corsConfiguration{Rules: rules}
+	return c.doRequest(ctx, http.MethodPut, c.bucketURL(bucket, "cors"), body, nil)
+}
+
+// DeleteBucketCORS removes all CORS rules from a bucket
+func (c *Client) DeleteBucketCORS(ctx context.Context, bucket string) error {
+	return c.doRequest(ctx, http.MethodDelete, c.bucketURL(bucket, "cors"), nil, nil)
+}
@@ -0,0 +1,71 @@
+package objectstorage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCORS_PutGet(t *testing.T) {
+	rules := []CORSRule{
+		{
+			ID:             "allow-all",
+			AllowedOrigins: []string{"*"},
+			AllowedMethods: []string{"GET", "HEAD", "PUT", "POST", "DELETE"},
+			AllowedHeaders: []string{"*"},
+			ExposeHeaders:  []string{"ETag"},
+			MaxAgeSeconds:  3600,
+		},
+	}
+
+	var stored []byte
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/my-bucket" || r.URL.RawQuery != "cors" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			stored, _ = io.ReadAll(r.Body)
+		case http.MethodGet:
+			_, _ = w.Write(stored)
+		}
+	})
+
+	if err := client.PutBucketCORS(context.Background(), "my-bucket", rules); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(stored), "<AllowedOrigin>*</AllowedOrigin>") {
+		t.Fatalf("unexpected body: %s", stored)
+	}
+
+	result, err := client.GetBucketCORS(context.Background(), "my-bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(rules, result) {
+		t.Fatalf("expected %#v, got %#v", rules, result)
+	}
+}
+
+func TestCORS_GetMissing(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<Error><Code>NoSuchCORSConfiguration</Code></Error>`))
+	})
+
+	rules, err := client.GetBucketCORS(context.Background(), "my-bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 0 {
+		t.Fatalf("expected no rules, got %d", len(rules))
+	}
+}
@@ -0,0 +1,40 @@
+package objectstorage
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Error is an error response returned by the S3 API
+type Error struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+	RequestID  string `xml:"RequestId"`
+}
+
+func (e Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("[%03d] %s", e.StatusCode, e.Code)
+	}
+
+	return fmt.Sprintf("[%03d] %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+func newError(statusCode int, body []byte) error {
+	e := Error{StatusCode: statusCode}
+
+	if err := xml.Unmarshal(body, &e); err != nil || e.Code == "" {
+		e.Code = http.StatusText(statusCode)
+	}
+
+	return e
+}
+
+// IsErrorCode indicates if err is an S3 error with the given code, e.g. "NoSuchBucket".
+func IsErrorCode(err error, code string) bool {
+	var e Error
+	return errors.As(err, &e) && e.Code == code
+}
@@ -0,0 +1,84 @@
+package objectstorage
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+)
+
+// LifecycleRuleStatus indicates whether a LifecycleRule is applied
+type LifecycleRuleStatus string
+
+const (
+	LifecycleRuleEnabled  LifecycleRuleStatus = "Enabled"
+	LifecycleRuleDisabled LifecycleRuleStatus = "Disabled"
+)
+
+// LifecycleRule is a single bucket lifecycle rule
+type LifecycleRule struct {
+	ID     string              `xml:"ID,omitempty"`
+	Status LifecycleRuleStatus `xml:"Status"`
+
+	// Filter restricts the rule to objects with the given key prefix.
+	// An empty prefix applies the rule to the whole bucket.
+	Filter LifecycleFilter `xml:"Filter"`
+
+	Expiration                     *LifecycleExpiration                     `xml:"Expiration,omitempty"`
+	NoncurrentVersionExpiration    *LifecycleNoncurrentVersionExpiration    `xml:"NoncurrentVersionExpiration,omitempty"`
+	AbortIncompleteMultipartUpload *LifecycleAbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload,omitempty"`
+}
+
+// LifecycleFilter selects the objects a LifecycleRule applies to
+type LifecycleFilter struct {
+	Prefix string `xml:"Prefix"`
+}
+
+// LifecycleExpiration expires objects after a number of days or on a date (ISO 8601)
+type LifecycleExpiration struct {
+	Days int    `xml:"Days,omitempty"`
+	Date string `xml:"Date,omitempty"`
+}
+
+// LifecycleNoncurrentVersionExpiration expires noncurrent object versions
+type LifecycleNoncurrentVersionExpiration struct {
+	NoncurrentDays int `xml:"NoncurrentDays"`
+}
+
+// LifecycleAbortIncompleteMultipartUpload aborts multipart uploads that are not completed in time
+type LifecycleAbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+}
+
+// lifecycleConfiguration is the XML document used by the lifecycle subresource
+type lifecycleConfiguration struct {
+	XMLName xml.Name        `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LifecycleConfiguration"`
+	Rules   []LifecycleRule `xml:"Rule"`
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket.
+// A bucket without a lifecycle configuration returns no rules and no error.
+func (c *Client) GetBucketLifecycle(ctx context.Context, bucket string) ([]LifecycleRule, error) {
+	var result lifecycleConfiguration
+
+	err := c.doRequest(ctx, http.MethodGet, c.bucketURL(bucket, "lifecycle"), nil, &result)
+	if err != nil {
+		if IsErrorCode(err, "NoSuchLifecycleConfiguration") {
+			return []LifecycleRule{}, nil
+		}
+
+		return nil, err
+	}
+
+	return result.Rules, nil
+}
+
+// PutBucketLifecycle replaces the lifecycle rules of a bucket
+func (c *Client) PutBucketLifecycle(ctx context.Context, bucket string, rules []LifecycleRule) error {
+	body := lifecycleConfiguration{Rules: rules}
+	return c.doRequest(ctx, http.MethodPut, c.bucketURL(bucket, "lifecycle"), body, nil)
+}
+
+// DeleteBucketLifecycle removes all lifecycle rules from a bucket
+func (c *Client) DeleteBucketLifecycle(ctx context.Context, bucket string) error {
+	return c.doRequest(ctx, http.MethodDelete, c.bucketURL(bucket, "lifecycle"), nil, nil)
+}
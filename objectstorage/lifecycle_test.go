@@ -0,0 +1,134 @@
+package objectstorage
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/linode/linodego"
+)
+
+func mustParseURL(t *testing.T, raw string) *url.URL {
+	t.Helper()
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return u
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(linodego.ObjectStorageKey{AccessKey: "access", SecretKey: "secret"}, server.URL, server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client.SetSigningRegion("us-east-1")
+}
+
+func TestLifecycle_PutGet(t *testing.T) {
+	rules := []LifecycleRule{
+		{
+			ID:     "expire-logs",
+			Status: LifecycleRuleEnabled,
+			Filter: LifecycleFilter{Prefix: "logs/"},
+			Expiration: &LifecycleExpiration{
+				Days: 30,
+			},
+		},
+		{
+			ID:     "abort-multipart",
+			Status: LifecycleRuleEnabled,
+			AbortIncompleteMultipartUpload: &LifecycleAbortIncompleteMultipartUpload{
+				DaysAfterInitiation: 7,
+			},
+		},
+	}
+
+	var stored []byte
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/my-bucket" || r.URL.RawQuery != "lifecycle" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+			t.Errorf("request was not signed: %q", r.Header.Get("Authorization"))
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			if r.Header.Get("Content-MD5") == "" {
+				t.Error("expected Content-MD5 header")
+			}
+
+			stored, _ = io.ReadAll(r.Body)
+		case http.MethodGet:
+			_, _ = w.Write(stored)
+		}
+	})
+
+	if err := client.PutBucketLifecycle(context.Background(), "my-bucket", rules); err != nil {
+		t.Fatal(err)
+	}
+
+	var sent lifecycleConfiguration
+	if err := xml.Unmarshal(stored, &sent); err != nil {
+		t.Fatal(err)
+	}
+
+	if sent.XMLName.Space != "http://s3.amazonaws.com/doc/2006-03-01/" {
+		t.Fatalf("unexpected namespace: %s", sent.XMLName.Space)
+	}
+
+	result, err := client.GetBucketLifecycle(context.Background(), "my-bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(rules, result) {
+		t.Fatalf("expected %#v, got %#v", rules, result)
+	}
+}
+
+func TestLifecycle_GetMissing(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Error><Code>NoSuchLifecycleConfiguration</Code><Message>The lifecycle configuration does not exist</Message></Error>`))
+	})
+
+	rules, err := client.GetBucketLifecycle(context.Background(), "my-bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 0 {
+		t.Fatalf("expected no rules, got %d", len(rules))
+	}
+}
+
+func TestLifecycle_Error(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<Error><Code>NoSuchBucket</Code></Error>`))
+	})
+
+	err := client.DeleteBucketLifecycle(context.Background(), "missing")
+	if !IsErrorCode(err, "NoSuchBucket") {
+		t.Fatalf("expected NoSuchBucket error, got %v", err)
+	}
+}
@@ -0,0 +1,181 @@
+package objectstorage
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// defaultPOSTPolicyExpiry is used when POSTPolicyOptions.Expires is not set
+const defaultPOSTPolicyExpiry = time.Hour
+
+// POSTPolicyOptions constrains the uploads allowed by a POST policy
+type POSTPolicyOptions struct {
+	// Key is the exact object key uploads must use. It may contain the
+	// "${filename}" variable, which is replaced with the name of the uploaded file.
+	Key string
+
+	// KeyPrefix allows any key starting with the prefix; it is ignored if Key is set.
+	// The returned key field is the prefix followed by "${filename}".
+	KeyPrefix string
+
+	// Expires is how long the policy is valid for; defaults to one hour
+	Expires time.Duration
+
+	// MinContentLength and MaxContentLength limit the size in bytes of uploaded objects.
+	// No limit is applied if MaxContentLength is 0.
+	MinContentLength int64
+	MaxContentLength int64
+
+	// ContentType is the exact Content-Type uploads must use
+	ContentType string
+
+	// ContentTypePrefix allows any Content-Type starting with the prefix (e.g. "image/");
+	// it is ignored if ContentType is set
+	ContentTypePrefix string
+
+	// ACL is the canned ACL applied to uploaded objects (e.g. "public-read")
+	ACL string
+
+	// SuccessActionStatus is the status code returned for successful uploads:
+	// 200, 201 or 204 (the default)
+	SuccessActionStatus int
+}
+
+// POSTPolicy holds what a browser needs to upload an object with a
+// multipart/form-data POST request
+type POSTPolicy struct {
+	// URL is the form action
+	URL string
+
+	// Fields must be sent as form fields before the file field
+	Fields map[string]string
+
+	// Expiration is when the policy stops being accepted
+	Expiration time.Time
+}
+
+// postPolicyDocument is the JSON policy document signed for POST uploads
+type postPolicyDocument struct {
+	Expiration string `json:"expiration"`
+	Conditions []any  `json:"conditions"`
+}
+
+// CreatePOSTPolicy returns a signed policy allowing browsers to upload objects
+// to a bucket using an HTML form, without sending them the secret key.
+// No request is made to Object Storage.
+func (c *Client) CreatePOSTPolicy(bucket string, opts POSTPolicyOptions) (*POSTPolicy, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	expires := opts.Expires
+	if expires == 0 {
+		expires = defaultPOSTPolicyExpiry
+	}
+
+	now := c.now().UTC()
+	expiration := now.Add(expires)
+	credential := c.signer.accessKey + "/" + c.signer.scope(now)
+	amzDate := now.Format(amzDateFormat)
+
+	fields := map[string]string{
+		"bucket":           bucket,
+		"x-amz-algorithm":  signingAlgorithm,
+		"x-amz-credential": credential,
+		"x-amz-date":       amzDate,
+	}
+
+	conditions := []any{
+		map[string]string{"bucket": bucket},
+	}
+
+	if opts.Key != "" {
+		fields["key"] = opts.Key
+		conditions = append(conditions, []string{"eq", "$key", opts.Key})
+	} else {
+		fields["key"] = opts.KeyPrefix + "${filename}"
+		conditions = append(conditions, []string{"starts-with", "$key", opts.KeyPrefix})
+	}
+
+	if opts.MaxContentLength > 0 {
+		conditions = append(conditions, []any{"content-length-range", opts.MinContentLength, opts.MaxContentLength})
+	}
+
+	switch {
+	case opts.ContentType != "":
+		fields["Content-Type"] = opts.ContentType
+		conditions = append(conditions, []string{"eq", "$Content-Type", opts.ContentType})
+	case opts.ContentTypePrefix != "":
+		conditions = append(conditions, []string{"starts-with", "$Content-Type", opts.ContentTypePrefix})
+	}
+
+	if opts.ACL != "" {
+		fields["acl"] = opts.ACL
+		conditions = append(conditions, map[string]string{"acl": opts.ACL})
+	}
+
+	if opts.SuccessActionStatus != 0 {
+		status := strconv.Itoa(opts.SuccessActionStatus)
+		fields["success_action_status"] = status
+		conditions = append(conditions, map[string]string{"success_action_status": status})
+	}
+
+	conditions = append(conditions,
+		map[string]string{"x-amz-algorithm": signingAlgorithm},
+		map[string]string{"x-amz-credential": credential},
+		map[string]string{"x-amz-date": amzDate},
+	)
+
+	document, err := json.Marshal(postPolicyDocument{
+		Expiration: expiration.Format("2006-01-02T15:04:05.000Z"),
+		Conditions: conditions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode policy: %w", err)
+	}
+
+	policy := base64.StdEncoding.EncodeToString(document)
+
+	fields["policy"] = policy
+	fields["x-amz-signature"] = hex.EncodeToString(hmacSHA256(c.signer.signingKey(now), []byte(policy)))
+
+	u := *c.endpoint
+	u.Path = "/" + bucket
+	u.RawQuery = ""
+
+	return &POSTPolicy{
+		URL:        u.String(),
+		Fields:     fields,
+		Expiration: expiration,
+	}, nil
+}
+
+func (opts POSTPolicyOptions) validate() error {
+	if opts.Expires < 0 {
+		return fmt.Errorf("expires must not be negative, got %s", opts.Expires)
+	}
+
+	if opts.MinContentLength < 0 || opts.MaxContentLength < 0 {
+		return fmt.Errorf("content length limits must not be negative")
+	}
+
+	if opts.MaxContentLength > 0 && opts.MinContentLength > opts.MaxContentLength {
+		return fmt.Errorf("min content length %d exceeds max content length %d", opts.MinContentLength, opts.MaxContentLength)
+	}
+
+	if opts.MaxContentLength == 0 && opts.MinContentLength > 0 {
+		return fmt.Errorf("max content length is required when min content length is set")
+	}
+
+	switch opts.SuccessActionStatus {
+	case 0, 200, 201, 204:
+	default:
+		return fmt.Errorf("success action status must be 200, 201 or 204, got %d", opts.SuccessActionStatus)
+	}
+
+	return nil
+}
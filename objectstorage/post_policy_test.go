@@ -0,0 +1,156 @@
+package objectstorage
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+)
+
+func TestCreatePOSTPolicy(t *testing.T) {
+	client, err := NewClient(linodego.ObjectStorageKey{AccessKey: "access", SecretKey: "secret"}, "us-east-1.linodeobjects.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	client.now = func() time.Time { return now }
+
+	result, err := client.CreatePOSTPolicy("my-bucket", POSTPolicyOptions{
+		KeyPrefix:         "uploads/",
+		Expires:           15 * time.Minute,
+		MinContentLength:  1,
+		MaxContentLength:  10 << 20,
+		ContentTypePrefix: "image/",
+		ACL:               "private",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.URL != "https://us-east-1.linodeobjects.com/my-bucket" {
+		t.Errorf("unexpected url: %s", result.URL)
+	}
+
+	if !result.Expiration.Equal(now.Add(15 * time.Minute)) {
+		t.Errorf("unexpected expiration: %s", result.Expiration)
+	}
+
+	expectedFields := map[string]string{
+		"bucket":           "my-bucket",
+		"key":              "uploads/${filename}",
+		"acl":              "private",
+		"x-amz-algorithm":  "AWS4-HMAC-SHA256",
+		"x-amz-credential": "access/20240301/us-east-1/s3/aws4_request",
+		"x-amz-date":       "20240301T120000Z",
+	}
+
+	for k, v := range expectedFields {
+		if result.Fields[k] != v {
+			t.Errorf("expected field %s to be %q, got %q", k, v, result.Fields[k])
+		}
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(result.Fields["policy"])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var document struct {
+		Expiration string `json:"expiration"`
+		Conditions []any  `json:"conditions"`
+	}
+
+	if err := json.Unmarshal(raw, &document); err != nil {
+		t.Fatal(err)
+	}
+
+	if document.Expiration != "2024-03-01T12:15:00.000Z" {
+		t.Errorf("unexpected policy expiration: %s", document.Expiration)
+	}
+
+	expectedConditions := []any{
+		map[string]any{"bucket": "my-bucket"},
+		[]any{"starts-with", "$key", "uploads/"},
+		[]any{"content-length-range", float64(1), float64(10 << 20)},
+		[]any{"starts-with", "$Content-Type", "image/"},
+		map[string]any{"acl": "private"},
+		map[string]any{"x-amz-algorithm": "AWS4-HMAC-SHA256"},
+		map[string]any{"x-amz-credential": "access/20240301/us-east-1/s3/aws4_request"},
+		map[string]any{"x-amz-date": "20240301T120000Z"},
+	}
+
+	if !reflect.DeepEqual(expectedConditions, document.Conditions) {
+		t.Errorf("expected conditions %#v, got %#v", expectedConditions, document.Conditions)
+	}
+
+	// The signature is computed over the base64 encoded policy with the derived signing key
+	key := hmacSHA256([]byte("AWS4secret"), []byte("20240301"))
+	key = hmacSHA256(key, []byte("us-east-1"))
+	key = hmacSHA256(key, []byte("s3"))
+	key = hmacSHA256(key, []byte("aws4_request"))
+
+	signature := hex.EncodeToString(hmacSHA256(key, []byte(result.Fields["policy"])))
+	if result.Fields["x-amz-signature"] != signature {
+		t.Errorf("expected signature %s, got %s", signature, result.Fields["x-amz-signature"])
+	}
+}
+
+func TestCreatePOSTPolicy_ExactKey(t *testing.T) {
+	client := newTestClient(t, nil)
+
+	result, err := client.CreatePOSTPolicy("my-bucket", POSTPolicyOptions{
+		Key:                 "avatar.png",
+		ContentType:         "image/png",
+		SuccessActionStatus: 201,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Fields["key"] != "avatar.png" || result.Fields["Content-Type"] != "image/png" ||
+		result.Fields["success_action_status"] != "201" {
+		t.Errorf("unexpected fields: %v", result.Fields)
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(result.Fields["policy"])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var document postPolicyDocument
+	if err := json.Unmarshal(raw, &document); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range document.Conditions {
+		if list, ok := c.([]any); ok && list[0] == "content-length-range" {
+			t.Errorf("unexpected content-length-range condition without a max length: %v", list)
+		}
+	}
+
+	expected := []any{"eq", "$key", "avatar.png"}
+	if !reflect.DeepEqual(document.Conditions[1], expected) {
+		t.Errorf("expected key condition %v, got %v", expected, document.Conditions[1])
+	}
+}
+
+func TestCreatePOSTPolicy_Validation(t *testing.T) {
+	client := newTestClient(t, nil)
+
+	for _, opts := range []POSTPolicyOptions{
+		{Expires: -time.Minute},
+		{MinContentLength: 10, MaxContentLength: 5},
+		{MinContentLength: 10},
+		{MaxContentLength: -1},
+		{SuccessActionStatus: 302},
+	} {
+		if _, err := client.CreatePOSTPolicy("my-bucket", opts); err == nil {
+			t.Errorf("expected an error for %+v", opts)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package objectstorage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	signingService   = "s3"
+	amzDateFormat    = "20060102T150405Z"
+	amzShortFormat   = "20060102"
+)
+
+// signer implements the subset of AWS Signature Version 4 needed for
+// path-style S3 requests with a fully buffered payload.
+type signer struct {
+	accessKey string
+	secretKey string
+	region    string
+}
+
+// sign adds the SigV4 Authorization header and its supporting headers to req
+func (s signer) sign(req *http.Request, payload []byte, t time.Time) {
+	t = t.UTC()
+	amzDate := t.Format(amzDateFormat)
+	payloadHash := hashHex(payload)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders, canonicalHeaders := canonicalHeaders(req)
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI(req.URL),
+		canonicalQuery(req.URL),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := s.scope(t)
+
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		amzDate,
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signature := hex.EncodeToString(hmacSHA256(s.signingKey(t), []byte(stringToSign)))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, s.accessKey, scope, signedHeaders, signature,
+	))
+}
+
+// scope returns the credential scope for the given time
+func (s signer) scope(t time.Time) string {
+	return strings.Join([]string{t.UTC().Format(amzShortFormat), s.region, signingService, "aws4_request"}, "/")
+}
+
+// signingKey derives the SigV4 signing key for the given time
+func (s signer) signingKey(t time.Time) []byte {
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), []byte(t.UTC().Format(amzShortFormat)))
+	key = hmacSHA256(key, []byte(s.region))
+	key = hmacSHA256(key, []byte(signingService))
+
+	return hmacSHA256(key, []byte("aws4_request"))
+}
+
+// canonicalHeaders returns the signed header list and canonical header block for req.
+// The host header, x-amz-* headers and content headers are signed.
+func canonicalHeaders(req *http.Request) (string, string) {
+	headers := map[string]string{
+		"host": req.URL.Host,
+	}
+
+	for name, values := range req.Header {
+		lower := strings.ToLower(name)
+		if !strings.HasPrefix(lower, "x-amz-") && lower != "content-md5" &&
+			lower != "content-type" && lower != "range" {
+			continue
+		}
+
+		trimmed := make([]string, len(values))
+		for i, v := range values {
+			trimmed[i] = strings.Join(strings.Fields(v), " ")
+		}
+
+		headers[lower] = strings.Join(trimmed, ",")
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name)
+		b.WriteByte(':')
+		b.WriteString(headers[name])
+		b.WriteByte('\n')
+	}
+
+	return strings.Join(names, ";"), b.String()
+}
+
+// canonicalURI returns the URI-encoded path of u, encoding each segment once
+func canonicalURI(u *url.URL) string {
+	if u.Path == "" {
+		return "/"
+	}
+
+	segments := strings.Split(u.Path, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// canonicalQuery returns the sorted, URI-encoded query string of u
+func canonicalQuery(u *url.URL) string {
+	query := u.Query()
+
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values := query[k]
+		sort.Strings(values)
+
+		for _, v := range values {
+			pairs = append(pairs, uriEncode(k)+"="+uriEncode(v))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode percent-encodes every byte except the RFC 3986 unreserved characters
+func uriEncode(s string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+
+	return b.String()
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+
+	return h.Sum(nil)
+}
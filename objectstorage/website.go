@@ -0,0 +1,70 @@
+package objectstorage
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+)
+
+// WebsiteConfiguration configures static website hosting for a bucket
+type WebsiteConfiguration struct {
+	XMLName       xml.Name              `xml:"http://s3.amazonaws.com/doc/2006-03-01/ WebsiteConfiguration"`
+	IndexDocument *WebsiteIndexDocument `xml:"IndexDocument,omitempty"`
+	ErrorDocument *WebsiteErrorDocument `xml:"ErrorDocument,omitempty"`
+}
+
+// WebsiteIndexDocument is the object returned for requests to a directory, e.g. "index.html"
+type WebsiteIndexDocument struct {
+	Suffix string `xml:"Suffix"`
+}
+
+// WebsiteErrorDocument is the object returned when a 4XX error occurs, e.g. "404.html"
+type WebsiteErrorDocument struct {
+	Key string `xml:"Key"`
+}
+
+// WebsiteEndpoint returns the URL a bucket is served from when website hosting is enabled
+func (c *Client) WebsiteEndpoint(bucket string) string {
+	return fmt.Sprintf("http://%s.website-%s", bucket, c.endpoint.Host)
+}
+
+// GetBucketWebsite returns the website configuration of a bucket
+func (c *Client) GetBucketWebsite(ctx context.Context, bucket string) (*WebsiteConfiguration, error) {
+	var result WebsiteConfiguration
+
+	if err := c.doRequest(ctx, http.MethodGet, c.bucketURL(bucket, "website"), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// PutBucketWebsite enables static website hosting for a bucket and returns its website endpoint.
+// NOTE: Objects must be publicly readable to be served, e.g. using a "public-read" bucket ACL.
+func (c *Client) PutBucketWebsite(ctx context.Context, bucket string, config WebsiteConfiguration) (string, error) {
+	if err := c.doRequest(ctx, http.MethodPut, c.bucketURL(bucket, "website"), config, nil); err != nil {
+		return "", err
+	}
+
+	return c.WebsiteEndpoint(bucket), nil
+}
+
+// SetBucketWebsite enables static website hosting for a bucket using the given index and
+// error documents and returns its website endpoint. errorDoc may be empty.
+func (c *Client) SetBucketWebsite(ctx context.Context, bucket, index, errorDoc string) (string, error) {
+	config := WebsiteConfiguration{
+		IndexDocument: &WebsiteIndexDocument{Suffix: index},
+	}
+
+	if errorDoc != "" {
+		config.ErrorDocument = &WebsiteErrorDocument{Key: errorDoc}
+	}
+
+	return c.PutBucketWebsite(ctx, bucket, config)
+}
+
+// DeleteBucketWebsite disables static website hosting for a bucket
+func (c *Client) DeleteBucketWebsite(ctx context.Context, bucket string) error {
+	return c.doRequest(ctx, http.MethodDelete, c.bucketURL(bucket, "website"), nil, nil)
+}
@@ -0,0 +1,58 @@
+package objectstorage
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/linode/linodego"
+)
+
+func TestWebsite_Set(t *testing.T) {
+	var sent WebsiteConfiguration
+
+	client := newTestClient(t, func(_ http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/my-site" || r.URL.RawQuery != "website" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		data, _ := io.ReadAll(r.Body)
+		if err := xml.Unmarshal(data, &sent); err != nil {
+			t.Error(err)
+		}
+	})
+
+	endpoint, err := client.SetBucketWebsite(context.Background(), "my-site", "index.html", "404.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sent.IndexDocument == nil || sent.IndexDocument.Suffix != "index.html" {
+		t.Fatalf("unexpected index document: %#v", sent.IndexDocument)
+	}
+
+	if sent.ErrorDocument == nil || sent.ErrorDocument.Key != "404.html" {
+		t.Fatalf("unexpected error document: %#v", sent.ErrorDocument)
+	}
+
+	if endpoint != client.WebsiteEndpoint("my-site") {
+		t.Fatalf("unexpected endpoint: %s", endpoint)
+	}
+}
+
+func TestWebsite_Endpoint(t *testing.T) {
+	client, err := NewClient(
+		linodego.ObjectStorageKey{AccessKey: "access", SecretKey: "secret"},
+		"us-east-1.linodeobjects.com",
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := client.WebsiteEndpoint("my-site"); got != "http://my-site.website-us-east-1.linodeobjects.com" {
+		t.Fatalf("unexpected endpoint: %s", got)
+	}
+}
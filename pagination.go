@@ -33,12 +33,45 @@ type ListOptions struct {
 	// calls. QueryParams should be an instance of a struct containing fields with
 	// the `query` tag.
 	QueryParams any
+
+	// Concurrency is the number of pages requested at once when listing all pages, once the
+	// first page has been requested to find the number of pages. Results are returned in page
+	// order, and values <= 1 request each page sequentially, which is the default.
+	Concurrency int `json:"-"`
+
+	// filterErr is set by NewListOptions if its filter could not be marshalled
+	filterErr error
+}
+
+// Filterable is a filter accepted by NewListOptions: either a raw JSON filter string or a *Filter
+type Filterable interface {
+	~string | *Filter
 }
 
 // NewListOptions simplified construction of ListOptions using only
-// the two writable properties, Page and Filter
-func NewListOptions(page int, filter string) *ListOptions {
-	return &ListOptions{PageOptions: &PageOptions{Page: page}, Filter: filter}
+// the two writable properties, Page and Filter. If a *Filter cannot be
+// marshalled, the error is returned by requests made using the ListOptions.
+func NewListOptions[F Filterable](page int, filter F) *ListOptions {
+	opts := &ListOptions{PageOptions: &PageOptions{Page: page}}
+
+	switch f := any(filter).(type) {
+	case *Filter:
+		if f == nil {
+			break
+		}
+
+		b, err := f.MarshalJSON()
+		if err != nil {
+			opts.filterErr = fmt.Errorf("failed to marshal filter: %w", err)
+			break
+		}
+
+		opts.Filter = string(b)
+	default:
+		opts.Filter = reflect.ValueOf(filter).String()
+	}
+
+	return opts
 }
 
 // Hash returns the sha256 hash of the provided ListOptions.
@@ -61,6 +94,10 @@ func applyListOptionsToRequest(opts *ListOptions, req *resty.Request) error {
 		return nil
 	}
 
+	if opts.filterErr != nil {
+		return opts.filterErr
+	}
+
 	if opts.QueryParams != nil {
 		params, err := flattenQueryStruct(opts.QueryParams)
 		if err != nil {
@@ -0,0 +1,111 @@
+package linodego
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// ErrPayloadTooLarge is matched (using errors.Is) by the *PayloadTooLargeError returned
+// when a request is rejected before being sent because it exceeds a known API limit.
+var ErrPayloadTooLarge = errors.New("payload too large")
+
+// PayloadTooLargeError describes which field of a request exceeded its limit.
+type PayloadTooLargeError struct {
+	Field string
+	Limit int
+	Size  int
+}
+
+func (e *PayloadTooLargeError) Error() string {
+	return fmt.Sprintf("%s: %s has size %d, exceeding the limit of %d", ErrPayloadTooLarge, e.Field, e.Size, e.Limit)
+}
+
+func (e *PayloadTooLargeError) Unwrap() error {
+	return ErrPayloadTooLarge
+}
+
+// PayloadLimits are the request size limits checked by the client before sending
+// requests to the API. A limit of 0 disables the corresponding check.
+type PayloadLimits struct {
+	// UserData is the maximum size in bytes of the Base64-encoded Metadata user data
+	UserData int
+
+	// StackScriptData is the maximum size in bytes of the JSON-encoded stackscript_data
+	StackScriptData int
+
+	// InstanceLabel is the maximum length in characters of an Instance label
+	InstanceLabel int
+
+	// StackScriptLabel is the maximum length in characters of a StackScript label
+	StackScriptLabel int
+
+	// StackScript is the maximum size in bytes of a StackScript's script
+	StackScript int
+
+	// Body is the maximum size in bytes of the JSON-encoded body of a POST or PUT request
+	Body int
+}
+
+// DefaultPayloadLimits are the API limits known at the time of release
+var DefaultPayloadLimits = PayloadLimits{
+	UserData:         16 * 1024,
+	StackScriptData:  32 * 1024,
+	InstanceLabel:    64,
+	StackScriptLabel: 128,
+	StackScript:      64 * 1024,
+	Body:             1024 * 1024,
+}
+
+// SetPayloadLimits overrides the request size limits checked by the client,
+// e.g. in case the API limits change. Defaults to DefaultPayloadLimits.
+func (c *Client) SetPayloadLimits(limits PayloadLimits) *Client {
+	c.payloadLimits = limits
+	return c
+}
+
+func checkPayloadLimit(field string, limit, size int) error {
+	if limit > 0 && size > limit {
+		return &PayloadTooLargeError{Field: field, Limit: limit, Size: size}
+	}
+
+	return nil
+}
+
+func (l PayloadLimits) checkInstanceLabel(label string) error {
+	return checkPayloadLimit("label", l.InstanceLabel, utf8.RuneCountInString(label))
+}
+
+func (l PayloadLimits) checkStackScriptLabel(label string) error {
+	return checkPayloadLimit("label", l.StackScriptLabel, utf8.RuneCountInString(label))
+}
+
+func (l PayloadLimits) checkStackScript(script string) error {
+	return checkPayloadLimit("script", l.StackScript, len(script))
+}
+
+func (l PayloadLimits) checkBody(body []byte) error {
+	return checkPayloadLimit("body", l.Body, len(body))
+}
+
+func (l PayloadLimits) checkMetadata(metadata *InstanceMetadataOptions) error {
+	if metadata == nil {
+		return nil
+	}
+
+	return checkPayloadLimit("metadata.user_data", l.UserData, len(metadata.UserData))
+}
+
+func (l PayloadLimits) checkStackScriptData(data map[string]string) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return checkPayloadLimit("stackscript_data", l.StackScriptData, len(b))
+}
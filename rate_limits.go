@@ -0,0 +1,106 @@
+package linodego
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	rateLimitLimitHeaderName     = "X-RateLimit-Limit"
+	rateLimitRemainingHeaderName = "X-RateLimit-Remaining"
+	rateLimitResetHeaderName     = "X-RateLimit-Reset"
+)
+
+// RateLimitInfo contains the rate limit details reported by the API in response to a request
+type RateLimitInfo struct {
+	// Limit is the number of requests allowed in the current rate limit window
+	Limit int
+
+	// Remaining is the number of requests remaining in the current rate limit window
+	Remaining int
+
+	// Reset is the time at which the current rate limit window resets
+	Reset time.Time
+}
+
+// LastRateLimit returns the rate limit details of the most recent response which included them.
+// Headers which are missing or invalid are left as their zero value, and the zero RateLimitInfo
+// is returned if no response has included any rate limit headers.
+func (c *Client) LastRateLimit() RateLimitInfo {
+	c.rateLimits.mu.RLock()
+	defer c.rateLimits.mu.RUnlock()
+
+	return c.rateLimits.last
+}
+
+// OnRateLimit adds a handler to run whenever a response includes rate limit headers,
+// e.g. to throttle requests as the remaining budget runs low
+func (c *Client) OnRateLimit(m func(info RateLimitInfo)) *Client {
+	c.rateLimits.mu.Lock()
+	defer c.rateLimits.mu.Unlock()
+
+	c.rateLimits.hooks = append(c.rateLimits.hooks, m)
+
+	return c
+}
+
+// rateLimits is shared by all copies of a Client, as its hooks are registered on the resty client
+type rateLimits struct {
+	mu    sync.RWMutex
+	last  RateLimitInfo
+	hooks []func(RateLimitInfo)
+}
+
+func newRateLimits(rc *resty.Client) *rateLimits {
+	l := &rateLimits{}
+
+	rc.OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+		if info, ok := parseRateLimitHeaders(r); ok {
+			l.record(info)
+		}
+
+		return nil
+	})
+
+	return l
+}
+
+func (l *rateLimits) record(info RateLimitInfo) {
+	l.mu.Lock()
+	l.last = info
+	hooks := l.hooks
+	l.mu.Unlock()
+
+	for _, hook := range hooks {
+		hook(info)
+	}
+}
+
+// parseRateLimitHeaders returns the rate limit details of a response, and whether any were valid
+func parseRateLimitHeaders(r *resty.Response) (info RateLimitInfo, ok bool) {
+	if r == nil {
+		return info, false
+	}
+
+	header := r.Header()
+
+	if limit, err := strconv.Atoi(header.Get(rateLimitLimitHeaderName)); err == nil {
+		info.Limit = limit
+		ok = true
+	}
+
+	if remaining, err := strconv.Atoi(header.Get(rateLimitRemainingHeaderName)); err == nil {
+		info.Remaining = remaining
+		ok = true
+	}
+
+	if reset, err := strconv.ParseInt(header.Get(rateLimitResetHeaderName), 10, 64); err == nil {
+		info.Reset = time.Unix(reset, 0)
+		ok = true
+	}
+
+	return info, ok
+}
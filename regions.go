@@ -2,41 +2,68 @@ package linodego
 
 import (
 	"context"
+	"strings"
 	"time"
 )
 
+// Capability is a feature Linode offers that can be referenced
+// through the user-facing parts of the application, e.g. in Region and Account capabilities.
+// Defined as an alias of string rather than a custom type to avoid breaking change.
+// Can be changed in the potential v2 version.
+type Capability = string
+
 // This is an enumeration of Capabilities Linode offers that can be referenced
 // through the user-facing parts of the application.
-// Defined as strings rather than a custom type to avoid breaking change.
-// Can be changed in the potential v2 version.
 const (
-	CapabilityLinodes                string = "Linodes"
-	CapabilityNodeBalancers          string = "NodeBalancers"
-	CapabilityBlockStorage           string = "Block Storage"
-	CapabilityObjectStorage          string = "Object Storage"
-	CapabilityObjectStorageRegions   string = "Object Storage Access Key Regions"
-	CapabilityLKE                    string = "Kubernetes"
-	CapabilityLkeHaControlPlanes     string = "LKE HA Control Planes"
-	CapabilityCloudFirewall          string = "Cloud Firewall"
-	CapabilityGPU                    string = "GPU Linodes"
-	CapabilityVlans                  string = "Vlans"
-	CapabilityVPCs                   string = "VPCs"
-	CapabilityVPCsExtra              string = "VPCs Extra"
-	CapabilityMachineImages          string = "Machine Images"
-	CapabilityBareMetal              string = "Bare Metal"
-	CapabilityDBAAS                  string = "Managed Databases"
-	CapabilityBlockStorageMigrations string = "Block Storage Migrations"
-	CapabilityMetadata               string = "Metadata"
-	CapabilityPremiumPlans           string = "Premium Plans"
-	CapabilityEdgePlans              string = "Edge Plans"
-	CapabilityLKEControlPlaneACL     string = "LKE Network Access Control List (IP ACL)"
-	CapabilityACLB                   string = "Akamai Cloud Load Balancer"
-	CapabilitySupportTicketSeverity  string = "Support Ticket Severity"
-	CapabilityBackups                string = "Backups"
-	CapabilityPlacementGroup         string = "Placement Group"
-	CapabilityDiskEncryption         string = "Disk Encryption"
+	CapabilityLinodes                Capability = "Linodes"
+	CapabilityNodeBalancers          Capability = "NodeBalancers"
+	CapabilityBlockStorage           Capability = "Block Storage"
+	CapabilityObjectStorage          Capability = "Object Storage"
+	CapabilityObjectStorageRegions   Capability = "Object Storage Access Key Regions"
+	CapabilityLKE                    Capability = "Kubernetes"
+	CapabilityLkeHaControlPlanes     Capability = "LKE HA Control Planes"
+	CapabilityCloudFirewall          Capability = "Cloud Firewall"
+	CapabilityGPU                    Capability = "GPU Linodes"
+	CapabilityVlans                  Capability = "Vlans"
+	CapabilityVPCs                   Capability = "VPCs"
+	CapabilityVPCsExtra              Capability = "VPCs Extra"
+	CapabilityMachineImages          Capability = "Machine Images"
+	CapabilityBareMetal              Capability = "Bare Metal"
+	CapabilityDBAAS                  Capability = "Managed Databases"
+	CapabilityBlockStorageMigrations Capability = "Block Storage Migrations"
+	CapabilityMetadata               Capability = "Metadata"
+	CapabilityPremiumPlans           Capability = "Premium Plans"
+	CapabilityEdgePlans              Capability = "Edge Plans"
+	CapabilityLKEControlPlaneACL     Capability = "LKE Network Access Control List (IP ACL)"
+	CapabilityACLB                   Capability = "Akamai Cloud Load Balancer"
+	CapabilitySupportTicketSeverity  Capability = "Support Ticket Severity"
+	CapabilityBackups                Capability = "Backups"
+	CapabilityPlacementGroup         Capability = "Placement Group"
+	CapabilityDiskEncryption         Capability = "Disk Encryption"
+	CapabilityBlockStorageEncryption Capability = "Block Storage Encryption"
 )
 
+// hasCapabilities returns whether all of the wanted capabilities are present in
+// the given capability list. Capabilities are compared case-insensitively.
+func hasCapabilities(capabilities []string, wanted []Capability) bool {
+	for _, w := range wanted {
+		found := false
+
+		for _, c := range capabilities {
+			if strings.EqualFold(c, w) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Region-related endpoints have a custom expiry time as the
 // `status` field may update for database outages.
 var cacheExpiryTime = time.Minute
@@ -46,7 +73,8 @@ type Region struct {
 	ID      string `json:"id"`
 	Country string `json:"country"`
 
-	// A List of enums from the above constants
+	// A List of enums from the above constants.
+	// Capabilities unknown to this package are preserved as-is.
 	Capabilities []string `json:"capabilities"`
 
 	Status   string `json:"status"`
@@ -57,6 +85,11 @@ type Region struct {
 	PlacementGroupLimits *RegionPlacementGroupLimits `json:"placement_group_limits"`
 }
 
+// HasCapabilities returns whether the Region supports all of the given capabilities
+func (r Region) HasCapabilities(capabilities ...Capability) bool {
+	return hasCapabilities(r.Capabilities, capabilities)
+}
+
 // RegionResolvers contains the DNS resolvers of a region
 type RegionResolvers struct {
 	IPv4 string `json:"ipv4"`
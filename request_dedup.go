@@ -0,0 +1,102 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// SetGETDeduplication configures whether concurrent identical GET requests made by the client
+// share a single round trip to the API. Requests are identical if they have the same URL,
+// query parameters and filter; each caller decodes its own copy of the shared response, so
+// results can be modified independently. Only GET requests are deduplicated, and requests are
+// not deduplicated while debugging is enabled so that each request is logged. Defaults to false.
+//
+// NOTE: A shared request is not cancelled when the context of the request that started it is
+// cancelled, as other callers may be waiting for it; callers stop waiting once their own
+// context is done.
+func (c *Client) SetGETDeduplication(enabled bool) *Client {
+	c.getFlights.enabled.Store(enabled)
+	return c
+}
+
+// getFlights tracks the GET requests in flight. It is shared by all copies of a Client.
+type getFlights struct {
+	enabled atomic.Bool
+
+	mu    sync.Mutex
+	calls map[string]*getFlight
+}
+
+// getFlight is a GET request whose response body is shared by its callers
+type getFlight struct {
+	done chan struct{}
+	body []byte
+	err  error
+}
+
+func newGETFlights() *getFlights {
+	return &getFlights{calls: make(map[string]*getFlight)}
+}
+
+// do runs fn once for each key at a time, returning its result to all callers waiting for it
+func (f *getFlights) do(ctx context.Context, key string, fn func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	f.mu.Lock()
+
+	call, ok := f.calls[key]
+	if !ok {
+		call = &getFlight{done: make(chan struct{})}
+		f.calls[key] = call
+
+		go func() {
+			call.body, call.err = fn(context.WithoutCancel(ctx))
+
+			f.mu.Lock()
+			delete(f.calls, key)
+			f.mu.Unlock()
+
+			close(call.done)
+		}()
+	}
+
+	f.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.body, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// shouldDeduplicate reports whether GET requests made by the client should be deduplicated
+func (c *Client) shouldDeduplicate() bool {
+	return c.getFlights.enabled.Load() && !c.debug
+}
+
+// doSharedGETRequest runs the GET request, sharing its response with identical requests in flight
+func doSharedGETRequest[T any](ctx context.Context, client *Client, req *resty.Request, endpoint string) (*T, error) {
+	key := endpoint + "?" + req.QueryParam.Encode() + "\n" + req.Header.Get("X-Filter")
+
+	body, err := client.getFlights.do(ctx, key, func(ctx context.Context) ([]byte, error) {
+		r, err := coupleAPIErrors(req.SetContext(ctx).Get(endpoint))
+		if err != nil {
+			return nil, err
+		}
+
+		return r.Body(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result T
+	if err := client.resty.JSONUnmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"sync"
 )
 
 // paginatedResponse represents a single response from a paginated
@@ -26,8 +27,6 @@ func getPaginatedResults[T any](
 	endpoint string,
 	opts *ListOptions,
 ) ([]T, error) {
-	var resultType paginatedResponse[T]
-
 	result := make([]T, 0)
 
 	if opts == nil {
@@ -44,22 +43,11 @@ func getPaginatedResults[T any](
 		// Override the page to be applied in applyListOptionsToRequest(...)
 		opts.Page = page
 
-		// This request object cannot be reused for each page request
-		// because it can lead to possible data corruption
-		req := client.R(ctx).SetResult(resultType)
-
-		// Apply all user-provided list options to the request
-		if err := applyListOptionsToRequest(opts, req); err != nil {
-			return err
-		}
-
-		res, err := coupleAPIErrors(req.Get(endpoint))
+		response, err := getPage[T](ctx, client, endpoint, opts)
 		if err != nil {
 			return err
 		}
 
-		response := res.Result().(*paginatedResponse[T])
-
 		opts.Page = page
 		opts.Pages = response.Pages
 		opts.Results = response.Results
@@ -87,6 +75,17 @@ func getPaginatedResults[T any](
 		return result, nil
 	}
 
+	if opts.Concurrency > 1 && opts.Pages > 2 {
+		rest, err := getPagesConcurrently[T](ctx, client, endpoint, *opts)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.Page = opts.Pages
+
+		return append(result, rest...), nil
+	}
+
 	// Get the rest of the pages
 	for page := 2; page <= opts.Pages; page++ {
 		if err := handlePage(page); err != nil {
@@ -97,6 +96,109 @@ func getPaginatedResults[T any](
 	return result, nil
 }
 
+// getPage requests the page of a paginated endpoint given by opts.Page
+func getPage[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	opts *ListOptions,
+) (*paginatedResponse[T], error) {
+	// This request object cannot be reused for each page request
+	// because it can lead to possible data corruption
+	req := client.R(ctx).SetResult(paginatedResponse[T]{})
+
+	// Apply all user-provided list options to the request
+	if err := applyListOptionsToRequest(opts, req); err != nil {
+		return nil, err
+	}
+
+	if client.shouldDeduplicate() {
+		return doSharedGETRequest[paginatedResponse[T]](ctx, client, req, endpoint)
+	}
+
+	res, err := coupleAPIErrors(req.Get(endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Result().(*paginatedResponse[T]), nil
+}
+
+// getPagesConcurrently requests pages 2 through opts.Pages of a paginated endpoint using
+// opts.Concurrency workers, returning their results in page order. All outstanding requests
+// are cancelled once any request fails.
+func getPagesConcurrently[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	opts ListOptions,
+) ([]T, error) {
+	workerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	pageData := make([][]T, opts.Pages+1)
+	pages := make(chan int)
+
+	for range min(opts.Concurrency, opts.Pages-1) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for page := range pages {
+				pageOpts := opts
+				pageOpts.PageOptions = &PageOptions{Page: page}
+
+				response, err := getPage[T](workerCtx, client, endpoint, &pageOpts)
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+
+					return
+				}
+
+				pageData[page] = response.Data
+			}
+		}()
+	}
+
+feed:
+	for page := 2; page <= opts.Pages; page++ {
+		select {
+		case pages <- page:
+		case <-workerCtx.Done():
+			break feed
+		}
+	}
+
+	close(pages)
+	wg.Wait()
+
+	// Prefer the caller's context error over the errors of the requests it interrupted
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	var result []T
+	for _, data := range pageData {
+		result = append(result, data...)
+	}
+
+	return result, nil
+}
+
 // doGETRequest runs a GET request using the given client and API endpoint,
 // and returns the result
 func doGETRequest[T any](
@@ -104,6 +206,10 @@ func doGETRequest[T any](
 	client *Client,
 	endpoint string,
 ) (*T, error) {
+	if client.shouldDeduplicate() {
+		return doSharedGETRequest[T](ctx, client, client.R(ctx), endpoint)
+	}
+
 	var resultType T
 
 	req := client.R(ctx).SetResult(&resultType)
@@ -138,6 +244,11 @@ func doPOSTRequest[T, O any](
 		if err != nil {
 			return nil, err
 		}
+
+		if err := client.payloadLimits.checkBody(body); err != nil {
+			return nil, err
+		}
+
 		req.SetBody(string(body))
 	}
 
@@ -172,6 +283,11 @@ func doPUTRequest[T, O any](
 		if err != nil {
 			return nil, err
 		}
+
+		if err := client.payloadLimits.checkBody(body); err != nil {
+			return nil, err
+		}
+
 		req.SetBody(string(body))
 	}
 
@@ -3,11 +3,16 @@ package linodego
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -247,6 +252,154 @@ func TestRequestHelpers_paginateSingle(t *testing.T) {
 	}
 }
 
+func TestRequestHelpers_paginateConcurrent(t *testing.T) {
+	const totalResults = 4123
+
+	client := testutil.CreateMockClient(t, NewClient)
+
+	numRequests := 0
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("/foo/bar"),
+		synchronizedResponder(mockPaginatedResponse(buildPaginatedEntries(totalResults), &numRequests)),
+	)
+
+	list := func(concurrency int) []testResultType {
+		response, err := getPaginatedResults[testResultType](
+			context.Background(),
+			client,
+			"/foo/bar",
+			&ListOptions{PageSize: 100, Concurrency: concurrency},
+		)
+		require.NoError(t, err)
+
+		return response
+	}
+
+	sequential := list(0)
+	require.Equal(t, 42, numRequests)
+
+	concurrent := list(8)
+	require.Equal(t, 84, numRequests)
+	require.Equal(t, sequential, concurrent)
+}
+
+func TestRequestHelpers_paginateConcurrentError(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	// Responders may still be running for cancelled requests once the list has returned
+	var numRequests atomic.Int32
+
+	entriesResponder := synchronizedResponder(mockPaginatedResponse(buildPaginatedEntries(3000), new(int)))
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("/foo/bar"),
+		func(request *http.Request) (*http.Response, error) {
+			numRequests.Add(1)
+
+			if request.URL.Query().Get("page") == "3" {
+				return httpmock.NewJsonResponse(http.StatusInternalServerError, APIError{
+					Errors: []APIErrorReason{{Reason: "page unavailable"}},
+				})
+			}
+
+			return entriesResponder(request)
+		},
+	)
+
+	_, err := getPaginatedResults[testResultType](
+		context.Background(),
+		client,
+		"/foo/bar",
+		&ListOptions{PageSize: 10, Concurrency: 2},
+	)
+	require.ErrorContains(t, err, "page unavailable")
+	require.Less(t, numRequests.Load(), int32(300), "expected the remaining pages not to be requested")
+}
+
+func TestRequestHelpers_paginateConcurrentCancel(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	entriesResponder := synchronizedResponder(mockPaginatedResponse(buildPaginatedEntries(3000), new(int)))
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("/foo/bar"),
+		func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("page") == "2" {
+				cancel()
+			}
+
+			return entriesResponder(request)
+		},
+	)
+
+	_, err := getPaginatedResults[testResultType](
+		ctx,
+		client,
+		"/foo/bar",
+		&ListOptions{PageSize: 10, Concurrency: 4},
+	)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func BenchmarkRequestHelpers_paginate(b *testing.B) {
+	entries := buildPaginatedEntries(5000)
+	numRequests := 0
+	responder := synchronizedResponder(mockPaginatedResponse(entries, &numRequests))
+
+	// Simulate the latency of the API, which is what concurrent requests hide
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Millisecond)
+
+		resp, err := responder(r)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(resp.StatusCode)
+		_, _ = io.Copy(w, resp.Body)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	for _, concurrency := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			for range b.N {
+				if _, err := getPaginatedResults[testResultType](
+					context.Background(),
+					&client,
+					"/foo/bar",
+					&ListOptions{PageSize: 25, Concurrency: concurrency},
+				); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// synchronizedResponder allows a responder which is not safe for concurrent use
+// to handle concurrent requests
+func synchronizedResponder(responder httpmock.Responder) httpmock.Responder {
+	var mu sync.Mutex
+
+	return func(request *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return responder(request)
+	}
+}
+
 func buildPaginatedEntries(numEntries int) []testResultType {
 	result := make([]testResultType, numEntries)
 
@@ -0,0 +1,204 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// DefaultMaxResponseSize is the default maximum size in bytes of a response body
+const DefaultMaxResponseSize = 64 << 20
+
+// ErrResponseTooLarge is matched (using errors.Is) by the error returned when a response
+// body exceeds the client's maximum response size.
+var ErrResponseTooLarge = &Error{Code: ErrorFromResponseTooLarge, Message: "response too large"}
+
+// SetMaxResponseSize sets the maximum size in bytes of response bodies read by the client,
+// protecting against unexpectedly large responses, e.g. from a misbehaving proxy. Larger
+// responses fail with an error matching ErrResponseTooLarge. A limit of 0 disables the check.
+// Defaults to DefaultMaxResponseSize.
+//
+// NOTE: The limit is enforced by wrapping the transport of the client's copy of the http.Client
+// passed to NewClient; the caller's http.Client is not modified.
+func (c *Client) SetMaxResponseSize(limit int64) *Client {
+	c.responseLimits.mu.Lock()
+	defer c.responseLimits.mu.Unlock()
+
+	c.responseLimits.max = limit
+
+	return c
+}
+
+// SetMaxResponseBytes is an alias of SetMaxResponseSize. Passing 0 restores unlimited
+// response reads; otherwise the client defaults to DefaultMaxResponseSize.
+func (c *Client) SetMaxResponseBytes(n int64) *Client {
+	return c.SetMaxResponseSize(n)
+}
+
+// SetEndpointMaxResponseSize overrides the maximum response size for an endpoint which
+// legitimately returns large responses. The endpoint is given as reported to a
+// MetricsCollector, i.e. with IDs replaced by "{id}", e.g. "/linode/instances/{id}/stats".
+func (c *Client) SetEndpointMaxResponseSize(endpointTemplate string, limit int64) *Client {
+	c.responseLimits.mu.Lock()
+	defer c.responseLimits.mu.Unlock()
+
+	c.responseLimits.endpoints[endpointTemplate] = limit
+
+	return c
+}
+
+// responseLimits is shared by all copies of a Client, as its hooks are registered on the resty client
+type responseLimits struct {
+	mu        sync.RWMutex
+	max       int64
+	endpoints map[string]int64
+}
+
+type responseLimitKey struct{}
+
+// responseLimit is passed to responseLimitTransport using the request context
+type responseLimit struct {
+	endpoint string
+	limit    int64
+}
+
+func newResponseLimits(rc *resty.Client) *responseLimits {
+	l := &responseLimits{
+		max:       DefaultMaxResponseSize,
+		endpoints: make(map[string]int64),
+	}
+
+	rc.OnBeforeRequest(func(rc *resty.Client, r *resty.Request) error {
+		template := endpointTemplate(rc.BaseURL, r.URL)
+
+		if limit := l.forEndpoint(template); limit > 0 {
+			r.SetContext(context.WithValue(r.Context(), responseLimitKey{}, responseLimit{
+				endpoint: r.Method + " " + template,
+				limit:    limit,
+			}))
+		}
+
+		return nil
+	})
+
+	// NewClient gives rc its own copy of the caller's http.Client, so wrapping its transport
+	// does not affect other users of the caller's http.Client
+	hc := rc.GetClient()
+	if _, ok := hc.Transport.(*responseLimitTransport); !ok {
+		hc.Transport = &responseLimitTransport{base: hc.Transport}
+	}
+
+	return l
+}
+
+func (l *responseLimits) forEndpoint(template string) int64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if limit, ok := l.endpoints[template]; ok {
+		return limit
+	}
+
+	return l.max
+}
+
+func (l *responseLimits) copyFrom(other *responseLimits) {
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.max = other.max
+
+	for endpoint, limit := range other.endpoints {
+		l.endpoints[endpoint] = limit
+	}
+}
+
+// responseLimitTransport limits the response bodies of requests carrying a responseLimit in their context
+type responseLimitTransport struct {
+	base http.RoundTripper
+}
+
+func (t *responseLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if limit, ok := req.Context().Value(responseLimitKey{}).(responseLimit); ok && resp.Body != nil {
+		resp.Body = &limitedResponseBody{
+			body:      resp.Body,
+			remaining: limit.limit,
+			limit:     limit,
+			tooLarge:  resp.ContentLength > limit.limit,
+		}
+	}
+
+	return resp, nil
+}
+
+// limitedResponseBody fails reads once more than limit bytes have been read, or
+// immediately if the response's Content-Length exceeds the limit.
+type limitedResponseBody struct {
+	body      io.ReadCloser
+	remaining int64
+	limit     responseLimit
+	tooLarge  bool
+}
+
+func (b *limitedResponseBody) Read(p []byte) (int, error) {
+	if b.tooLarge {
+		return 0, b.err()
+	}
+
+	// Read one byte more than remains to detect bodies exceeding the limit
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+
+	n, err := b.body.Read(p)
+
+	if int64(n) > b.remaining {
+		b.tooLarge = true
+		return 0, b.err()
+	}
+
+	b.remaining -= int64(n)
+
+	return n, err
+}
+
+func (b *limitedResponseBody) Close() error {
+	return b.body.Close()
+}
+
+func (b *limitedResponseBody) err() error {
+	return &Error{
+		Code:    ErrorFromResponseTooLarge,
+		Message: fmt.Sprintf("%s: response to %s exceeded the limit of %d bytes", ErrResponseTooLarge.Message, b.limit.endpoint, b.limit.limit),
+	}
+}
+
+// withBaseTransport runs f with a resty client using the transport wrapped by the one
+// limiting responses, as resty is only able to configure TLS when using an *http.Transport.
+// The transport of the client's http.Client is left in place, so requests in flight are unaffected.
+func (c *Client) withBaseTransport(f func(rc *resty.Client)) {
+	wrapped, ok := c.resty.GetClient().Transport.(*responseLimitTransport)
+	if !ok || wrapped.base == nil {
+		f(c.resty)
+		return
+	}
+
+	f(resty.NewWithClient(&http.Client{Transport: wrapped.base}))
+}
@@ -2,9 +2,13 @@ package linodego
 
 import (
 	"errors"
+	"io"
 	"log"
+	"net"
 	"net/http"
 	"strconv"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -36,15 +40,40 @@ func configureRetries(c *Client) {
 
 func checkRetryConditionals(c *Client) func(*resty.Response, error) bool {
 	return func(r *resty.Response, err error) bool {
-		for _, retryConditional := range c.retryConditionals {
-			retry := retryConditional(r, err)
-			if retry {
-				log.Printf("[INFO] Received error %s - Retrying", r.Error())
-				return true
+		// A nil response indicates the request failed before being sent,
+		// e.g. an OnBeforeRequest hook returned an error
+		if r == nil {
+			return false
+		}
+
+		// Attempts which failed with an error are not passed to OnAfterResponse hooks,
+		// which record the others, and only the last is passed to OnError
+		if err != nil {
+			c.metrics.observe(c.resty, r)
+
+			if r.RawResponse == nil {
+				c.circuitBreaker.record(true)
 			}
 		}
-		return false
+
+		retry := c.isRetryable(r, err)
+		if retry {
+			log.Printf("[INFO] Received error %s - Retrying", r.Error())
+		}
+
+		return retry
+	}
+}
+
+// isRetryable returns whether any of the client's retry conditionals match the attempt
+func (c *Client) isRetryable(r *resty.Response, err error) bool {
+	for _, retryConditional := range c.retryConditionals {
+		if retryConditional(r, err) {
+			return true
+		}
 	}
+
+	return false
 }
 
 // SetLinodeBusyRetry configures resty to retry specifically on "Linode busy." errors
@@ -88,6 +117,50 @@ func requestNGINXRetryCondition(r *resty.Response, _ error) bool {
 		r.Header().Get("Content-Type") == "text/html"
 }
 
+// badGatewayRetryCondition retries 502 and 504 responses, which may be returned
+// by a proxy after the API has processed the request.
+func badGatewayRetryCondition(r *resty.Response, _ error) bool {
+	return r.StatusCode() == http.StatusBadGateway || r.StatusCode() == http.StatusGatewayTimeout
+}
+
+// transientNetworkRetryCondition retries requests which failed due to a dropped or
+// timed out connection, in which case the request may have been processed.
+func transientNetworkRetryCondition(_ *resty.Response, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// idempotentRetryCondition restricts the given condition to requests that are safe to repeat,
+// i.e. those using an idempotent method, unless retrying other requests has been opted into.
+func idempotentRetryCondition(nonIdempotent *atomic.Bool, condition RetryConditional) RetryConditional {
+	return func(r *resty.Response, err error) bool {
+		if !nonIdempotent.Load() && !isIdempotentMethod(r.Request.Method) {
+			return false
+		}
+
+		return condition(r, err)
+	}
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
 func respectRetryAfter(client *resty.Client, resp *resty.Response) (time.Duration, error) {
 	retryAfterStr := resp.Header().Get(retryAfterHeaderName)
 	if retryAfterStr == "" {
@@ -1,7 +1,10 @@
 package linodego
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -74,3 +77,131 @@ func TestLinodeServiceMaintenanceModeRetryCondition(t *testing.T) {
 		t.Error("expected retry to be skipped due to maintenance mode header")
 	}
 }
+
+// newFlakyServer returns a server which responds using fail for the first
+// failures requests, and succeeds afterwards.
+func newFlakyServer(t *testing.T, failures int32, fail http.HandlerFunc) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			fail(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func newFlakyServerClient(server *httptest.Server) *Client {
+	client := NewClient(server.Client())
+	client.SetBaseURL(server.URL).
+		SetRetryWaitTime(time.Millisecond).
+		SetRetryMaxWaitTime(5 * time.Second)
+
+	return &client
+}
+
+func respondWithStatus(status int, retryAfter string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		if retryAfter != "" {
+			w.Header().Set(retryAfterHeaderName, retryAfter)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"errors": [{"reason": "try again"}]}`))
+	}
+}
+
+func TestRetries_TooManyRequestsRespectsRetryAfter(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, respondWithStatus(http.StatusTooManyRequests, "1"))
+	client := newFlakyServerClient(server)
+
+	var attempts []int
+	client.OnRetry(func(attempt RetryAttempt) {
+		attempts = append(attempts, attempt.Attempt)
+	})
+
+	start := time.Now()
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the Retry-After of 1s to be respected, retried after %s", elapsed)
+	}
+
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", calls.Load())
+	}
+
+	if len(attempts) != 1 || attempts[0] != 1 {
+		t.Errorf("expected the retry of attempt 1 to be observed, got %v", attempts)
+	}
+}
+
+func TestRetries_GatewayErrors(t *testing.T) {
+	for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server, calls := newFlakyServer(t, 2, respondWithStatus(status, ""))
+			client := newFlakyServerClient(server)
+
+			if _, err := client.GetInstance(context.Background(), 123); err != nil {
+				t.Fatal(err)
+			}
+
+			if calls.Load() != 3 {
+				t.Errorf("expected 3 requests, got %d", calls.Load())
+			}
+		})
+	}
+}
+
+func TestRetries_NonIdempotent(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, respondWithStatus(http.StatusBadGateway, ""))
+	client := newFlakyServerClient(server)
+
+	if _, err := client.CreateInstance(context.Background(), InstanceCreateOptions{Region: "us-east"}); err == nil {
+		t.Fatal("expected the POST not to be retried")
+	}
+
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", calls.Load())
+	}
+
+	client.SetRetryNonIdempotent(true)
+
+	if _, err := client.CreateInstance(context.Background(), InstanceCreateOptions{Region: "us-east"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRetries_TransientNetworkError(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, func(w http.ResponseWriter, _ *http.Request) {
+		// Drop the connection without responding
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		_ = conn.Close()
+	})
+	client := newFlakyServerClient(server)
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", calls.Load())
+	}
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -124,6 +125,13 @@ func (c *Client) GetStackscript(ctx context.Context, scriptID int) (*Stackscript
 
 // CreateStackscript creates a StackScript
 func (c *Client) CreateStackscript(ctx context.Context, opts StackscriptCreateOptions) (*Stackscript, error) {
+	if err := errors.Join(
+		c.payloadLimits.checkStackScriptLabel(opts.Label),
+		c.payloadLimits.checkStackScript(opts.Script),
+	); err != nil {
+		return nil, err
+	}
+
 	e := "linode/stackscripts"
 	response, err := doPOSTRequest[Stackscript](ctx, c, e, opts)
 	return response, err
@@ -131,6 +139,13 @@ func (c *Client) CreateStackscript(ctx context.Context, opts StackscriptCreateOp
 
 // UpdateStackscript updates the StackScript with the specified id
 func (c *Client) UpdateStackscript(ctx context.Context, scriptID int, opts StackscriptUpdateOptions) (*Stackscript, error) {
+	if err := errors.Join(
+		c.payloadLimits.checkStackScriptLabel(opts.Label),
+		c.payloadLimits.checkStackScript(opts.Script),
+	); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("linode/stackscripts/%d", scriptID)
 	response, err := doPUTRequest[Stackscript](ctx, c, e, opts)
 	return response, err
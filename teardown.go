@@ -0,0 +1,445 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// teardownWaitTimeoutSeconds bounds waiting for a Volume to be detached during a teardown
+const teardownWaitTimeoutSeconds = 600
+
+// defaultTeardownConcurrency is the number of steps run concurrently when
+// TeardownExecuteOptions.Concurrency is not set.
+const defaultTeardownConcurrency = 4
+
+// TeardownSelector selects the resources to be torn down by PlanTeardown. Resources
+// matching either the Tag or the LabelPrefix are selected; at least one must be set.
+type TeardownSelector struct {
+	Tag         string
+	LabelPrefix string
+}
+
+// TeardownAction is the kind of change made by a TeardownStep
+type TeardownAction string
+
+// TeardownAction constants start with Teardown and are listed in the order they are executed
+const (
+	TeardownDeleteDomainRecord     TeardownAction = "delete_domain_record"
+	TeardownDeleteNodeBalancerNode TeardownAction = "delete_nodebalancer_node"
+	TeardownDetachVolume           TeardownAction = "detach_volume"
+	TeardownDeleteFirewallDevice   TeardownAction = "delete_firewall_device"
+	TeardownDeleteNodeBalancer     TeardownAction = "delete_nodebalancer"
+	TeardownDeleteInstance         TeardownAction = "delete_instance"
+	TeardownDeleteVolume           TeardownAction = "delete_volume"
+	TeardownDeleteFirewall         TeardownAction = "delete_firewall"
+	TeardownReleaseReservedIP      TeardownAction = "release_reserved_ip"
+)
+
+// teardownOrder is the order in which the steps of each TeardownAction are executed.
+// Dependents are always removed before the resources they depend on.
+var teardownOrder = []TeardownAction{
+	TeardownDeleteDomainRecord,
+	TeardownDeleteNodeBalancerNode,
+	TeardownDetachVolume,
+	TeardownDeleteFirewallDevice,
+	TeardownDeleteNodeBalancer,
+	TeardownDeleteInstance,
+	TeardownDeleteVolume,
+	TeardownDeleteFirewall,
+	TeardownReleaseReservedIP,
+}
+
+// TeardownStep is a single change made when executing a TeardownPlan. Steps are executed
+// using their Action and resource IDs, so a plan can be serialized and executed later.
+type TeardownStep struct {
+	Action      TeardownAction `json:"action"`
+	Description string         `json:"description"`
+
+	// ResourceIDs are the ID of the resource changed by the step, preceded by the IDs of
+	// the resources it belongs to, e.g. the NodeBalancer, config and node IDs of a node
+	ResourceIDs []int `json:"resource_ids,omitempty"`
+
+	// Address is the address released by a release_reserved_ip step
+	Address string `json:"address,omitempty"`
+}
+
+// TeardownPlan is an ordered set of steps that remove the resources selected by PlanTeardown
+type TeardownPlan struct {
+	Steps []TeardownStep `json:"steps"`
+}
+
+// TeardownExecuteOptions are the options accepted by ExecuteTeardown
+type TeardownExecuteOptions struct {
+	// DryRun returns the steps that would be executed without making any changes
+	DryRun bool
+
+	// Concurrency is the maximum number of steps of the same action run at once; defaults to 4
+	Concurrency int
+}
+
+// TeardownStepResult is the outcome of a single step of ExecuteTeardown
+type TeardownStepResult struct {
+	Step     TeardownStep
+	Executed bool
+	Err      error
+}
+
+func (s TeardownSelector) matches(label string, tags []string) bool {
+	if s.Tag != "" && slices.Contains(tags, s.Tag) {
+		return true
+	}
+
+	return s.LabelPrefix != "" && strings.HasPrefix(label, s.LabelPrefix)
+}
+
+func (p *TeardownPlan) add(action TeardownAction, description string, resourceIDs ...int) {
+	p.Steps = append(p.Steps, TeardownStep{Action: action, Description: description, ResourceIDs: resourceIDs})
+}
+
+// teardownResourceIDs is the number of resource IDs needed by each TeardownAction
+var teardownResourceIDs = map[TeardownAction]int{
+	TeardownDeleteDomainRecord:     2,
+	TeardownDeleteNodeBalancerNode: 3,
+	TeardownDetachVolume:           1,
+	TeardownDeleteFirewallDevice:   2,
+	TeardownDeleteNodeBalancer:     1,
+	TeardownDeleteInstance:         1,
+	TeardownDeleteVolume:           1,
+	TeardownDeleteFirewall:         1,
+	TeardownReleaseReservedIP:      0,
+}
+
+// run makes the change described by the step
+func (s TeardownStep) run(ctx context.Context, c *Client) error {
+	if count, ok := teardownResourceIDs[s.Action]; ok && len(s.ResourceIDs) != count {
+		return fmt.Errorf("%d resource IDs are required, got %d", count, len(s.ResourceIDs))
+	}
+
+	ids := s.ResourceIDs
+
+	switch s.Action {
+	case TeardownDeleteDomainRecord:
+		return c.DeleteDomainRecord(ctx, ids[0], ids[1])
+	case TeardownDeleteNodeBalancerNode:
+		return c.DeleteNodeBalancerNode(ctx, ids[0], ids[1], ids[2])
+	case TeardownDetachVolume:
+		if err := c.DetachVolume(ctx, ids[0]); err != nil {
+			return err
+		}
+
+		_, err := c.WaitForVolumeLinodeID(ctx, ids[0], nil, teardownWaitTimeoutSeconds)
+		return err
+	case TeardownDeleteFirewallDevice:
+		return c.DeleteFirewallDevice(ctx, ids[0], ids[1])
+	case TeardownDeleteNodeBalancer:
+		return c.DeleteNodeBalancer(ctx, ids[0])
+	case TeardownDeleteInstance:
+		return c.DeleteInstance(ctx, ids[0])
+	case TeardownDeleteVolume:
+		return c.DeleteVolume(ctx, ids[0])
+	case TeardownDeleteFirewall:
+		return c.DeleteFirewall(ctx, ids[0])
+	case TeardownReleaseReservedIP:
+		if s.Address == "" {
+			return fmt.Errorf("an address is required")
+		}
+
+		return c.DeleteReservedIPAddress(ctx, s.Address)
+	default:
+		return fmt.Errorf("unknown teardown action %q", s.Action)
+	}
+}
+
+// PlanTeardown discovers the Instances, Volumes, Firewalls and NodeBalancers matching the
+// selector, along with the resources that depend on them, and plans their removal in an
+// order that respects those dependencies:
+//
+//   - Domain records targeting the addresses of selected Instances and NodeBalancers are deleted
+//   - Nodes of other NodeBalancers that send traffic to selected Instances are removed
+//   - Selected Volumes are detached and selected Firewalls have their devices removed
+//   - Selected NodeBalancers, Instances, Volumes and Firewalls are deleted, in that order
+//   - Reserved IPs assigned to selected Instances are released last
+//
+// No changes are made until the plan is passed to ExecuteTeardown.
+func (c *Client) PlanTeardown(ctx context.Context, selector TeardownSelector) (*TeardownPlan, error) {
+	if selector.Tag == "" && selector.LabelPrefix == "" {
+		return nil, fmt.Errorf("a tag or label prefix must be given to select resources")
+	}
+
+	steps := make(map[TeardownAction]*TeardownPlan, len(teardownOrder))
+	for _, action := range teardownOrder {
+		steps[action] = &TeardownPlan{}
+	}
+
+	instances, err := c.ListInstances(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceAddresses := make(map[string]bool)
+	recordTargets := make(map[string]bool)
+	reservedIPs := make([]string, 0)
+
+	for _, instance := range instances {
+		if !selector.matches(instance.Label, instance.Tags) {
+			continue
+		}
+
+		for _, ip := range instance.IPv4 {
+			instanceAddresses[ip.String()] = true
+			recordTargets[ip.String()] = true
+		}
+
+		if instance.IPv6 != "" {
+			recordTargets[strings.Split(instance.IPv6, "/")[0]] = true
+		}
+
+		ips, err := c.GetInstanceIPAddresses(ctx, instance.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if ips.IPv4 != nil {
+			for _, ip := range ips.IPv4.Reserved {
+				if !slices.Contains(reservedIPs, ip.Address) {
+					reservedIPs = append(reservedIPs, ip.Address)
+				}
+			}
+		}
+
+		steps[TeardownDeleteInstance].add(TeardownDeleteInstance,
+			fmt.Sprintf("instance %d (%s)", instance.ID, instance.Label), instance.ID)
+	}
+
+	for _, address := range reservedIPs {
+		steps[TeardownReleaseReservedIP].Steps = append(steps[TeardownReleaseReservedIP].Steps, TeardownStep{
+			Action:      TeardownReleaseReservedIP,
+			Description: fmt.Sprintf("reserved IP %s", address),
+			Address:     address,
+		})
+	}
+
+	nodebalancers, err := c.ListNodeBalancers(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, nb := range nodebalancers {
+		label := ""
+		if nb.Label != nil {
+			label = *nb.Label
+		}
+
+		if selector.matches(label, nb.Tags) {
+			for _, ip := range []*string{nb.IPv4, nb.IPv6} {
+				if ip != nil {
+					recordTargets[*ip] = true
+				}
+			}
+
+			steps[TeardownDeleteNodeBalancer].add(TeardownDeleteNodeBalancer,
+				fmt.Sprintf("nodebalancer %d (%s)", nb.ID, label), nb.ID)
+
+			continue
+		}
+
+		// Nodes of NodeBalancers being deleted are removed along with them,
+		// so only other NodeBalancers need to be checked for selected Instances.
+		if err := c.planNodeBalancerNodeTeardown(ctx, nb.ID, instanceAddresses, steps[TeardownDeleteNodeBalancerNode]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.planDomainRecordTeardown(ctx, recordTargets, steps[TeardownDeleteDomainRecord]); err != nil {
+		return nil, err
+	}
+
+	volumes, err := c.ListVolumes(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, volume := range volumes {
+		if !selector.matches(volume.Label, volume.Tags) {
+			continue
+		}
+
+		description := fmt.Sprintf("volume %d (%s)", volume.ID, volume.Label)
+
+		if volume.LinodeID != nil {
+			steps[TeardownDetachVolume].add(TeardownDetachVolume, description, volume.ID)
+		}
+
+		steps[TeardownDeleteVolume].add(TeardownDeleteVolume, description, volume.ID)
+	}
+
+	firewalls, err := c.ListFirewalls(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, firewall := range firewalls {
+		if !selector.matches(firewall.Label, firewall.Tags) {
+			continue
+		}
+
+		devices, err := c.ListFirewallDevices(ctx, firewall.ID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, device := range devices {
+			steps[TeardownDeleteFirewallDevice].add(TeardownDeleteFirewallDevice,
+				fmt.Sprintf("firewall %d device %d (%s %d)", firewall.ID, device.ID, device.Entity.Type, device.Entity.ID),
+				firewall.ID, device.ID)
+		}
+
+		steps[TeardownDeleteFirewall].add(TeardownDeleteFirewall,
+			fmt.Sprintf("firewall %d (%s)", firewall.ID, firewall.Label), firewall.ID)
+	}
+
+	plan := &TeardownPlan{Steps: make([]TeardownStep, 0)}
+	for _, action := range teardownOrder {
+		plan.Steps = append(plan.Steps, steps[action].Steps...)
+	}
+
+	return plan, nil
+}
+
+// planNodeBalancerNodeTeardown plans the removal of the nodes of a NodeBalancer
+// that send traffic to any of the given addresses.
+func (c *Client) planNodeBalancerNodeTeardown(
+	ctx context.Context, nodebalancerID int, addresses map[string]bool, plan *TeardownPlan,
+) error {
+	configs, err := c.ListNodeBalancerConfigs(ctx, nodebalancerID, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, config := range configs {
+		nodes, err := c.ListNodeBalancerNodes(ctx, nodebalancerID, config.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, node := range nodes {
+			host, _, err := net.SplitHostPort(node.Address)
+			if err != nil || !addresses[host] {
+				continue
+			}
+
+			plan.add(TeardownDeleteNodeBalancerNode,
+				fmt.Sprintf("nodebalancer %d config %d node %d (%s)", nodebalancerID, config.ID, node.ID, node.Address),
+				nodebalancerID, config.ID, node.ID)
+		}
+	}
+
+	return nil
+}
+
+// planDomainRecordTeardown plans the removal of the A and AAAA records of all
+// Domains that target any of the given addresses.
+func (c *Client) planDomainRecordTeardown(ctx context.Context, targets map[string]bool, plan *TeardownPlan) error {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	domains, err := c.ListDomains(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, domain := range domains {
+		records, err := c.ListDomainRecords(ctx, domain.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			if (record.Type != RecordTypeA && record.Type != RecordTypeAAAA) || !targets[record.Target] {
+				continue
+			}
+
+			plan.add(TeardownDeleteDomainRecord,
+				fmt.Sprintf("domain %d (%s) record %d (%s %s)", domain.ID, domain.Domain, record.ID, record.Name, record.Target),
+				domain.ID, record.ID)
+		}
+	}
+
+	return nil
+}
+
+// ExecuteTeardown executes the steps of a TeardownPlan in order. Steps with the same action
+// are run concurrently, and execution stops before the next action if any step fails, leaving
+// the remaining steps unexecuted. A result is returned for every step of the plan, and the
+// returned error joins the errors of all failed steps.
+func (c *Client) ExecuteTeardown(
+	ctx context.Context, plan *TeardownPlan, opts TeardownExecuteOptions,
+) ([]TeardownStepResult, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultTeardownConcurrency
+	}
+
+	results := make([]TeardownStepResult, len(plan.Steps))
+	for i, step := range plan.Steps {
+		results[i] = TeardownStepResult{Step: step}
+	}
+
+	if opts.DryRun {
+		return results, nil
+	}
+
+	sem := make(chan struct{}, concurrency)
+
+	for start := 0; start < len(plan.Steps); {
+		end := start
+		for end < len(plan.Steps) && plan.Steps[end].Action == plan.Steps[start].Action {
+			end++
+		}
+
+		var wg sync.WaitGroup
+
+		for i := start; i < end; i++ {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				err := plan.Steps[i].run(ctx, c)
+				if err != nil {
+					err = fmt.Errorf("failed to %s %s: %w",
+						strings.ReplaceAll(string(plan.Steps[i].Action), "_", " "), plan.Steps[i].Description, err)
+				}
+
+				results[i].Executed = true
+				results[i].Err = err
+			}(i)
+		}
+
+		wg.Wait()
+
+		errs := make([]error, 0)
+		for _, r := range results[start:end] {
+			if r.Err != nil {
+				errs = append(errs, r.Err)
+			}
+		}
+
+		if len(errs) > 0 {
+			return results, errors.Join(errs...)
+		}
+
+		start = end
+	}
+
+	return results, nil
+}
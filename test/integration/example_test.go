@@ -9,7 +9,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/linode/linodego"
 )
@@ -282,3 +284,29 @@ func ExampleClient_ListStackscripts_page1() {
 	// StackScript Script has shebang: true
 	// Created is parsed: true
 }
+
+// ExampleClient_MigrateInstance demonstrates migrating an Instance to another region and
+// waiting for the migration to finish. This example is not run, as it requires a real Instance.
+func ExampleClient_MigrateInstance() {
+	linodeClient := linodego.NewClient(nil)
+	linodeClient.SetToken(os.Getenv("LINODE_TOKEN"))
+
+	ctx := context.Background()
+	instanceID := 123
+
+	// Events created before the migration are ignored
+	start := time.Now()
+
+	if err := linodeClient.MigrateInstance(ctx, instanceID, linodego.InstanceMigrateOptions{
+		Region: "us-west",
+		Type:   linodego.WarmMigration,
+	}); err != nil {
+		log.Fatal(err)
+	}
+
+	if _, err := linodeClient.WaitForEventFinished(
+		ctx, instanceID, linodego.EntityLinode, linodego.ActionLinodeMigrateDatacenter, start, 3600,
+	); err != nil {
+		log.Fatal(err)
+	}
+}
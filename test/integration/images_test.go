@@ -111,7 +111,7 @@ func TestImage_CreateUpload(t *testing.T) {
 	defer teardown()
 
 	image, uploadURL, err := client.CreateImageUpload(context.Background(), ImageCreateUploadOptions{
-		Region: getRegionsWithCaps(t, client, []string{"Metadata"})[0],
+		Region: getRegionsWithCaps(t, client, []Capability{CapabilityMetadata})[0],
 
 		Label:       "linodego-image-create-upload",
 		Description: "An image that does stuff.",
@@ -140,7 +140,7 @@ func TestImage_CloudInit(t *testing.T) {
 	client, instance, teardown, err := setupInstance(
 		t, "fixtures/TestImage_CloudInit", true,
 		func(client *Client, options *InstanceCreateOptions) {
-			options.Region = getRegionsWithCaps(t, client, []string{"Metadata"})[0]
+			options.Region = getRegionsWithCaps(t, client, []Capability{CapabilityMetadata})[0]
 		})
 	if err != nil {
 		t.Fatal(err)
@@ -181,7 +181,7 @@ func TestImage_Replicate(t *testing.T) {
 	client, teardown := createTestClient(t, "fixtures/TestImage_Replicate")
 	defer teardown()
 
-	availableRegions := getRegionsWithCapsAndSiteType(t, client, []string{"Object Storage"}, "core")
+	availableRegions := getRegionsWithCapsAndSiteType(t, client, []Capability{CapabilityObjectStorage}, "core")
 
 	image, uploadURL, err := client.CreateImageUpload(context.Background(), ImageCreateUploadOptions{
 		Region:      availableRegions[1],
@@ -3,12 +3,12 @@ package integration
 import (
 	"context"
 	"encoding/base64"
-	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/linode/linodego"
+	"github.com/linode/linodego/filter"
 )
 
 type instanceModifier func(*linodego.Client, *linodego.InstanceCreateOptions)
@@ -28,7 +28,7 @@ func TestInstances_List(t *testing.T) {
 		t.Error(err)
 	}
 
-	listOpts := linodego.NewListOptions(1, "{\"id\": "+strconv.Itoa(instance.ID)+"}")
+	listOpts := linodego.NewListOptions(1, filter.And(filter.Eq("id", instance.ID)))
 	linodes, err := client.ListInstances(context.Background(), listOpts)
 	if err != nil {
 		t.Errorf("Error listing instances, expected struct, got error %v", err)
@@ -138,7 +138,7 @@ func TestInstance_Disks_List(t *testing.T) {
 
 func TestInstance_Disks_List_WithEncryption(t *testing.T) {
 	client, instance, teardown, err := setupInstance(t, "fixtures/TestInstance_Disks_List_WithEncryption", true, func(c *linodego.Client, ico *linodego.InstanceCreateOptions) {
-		ico.Region = getRegionsWithCaps(t, c, []string{"Disk Encryption"})[0]
+		ico.Region = getRegionsWithCaps(t, c, []linodego.Capability{linodego.CapabilityDiskEncryption})[0]
 	})
 	defer teardown()
 	if err != nil {
@@ -379,7 +379,7 @@ func TestInstance_Rebuild(t *testing.T) {
 		t,
 		"fixtures/TestInstance_Rebuild", true,
 		func(client *linodego.Client, options *linodego.InstanceCreateOptions) {
-			options.Region = getRegionsWithCaps(t, client, []string{"Metadata"})[0]
+			options.Region = getRegionsWithCaps(t, client, []linodego.Capability{linodego.CapabilityMetadata})[0]
 		},
 	)
 	defer teardown()
@@ -417,7 +417,7 @@ func TestInstance_RebuildWithEncryption(t *testing.T) {
 		"fixtures/TestInstance_RebuildWithEncryption",
 		true,
 		func(client *linodego.Client, options *linodego.InstanceCreateOptions) {
-			options.Region = getRegionsWithCaps(t, client, []string{"Disk Encryption"})[0]
+			options.Region = getRegionsWithCaps(t, client, []linodego.Capability{linodego.CapabilityDiskEncryption})[0]
 			options.DiskEncryption = linodego.InstanceDiskEncryptionEnabled
 		},
 	)
@@ -454,7 +454,7 @@ func TestInstance_Clone(t *testing.T) {
 	client, instance, teardownOriginalLinode, err := setupInstance(
 		t, "fixtures/TestInstance_Clone", true,
 		func(client *linodego.Client, options *linodego.InstanceCreateOptions) {
-			targetRegion = getRegionsWithCaps(t, client, []string{"Metadata"})[0]
+			targetRegion = getRegionsWithCaps(t, client, []linodego.Capability{linodego.CapabilityMetadata})[0]
 
 			options.Region = targetRegion
 		})
@@ -532,7 +532,7 @@ func TestInstance_withMetadata(t *testing.T) {
 			options.Metadata = &linodego.InstanceMetadataOptions{
 				UserData: base64.StdEncoding.EncodeToString([]byte("reallycoolmetadata")),
 			}
-			options.Region = getRegionsWithCaps(t, client, []string{"Metadata"})[0]
+			options.Region = getRegionsWithCaps(t, client, []linodego.Capability{linodego.CapabilityMetadata})[0]
 		})
 	if err != nil {
 		t.Fatal(err)
@@ -597,7 +597,7 @@ func createInstance(t *testing.T, client *linodego.Client, enableCloudFirewall b
 	createOpts := linodego.InstanceCreateOptions{
 		Label:    "go-test-ins-" + randLabel(),
 		RootPass: randPassword(),
-		Region:   getRegionsWithCaps(t, client, []string{"linodes"})[0],
+		Region:   getRegionsWithCaps(t, client, []linodego.Capability{linodego.CapabilityLinodes})[0],
 		Type:     "g6-nanode-1",
 		Image:    "linode/debian9",
 		Booted:   linodego.Pointer(false),
@@ -645,7 +645,7 @@ func createInstanceWithoutDisks(
 
 	createOpts := linodego.InstanceCreateOptions{
 		Label:  "go-test-ins-wo-disk-" + randLabel(),
-		Region: getRegionsWithCaps(t, client, []string{"linodes"})[0],
+		Region: getRegionsWithCaps(t, client, []linodego.Capability{linodego.CapabilityLinodes})[0],
 		Type:   "g6-nanode-1",
 		Booted: linodego.Pointer(false),
 	}
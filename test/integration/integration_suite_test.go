@@ -9,7 +9,6 @@ import (
 	"os"
 	"regexp"
 	"strconv"
-	"strings"
 	"testing"
 	"time"
 
@@ -195,7 +194,7 @@ Parameters:
 Returns:
   - string values representing the IDs of regions that have a given set of capabilities.
 */
-func getRegionsWithCaps(t *testing.T, client *linodego.Client, capabilities []string) []string {
+func getRegionsWithCaps(t *testing.T, client *linodego.Client, capabilities []linodego.Capability) []string {
 	result := make([]string, 0)
 
 	regions, err := client.ListRegions(context.Background(), nil)
@@ -204,7 +203,7 @@ func getRegionsWithCaps(t *testing.T, client *linodego.Client, capabilities []st
 	}
 
 	for _, region := range regions {
-		if region.Status != "ok" || !regionHasCaps(region, capabilities) {
+		if region.Status != "ok" || !region.HasCapabilities(capabilities...) {
 			continue
 		}
 
@@ -216,7 +215,7 @@ func getRegionsWithCaps(t *testing.T, client *linodego.Client, capabilities []st
 
 // getRegionWithCapsAndPlans resolves a list of regions that meet the given capabilities
 // and has availability for all the provided plans.
-func getRegionsWithCapsAndPlans(t *testing.T, client *linodego.Client, capabilities, plans []string) []string {
+func getRegionsWithCapsAndPlans(t *testing.T, client *linodego.Client, capabilities []linodego.Capability, plans []string) []string {
 	regionsWithCaps := getRegionsWithCaps(t, client, capabilities)
 
 	regionsAvailabilities, err := client.ListRegionsAvailability(context.Background(), nil)
@@ -256,7 +255,7 @@ func getRegionsWithCapsAndPlans(t *testing.T, client *linodego.Client, capabilit
 }
 
 // getRegionsWithCapsAndSiteType returns a list of regions that meet the given capabilities and site type
-func getRegionsWithCapsAndSiteType(t *testing.T, client *linodego.Client, capabilities []string, siteType string) []string {
+func getRegionsWithCapsAndSiteType(t *testing.T, client *linodego.Client, capabilities []linodego.Capability, siteType string) []string {
 	result := make([]string, 0)
 
 	regions, err := client.ListRegions(context.Background(), nil)
@@ -265,7 +264,7 @@ func getRegionsWithCapsAndSiteType(t *testing.T, client *linodego.Client, capabi
 	}
 
 	for _, region := range regions {
-		if region.Status != "ok" || region.SiteType != siteType || !regionHasCaps(region, capabilities) {
+		if region.Status != "ok" || region.SiteType != siteType || !region.HasCapabilities(capabilities...) {
 			continue
 		}
 
@@ -274,19 +273,3 @@ func getRegionsWithCapsAndSiteType(t *testing.T, client *linodego.Client, capabi
 
 	return result
 }
-
-func regionHasCaps(r linodego.Region, capabilities []string) bool {
-	capsMap := make(map[string]bool)
-
-	for _, c := range r.Capabilities {
-		capsMap[strings.ToUpper(c)] = true
-	}
-
-	for _, c := range capabilities {
-		if _, ok := capsMap[strings.ToUpper(c)]; !ok {
-			return false
-		}
-	}
-
-	return true
-}
@@ -122,7 +122,7 @@ func createPlacementGroup(
 	t.Helper()
 	createOpts := linodego.PlacementGroupCreateOptions{
 		Label:                "linodego-test-" + getUniqueText(),
-		Region:               getRegionsWithCaps(t, client, []string{"Placement Group"})[0],
+		Region:               getRegionsWithCaps(t, client, []linodego.Capability{linodego.CapabilityPlacementGroup})[0],
 		PlacementGroupType:   linodego.PlacementGroupTypeAntiAffinityLocal,
 		PlacementGroupPolicy: linodego.PlacementGroupPolicyFlexible,
 	}
@@ -35,7 +35,7 @@ func TestRegions_pgLimits(t *testing.T) {
 
 	// Filtering is not currently supported on capabilities
 	regionIdx := slices.IndexFunc(regions, func(region linodego.Region) bool {
-		return slices.Contains(region.Capabilities, "Placement Group")
+		return region.HasCapabilities(linodego.CapabilityPlacementGroup)
 	})
 	require.NotZero(t, regionIdx)
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/linode/linodego"
 	. "github.com/linode/linodego"
+	"github.com/linode/linodego/filter"
 )
 
 type vpcModifier func(*linodego.Client, *linodego.VPCCreateOptions)
@@ -35,7 +36,7 @@ func createVPC(t *testing.T, client *linodego.Client, vpcModifier ...vpcModifier
 	t.Helper()
 	createOpts := linodego.VPCCreateOptions{
 		Label:  "go-test-vpc-" + getUniqueText(),
-		Region: getRegionsWithCaps(t, client, []string{"VPCs"})[0],
+		Region: getRegionsWithCaps(t, client, []Capability{CapabilityVPCs})[0],
 	}
 
 	for _, mod := range vpcModifier {
@@ -59,7 +60,7 @@ func createVPC_invalid_label(t *testing.T, client *linodego.Client) error {
 	t.Helper()
 	createOpts := linodego.VPCCreateOptions{
 		Label:  "gotest_vpc_invalid_label" + getUniqueText(),
-		Region: getRegionsWithCaps(t, client, []string{"VPCs"})[0],
+		Region: getRegionsWithCaps(t, client, []Capability{CapabilityVPCs})[0],
 	}
 	_, err := client.CreateVPC(context.Background(), createOpts)
 
@@ -238,7 +239,7 @@ func TestVPC_ListAllIPAddresses(t *testing.T) {
 
 	vpcIPs, err := client.ListAllVPCIPAddresses(
 		context.Background(),
-		linodego.NewListOptions(1, fmt.Sprintf("{\"linode_id\": %d}", instance.ID)),
+		linodego.NewListOptions(1, filter.And(filter.Eq("linode_id", instance.ID))),
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -265,7 +266,7 @@ func TestVPC_ListIPAddresses(t *testing.T) {
 	vpcIPs, err := client.ListVPCIPAddresses(
 		context.Background(),
 		vpc.ID,
-		linodego.NewListOptions(1, fmt.Sprintf("{\"linode_id\": %d}", instance.ID)),
+		linodego.NewListOptions(1, filter.And(filter.Eq("linode_id", instance.ID))),
 	)
 	if err != nil {
 		t.Fatal(err)
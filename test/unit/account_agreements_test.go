@@ -0,0 +1,46 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountAgreements_AcknowledgeEUModel(t *testing.T) {
+	client := createMockClient(t)
+
+	agreements := linodego.AccountAgreements{
+		BillingAgreement:       true,
+		MasterServiceAgreement: true,
+		PrivacyPolicy:          true,
+	}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/agreements"),
+		func(_ *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, agreements)
+		})
+
+	before, err := client.GetAccountAgreements(context.Background())
+	require.NoError(t, err)
+	require.False(t, before.EUModel)
+
+	opts := linodego.AccountAgreementsUpdateOptions{EUModel: linodego.Pointer(true)}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "account/agreements"),
+		func(req *http.Request) (*http.Response, error) {
+			agreements.EUModel = true
+
+			return mockRequestBodyValidate(t, opts, map[string]any{})(req)
+		})
+
+	require.NoError(t, client.AcknowledgeAccountAgreements(context.Background(), opts))
+
+	after, err := client.GetAccountAgreements(context.Background())
+	require.NoError(t, err)
+	require.True(t, after.EUModel)
+	require.True(t, after.PrivacyPolicy)
+}
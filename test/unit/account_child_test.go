@@ -3,13 +3,18 @@ package unit
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/linode/linodego/internal/testutil"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
 )
 
 var testChildAccount = linodego.ChildAccount{
@@ -101,3 +106,228 @@ func TestAccountChild_createToken(t *testing.T) {
 
 	require.True(t, reflect.DeepEqual(*token, desiredResponse))
 }
+
+func TestAccountChild_useChildAccountRefresh(t *testing.T) {
+	client := createMockClient(t)
+	client.SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond)
+
+	const requestsPerToken = 2
+
+	var (
+		issued       int
+		tokenUses    = map[string]int{}
+		unauthorized = linodego.APIError{Errors: []linodego.APIErrorReason{{Reason: "Invalid Token"}}}
+	)
+
+	httpmock.RegisterRegexpResponder(
+		"POST",
+		testutil.MockRequestURL(fmt.Sprintf("account/child-accounts/%s/token", testChildAccount.EUUID)),
+		func(_ *http.Request) (*http.Response, error) {
+			issued++
+			return httpmock.NewJsonResponse(200, linodego.ChildAccountToken{Token: fmt.Sprintf("token-%d", issued)})
+		},
+	)
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("profile"),
+		func(r *http.Request) (*http.Response, error) {
+			token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+			// The first token expires after a fixed number of requests
+			if token == "token-1" && tokenUses[token] >= requestsPerToken {
+				return httpmock.NewJsonResponse(401, unauthorized)
+			}
+
+			tokenUses[token]++
+			return httpmock.NewJsonResponse(200, linodego.Profile{Username: token})
+		},
+	)
+
+	child, err := client.UseChildAccount(context.Background(), testChildAccount.EUUID)
+	require.NoError(t, err)
+
+	var refreshed []string
+	child.OnChildAccountTokenRefresh(func(token *linodego.ChildAccountToken, err error) {
+		require.NoError(t, err)
+		refreshed = append(refreshed, token.Token)
+	})
+
+	for i := 0; i < requestsPerToken; i++ {
+		profile, err := child.GetProfile(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "token-1", profile.Username)
+	}
+
+	profile, err := child.GetProfile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "token-2", profile.Username)
+	require.Equal(t, []string{"token-2"}, refreshed)
+	require.Equal(t, 2, issued)
+}
+
+func TestAccountChild_useChildAccountRefreshFailed(t *testing.T) {
+	client := createMockClient(t)
+	client.SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond)
+
+	unauthorized := linodego.APIError{Errors: []linodego.APIErrorReason{{Reason: "Invalid Token"}}}
+	issued := 0
+
+	httpmock.RegisterRegexpResponder(
+		"POST",
+		testutil.MockRequestURL(fmt.Sprintf("account/child-accounts/%s/token", testChildAccount.EUUID)),
+		func(_ *http.Request) (*http.Response, error) {
+			issued++
+			if issued > 1 {
+				return httpmock.NewJsonResponse(403, linodego.APIError{
+					Errors: []linodego.APIErrorReason{{Reason: "Unauthorized"}},
+				})
+			}
+
+			return httpmock.NewJsonResponse(200, linodego.ChildAccountToken{Token: "token-1"})
+		},
+	)
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("profile"),
+		httpmock.NewJsonResponderOrPanic(401, unauthorized))
+
+	child, err := client.UseChildAccount(context.Background(), testChildAccount.EUUID)
+	require.NoError(t, err)
+
+	var refreshErr error
+	child.OnChildAccountTokenRefresh(func(_ *linodego.ChildAccountToken, err error) {
+		refreshErr = err
+	})
+
+	_, err = child.GetProfile(context.Background())
+	require.Error(t, err)
+	require.Error(t, refreshErr)
+
+	// Subsequent requests fail without attempting another refresh
+	_, err = child.GetProfile(context.Background())
+	require.ErrorContains(t, err, "failed to refresh child account token")
+	require.Equal(t, 2, issued)
+}
+
+func TestAccountChild_useChildAccountRefreshWaitCancelled(t *testing.T) {
+	client := createMockClient(t)
+	client.SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond)
+
+	var (
+		issued       atomic.Int32
+		refreshing   = make(chan struct{})
+		release      = make(chan struct{})
+		rejected     = make(chan struct{}, 2)
+		unauthorized = linodego.APIError{Errors: []linodego.APIErrorReason{{Reason: "Invalid Token"}}}
+	)
+
+	httpmock.RegisterRegexpResponder(
+		"POST",
+		testutil.MockRequestURL(fmt.Sprintf("account/child-accounts/%s/token", testChildAccount.EUUID)),
+		func(_ *http.Request) (*http.Response, error) {
+			n := issued.Add(1)
+
+			// The refresh does not complete until the waiting request has been cancelled
+			if n > 1 {
+				close(refreshing)
+				<-release
+			}
+
+			return httpmock.NewJsonResponse(200, linodego.ChildAccountToken{Token: fmt.Sprintf("token-%d", n)})
+		},
+	)
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("profile"),
+		func(r *http.Request) (*http.Response, error) {
+			token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if token == "token-1" {
+				rejected <- struct{}{}
+				return httpmock.NewJsonResponse(401, unauthorized)
+			}
+
+			return httpmock.NewJsonResponse(200, linodego.Profile{Username: token})
+		},
+	)
+
+	child, err := client.UseChildAccount(context.Background(), testChildAccount.EUUID)
+	require.NoError(t, err)
+
+	refreshed := make(chan error, 1)
+	go func() {
+		profile, err := child.GetProfile(context.Background())
+		if err == nil && profile.Username != "token-2" {
+			err = fmt.Errorf("unexpected username %q", profile.Username)
+		}
+		refreshed <- err
+	}()
+
+	<-refreshing
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan error, 1)
+	go func() {
+		_, err := child.GetProfile(ctx)
+		cancelled <- err
+	}()
+
+	<-rejected
+	<-rejected
+	cancel()
+
+	select {
+	case err := <-cancelled:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("cancelled request waited for another request's token refresh")
+	}
+
+	close(release)
+	require.NoError(t, <-refreshed)
+	require.EqualValues(t, 2, issued.Load())
+}
+
+func TestAccountChild_useChildAccountOAuth2Parent(t *testing.T) {
+	mock := httpmock.NewMockTransport()
+
+	// The parent authenticates using an oauth2 transport rather than SetToken
+	client := linodego.NewClient(&http.Client{
+		Timeout: time.Minute,
+		Transport: &oauth2.Transport{
+			Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "parent-token"}),
+			Base:   mock,
+		},
+	})
+
+	mock.RegisterRegexpResponder(
+		"POST",
+		testutil.MockRequestURL(fmt.Sprintf("account/child-accounts/%s/token", testChildAccount.EUUID)),
+		func(r *http.Request) (*http.Response, error) {
+			require.Equal(t, "Bearer parent-token", r.Header.Get("Authorization"))
+			return httpmock.NewJsonResponse(200, linodego.ChildAccountToken{Token: "child-token"})
+		},
+	)
+
+	mock.RegisterRegexpResponder(
+		"GET",
+		testutil.MockRequestURL("profile"),
+		func(r *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, linodego.Profile{
+				Username: strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "),
+			})
+		},
+	)
+
+	child, err := client.UseChildAccount(context.Background(), testChildAccount.EUUID)
+	require.NoError(t, err)
+
+	profile, err := child.GetProfile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "child-token", profile.Username)
+
+	// The parent continues to use its own token
+	profile, err = client.GetProfile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "parent-token", profile.Username)
+}
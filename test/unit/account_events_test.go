@@ -0,0 +1,104 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents_ListWithEventListOptions(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/events"),
+		func(req *http.Request) (*http.Response, error) {
+			var filter map[string]any
+			require.NoError(t, json.Unmarshal([]byte(req.Header.Get("X-Filter")), &filter))
+
+			require.Equal(t, map[string]any{
+				"+order_by":   "created",
+				"+order":      "desc",
+				"entity.type": "linode",
+				"entity.id":   float64(123),
+				"+or":         []any{map[string]any{"action": "linode_create"}, map[string]any{"action": "linode_boot"}},
+				"created":     map[string]any{"+gte": "2024-05-01T12:00:00"},
+			}, filter)
+
+			return httpmock.NewStringResponse(200, `{
+				"data": [{
+					"id": 456,
+					"action": "linode_create",
+					"status": "finished",
+					"duration": 32.5,
+					"message": "",
+					"read": false,
+					"entity": {"id": 123, "type": "linode", "label": "test"},
+					"secondary_entity": {"id": "linode/debian12", "type": "image", "label": "Debian 12"}
+				}],
+				"page": 1,
+				"pages": 1,
+				"results": 1
+			}`), nil
+		})
+
+	opts := linodego.EventListOptions{
+		EntityType: linodego.EntityLinode,
+		EntityID:   123,
+		Actions:    []linodego.EventAction{linodego.ActionLinodeCreate, linodego.ActionLinodeBoot},
+		Since:      time.Date(2024, 5, 1, 14, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+	}
+
+	events, err := client.ListEvents(context.Background(), opts.ListOptions(0))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, 32.5, events[0].Duration)
+	require.Equal(t, "linode/debian12", events[0].SecondaryEntity.ID)
+}
+
+func TestEvents_MarkReadAndSeenByID(t *testing.T) {
+	client := createMockClient(t)
+
+	read := false
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "account/events/456/read"),
+		func(_ *http.Request) (*http.Response, error) {
+			read = true
+			return httpmock.NewStringResponse(200, "{}"), nil
+		})
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "account/events/456/seen"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/events/456"),
+		func(_ *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, linodego.Event{ID: 456, Action: linodego.ActionLinodeCreate, Read: read})
+		})
+
+	require.NoError(t, client.MarkEventReadByID(context.Background(), 456))
+
+	event, err := client.GetEvent(context.Background(), 456)
+	require.NoError(t, err)
+	require.True(t, event.Read)
+
+	require.NoError(t, client.MarkEventsSeenByID(context.Background(), 456))
+}
+
+func TestEvents_MarkRead(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "account/events/456/read"),
+		httpmock.NewStringResponder(200, "{}"))
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "account/events/456/seen"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	event := &linodego.Event{ID: 456}
+
+	require.NoError(t, client.MarkEventRead(context.Background(), event))
+	require.NoError(t, client.MarkEventsSeen(context.Background(), event))
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
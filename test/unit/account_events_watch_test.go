@@ -0,0 +1,144 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents_Watch(t *testing.T) {
+	client := createMockClient(t)
+
+	linodeEvent := func(id, linodeID int) linodego.Event {
+		return linodego.Event{
+			ID:     id,
+			Action: linodego.ActionLinodeBoot,
+			Status: linodego.EventFinished,
+			Entity: &linodego.EventEntity{ID: linodeID, Type: linodego.EntityLinode},
+		}
+	}
+
+	page := func(page, pages int, events ...linodego.Event) (*http.Response, error) {
+		return httpmock.NewJsonResponse(200, map[string]any{
+			"data":    events,
+			"page":    page,
+			"pages":   pages,
+			"results": len(events),
+		})
+	}
+
+	// Each poll lists the events with IDs greater than the last one delivered
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/events"),
+		func(req *http.Request) (*http.Response, error) {
+			var filter struct {
+				ID       map[string]int `json:"id"`
+				EntityID int            `json:"entity.id"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(req.Header.Get("X-Filter")), &filter))
+
+			if filter.ID == nil {
+				// The first poll finds the newest event, which is not delivered
+				return page(1, 1, linodeEvent(10, 123))
+			}
+
+			require.Equal(t, 123, filter.EntityID)
+
+			switch filter.ID["+gt"] {
+			case 10:
+				// Event 11 is repeated as it shifts onto the second page while listing
+				if req.URL.Query().Get("page") == "2" {
+					return page(2, 2, linodeEvent(11, 123), linodeEvent(12, 123))
+				}
+
+				return page(1, 2, linodeEvent(11, 123))
+			case 12:
+				return page(1, 1, linodeEvent(13, 123), linodeEvent(14, 456))
+			default:
+				return page(1, 1)
+			}
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, errs := client.WatchEvents(ctx, linodego.EventWatchOptions{
+		EntityType:   linodego.EntityLinode,
+		EntityID:     123,
+		Actions:      []linodego.EventAction{linodego.ActionLinodeBoot},
+		PollInterval: time.Millisecond,
+	})
+
+	var ids []int
+
+	for len(ids) < 3 {
+		select {
+		case event := <-events:
+			ids = append(ids, event.ID)
+		case err := <-errs:
+			t.Fatal(err)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for events, got %v", ids)
+		}
+	}
+
+	require.Equal(t, []int{11, 12, 13}, ids)
+
+	cancel()
+
+	for range events {
+		t.Fatal("unexpected event after cancellation")
+	}
+
+	_, ok := <-errs
+	require.False(t, ok)
+}
+
+func TestEvents_WatchRequiresEntityType(t *testing.T) {
+	client := createMockClient(t)
+
+	events, errs := client.WatchEvents(context.Background(), linodego.EventWatchOptions{EntityID: 123})
+
+	require.ErrorContains(t, <-errs, "EntityType is required")
+
+	_, ok := <-events
+	require.False(t, ok)
+	require.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestEvents_WatchFirstPollPageSize(t *testing.T) {
+	client := createMockClient(t)
+
+	pageSizes := make(chan string, 1)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/events"),
+		func(req *http.Request) (*http.Response, error) {
+			select {
+			case pageSizes <- req.URL.Query().Get("page_size"):
+			default:
+			}
+
+			return httpmock.NewJsonResponse(200, map[string]any{"data": []any{}, "page": 1, "pages": 1, "results": 0})
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client.WatchEvents(ctx, linodego.EventWatchOptions{
+		EntityType:   linodego.EntityLinode,
+		PollInterval: time.Millisecond,
+	})
+
+	select {
+	case pageSize := <-pageSizes:
+		// 25 is the smallest page size accepted by the API
+		require.Equal(t, "25", pageSize)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the first poll")
+	}
+}
@@ -0,0 +1,92 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func mockPaginatedResponse(t *testing.T, path string, data any, results int) {
+	t.Helper()
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, path),
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"data":    data,
+			"page":    1,
+			"pages":   1,
+			"results": results,
+		}))
+}
+
+func TestAccount_GetInventory(t *testing.T) {
+	client := createMockClient(t)
+
+	mockPaginatedResponse(t, "linode/instances", []linodego.Instance{
+		{ID: 1, Region: "us-east"}, {ID: 2, Region: "us-east"}, {ID: 3, Region: "eu-west"},
+	}, 3)
+	mockPaginatedResponse(t, "volumes", []linodego.Volume{
+		{ID: 1, Region: "us-east", Size: 20}, {ID: 2, Region: "eu-west", Size: 100},
+	}, 2)
+	mockPaginatedResponse(t, "nodebalancers", []linodego.NodeBalancer{{ID: 1, Region: "us-east"}}, 1)
+	mockPaginatedResponse(t, "lke/clusters", []linodego.LKECluster{{ID: 1, Region: "eu-west"}}, 1)
+	mockPaginatedResponse(t, "networking/reserved/ips", []linodego.InstanceIP{
+		{Address: "192.0.2.1", Region: "us-east"}, {Address: "192.0.2.2", Region: "us-east"},
+	}, 2)
+
+	// Only the first page of these is requested; the totals come from the results count.
+	mockPaginatedResponse(t, "networking/firewalls", []linodego.Firewall{{ID: 1}}, 12)
+	mockPaginatedResponse(t, "domains", []linodego.Domain{{ID: 1}}, 40)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "images"),
+		func(req *http.Request) (*http.Response, error) {
+			require.JSONEq(t, `{"is_public": false}`, req.Header.Get("X-Filter"))
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data":    []linodego.Image{{ID: "private/1", Size: 1500}, {ID: "private/2", Size: 2500}},
+				"page":    1,
+				"pages":   1,
+				"results": 2,
+			})
+		})
+
+	inventory, err := client.GetAccountInventory(context.Background(), linodego.InventoryOptions{Concurrency: 2})
+	require.NoError(t, err)
+
+	require.Equal(t, linodego.InventoryCount{Total: 3, ByRegion: map[string]int{"us-east": 2, "eu-west": 1}}, inventory.Instances)
+	require.Equal(t, linodego.InventoryCount{Total: 2, ByRegion: map[string]int{"us-east": 1, "eu-west": 1}}, inventory.Volumes)
+	require.Equal(t, 120, inventory.VolumesSizeGB)
+	require.Equal(t, 1, inventory.NodeBalancers.ByRegion["us-east"])
+	require.Equal(t, 1, inventory.LKEClusters.ByRegion["eu-west"])
+	require.Equal(t, linodego.InventoryCount{Total: 2, ByRegion: map[string]int{"us-east": 2}}, inventory.ReservedIPs)
+	require.Equal(t, linodego.InventoryCount{Total: 12}, inventory.Firewalls)
+	require.Equal(t, linodego.InventoryCount{Total: 40}, inventory.Domains)
+	require.Equal(t, 2, inventory.Images.Total)
+	require.Equal(t, 4000, inventory.ImagesSizeMB)
+
+	b, err := json.Marshal(inventory)
+	require.NoError(t, err)
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	require.Equal(t, map[string]any{"total": float64(12)}, decoded["firewalls"])
+	require.Equal(t, float64(120), decoded["volumes_size_gb"])
+}
+
+func TestAccount_GetInventoryError(t *testing.T) {
+	client := createMockClient(t)
+
+	for _, path := range []string{"linode/instances", "volumes", "nodebalancers", "lke/clusters", "networking/reserved/ips", "networking/firewalls", "images"} {
+		mockPaginatedResponse(t, path, []any{}, 0)
+	}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "domains"),
+		httpmock.NewJsonResponderOrPanic(500, linodego.APIError{Errors: []linodego.APIErrorReason{{Reason: "oops"}}}))
+
+	_, err := client.GetAccountInventory(context.Background(), linodego.InventoryOptions{})
+	require.ErrorContains(t, err, "failed to count domains")
+}
@@ -0,0 +1,133 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+const promotionAccountJSON = `{
+	"balance_uninvoiced": %s,
+	"active_since": "2024-01-10T00:00:00",
+	"active_promotions": [
+		{
+			"credit_monthly_cap": "100.00",
+			"credit_remaining": "150.00",
+			"this_month_credit_remaining": "40.00",
+			"description": "Receive up to $100 off your services.",
+			"expire_dt": "%s",
+			"service_type": "all",
+			"summary": "$100 off your Linode"
+		},
+		{
+			"credit_monthly_cap": "50.00",
+			"credit_remaining": "50.00",
+			"this_month_credit_remaining": "50.00",
+			"expire_dt": "2024-09-30T23:59:59",
+			"service_type": "all",
+			"summary": "$50 off"
+		}
+	]
+}`
+
+const promotionInvoicesJSON = `{
+	"data": [
+		{"id": 3, "label": "Invoice", "date": "2024-06-01T00:00:00", "subtotal": 310, "total": 310},
+		{"id": 2, "label": "Invoice", "date": "2024-05-01T00:00:00", "subtotal": 250, "total": 250}
+	],
+	"page": 1,
+	"pages": 1,
+	"results": 2
+}`
+
+// The last invoice was paid for entirely by promotional credit
+const promotionCoveredInvoicesJSON = `{
+	"data": [
+		{"id": 3, "label": "Invoice", "date": "2024-06-01T00:00:00", "subtotal": 310, "total": 0},
+		{"id": 2, "label": "Invoice", "date": "2024-05-01T00:00:00", "subtotal": 250, "total": 0}
+	],
+	"page": 1,
+	"pages": 1,
+	"results": 2
+}`
+
+func mockPromotionAccount(t *testing.T, uninvoiced, expiry, invoices string) {
+	t.Helper()
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account$"),
+		httpmock.NewStringResponder(200, fmt.Sprintf(promotionAccountJSON, uninvoiced, expiry)))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/invoices"),
+		httpmock.NewStringResponder(200, invoices))
+}
+
+func TestAccount_ActivePromotions(t *testing.T) {
+	client := createMockClient(t)
+	mockPromotionAccount(t, "150", "2024-12-31T23:59:59", promotionInvoicesJSON)
+
+	account, err := client.GetAccount(context.Background())
+	require.NoError(t, err)
+	require.Len(t, account.ActivePromotions, 2)
+	require.Equal(t, "150.00", account.ActivePromotions[0].CreditRemaining)
+	require.Equal(t, "40.00", account.ActivePromotions[0].ThisMonthCreditRemaining)
+	require.Equal(t, time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC), *account.ActivePromotions[0].ExpireDT)
+}
+
+func TestAccount_PredictCreditExhaustion(t *testing.T) {
+	now := time.Date(2024, 6, 16, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		uninvoiced string
+		expiry     string
+		invoices   string
+		want       time.Time
+	}{
+		{
+			// $150 over the 15 days since the last invoice is $10 per day; $200 of credit lasts 20 days
+			"uninvoiced rate", "150", "2024-12-31T23:59:59", promotionInvoicesJSON,
+			time.Date(2024, 7, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// Nothing has accrued yet, so the last invoice's $310 over 31 days is used
+			"invoice rate", "0", "2024-12-31T23:59:59", promotionInvoicesJSON,
+			time.Date(2024, 7, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// Credit covered the whole last invoice, so its $310 subtotal is used rather than its $0 total
+			"invoice covered by credit", "0", "2024-12-31T23:59:59", promotionCoveredInvoicesJSON,
+			time.Date(2024, 7, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// At $1 per day the credit would last 200 days, but it lapses when the last promotion expires
+			"expiry", "15", "2024-12-31T23:59:59", promotionInvoicesJSON,
+			time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := createMockClient(t)
+			mockPromotionAccount(t, tt.uninvoiced, tt.expiry, tt.invoices)
+
+			got, err := client.PredictCreditExhaustion(context.Background(), now)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAccount_PredictCreditExhaustionNoPromotion(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account$"),
+		httpmock.NewStringResponder(200, `{"balance_uninvoiced": 12.5, "active_promotions": []}`))
+
+	_, err := client.PredictCreditExhaustion(context.Background(), time.Now())
+	require.ErrorIs(t, err, linodego.ErrNoActivePromotion)
+}
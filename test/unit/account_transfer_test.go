@@ -39,3 +39,41 @@ func TestAccount_getTransfer(t *testing.T) {
 		t.Fatalf("actual response does not equal desired response: %s", cmp.Diff(questions, desiredResponse))
 	}
 }
+
+func TestAccount_getTransferMultipleRegions(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "/account/transfer"),
+		httpmock.NewStringResponder(200, `{
+			"billable": 12,
+			"quota": 9000,
+			"used": 9012,
+			"region_transfers": [
+				{"id": "us-east", "billable": 0, "quota": 5000, "used": 2500},
+				{"id": "id-cgk", "billable": 12, "quota": 4000, "used": 4012},
+				{"id": "br-gru", "billable": 0, "quota": 0, "used": 2500}
+			]
+		}`))
+
+	transfer, err := client.GetAccountTransfer(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(transfer.RegionTransfers) != 3 {
+		t.Fatalf("expected 3 region transfers, got %d", len(transfer.RegionTransfers))
+	}
+
+	billable := 0
+	for _, region := range transfer.RegionTransfers {
+		billable += region.Billable
+	}
+
+	if billable != transfer.Billable {
+		t.Errorf("expected the regions to account for %d billable GB, got %d", transfer.Billable, billable)
+	}
+
+	if overage := transfer.RegionTransfers[1]; overage.ID != "id-cgk" || overage.Used <= overage.Quota {
+		t.Errorf("expected id-cgk to be over its quota, got %+v", overage)
+	}
+}
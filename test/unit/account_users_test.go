@@ -0,0 +1,39 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountUsers_CheckAuthorizedUsers(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/users/with-keys"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.User{Username: "with-keys", SSHKeys: []string{"laptop"}}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/users/no-keys"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.User{Username: "no-keys", SSHKeys: []string{}}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/users/missing"),
+		httpmock.NewJsonResponderOrPanic(404, linodego.APIError{Errors: []linodego.APIErrorReason{{Reason: "Not found"}}}))
+
+	warnings, err := client.CheckAuthorizedUsers(context.Background(), []string{"with-keys"})
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+
+	warnings, err = client.CheckAuthorizedUsers(context.Background(), []string{"with-keys", "no-keys", "missing"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"user no-keys has no SSH keys", "user missing does not exist"}, warnings)
+}
+
+func TestAccountUsers_CheckAuthorizedUsersError(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/users/someone"),
+		httpmock.NewJsonResponderOrPanic(403, linodego.APIError{Errors: []linodego.APIErrorReason{{Reason: "Unauthorized"}}}))
+
+	_, err := client.CheckAuthorizedUsers(context.Background(), []string{"someone"})
+	require.True(t, linodego.ErrHasStatus(err, 403))
+}
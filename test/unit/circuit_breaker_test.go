@@ -0,0 +1,210 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOutage serves an Instance, failing with a 503 while outage is set
+type fakeOutage struct {
+	mu     sync.Mutex
+	outage bool
+	calls  int
+}
+
+func (f *fakeOutage) setOutage(outage bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.outage = outage
+}
+
+func (f *fakeOutage) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls
+}
+
+func mockOutage(t *testing.T) *fakeOutage {
+	t.Helper()
+
+	f := &fakeOutage{outage: true}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		func(_ *http.Request) (*http.Response, error) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			f.calls++
+
+			if f.outage {
+				return httpmock.NewJsonResponse(http.StatusServiceUnavailable, linodego.APIError{
+					Errors: []linodego.APIErrorReason{{Reason: "Service Unavailable"}},
+				})
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, linodego.Instance{ID: 123})
+		})
+
+	return f
+}
+
+func createCircuitBreakerClient(t *testing.T, transitions *[]string) *linodego.Client {
+	t.Helper()
+
+	client := createMockClient(t)
+	client.SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond)
+
+	client.SetCircuitBreaker(linodego.CircuitBreakerOptions{
+		FailureThreshold: 3,
+		CoolDown:         50 * time.Millisecond,
+		OnStateChange: func(from, to linodego.CircuitBreakerState) {
+			*transitions = append(*transitions, string(from)+"->"+string(to))
+		},
+	})
+
+	return client
+}
+
+func TestCircuitBreaker_OutageAndRecovery(t *testing.T) {
+	var transitions []string
+
+	client := createCircuitBreakerClient(t, &transitions)
+	server := mockOutage(t)
+
+	// The request is retried until the circuit opens, stopping further retries
+	_, err := client.GetInstance(context.Background(), 123)
+	require.ErrorIs(t, err, linodego.ErrCircuitOpen)
+	require.Equal(t, 3, server.callCount())
+	require.Equal(t, []string{"closed->open"}, transitions)
+
+	// Requests fail fast while the circuit is open
+	_, err = client.GetInstance(context.Background(), 123)
+	require.ErrorIs(t, err, linodego.ErrCircuitOpen)
+	require.Equal(t, 3, server.callCount())
+
+	server.setOutage(false)
+	time.Sleep(60 * time.Millisecond)
+
+	// A successful probe closes the circuit
+	instance, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, 123, instance.ID)
+	require.Equal(t, 4, server.callCount())
+	require.Equal(t, []string{"closed->open", "open->half_open", "half_open->closed"}, transitions)
+}
+
+func TestCircuitBreaker_FailedProbeReopens(t *testing.T) {
+	var transitions []string
+
+	client := createCircuitBreakerClient(t, &transitions)
+	server := mockOutage(t)
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.ErrorIs(t, err, linodego.ErrCircuitOpen)
+
+	time.Sleep(60 * time.Millisecond)
+
+	_, err = client.GetInstance(context.Background(), 123)
+	require.ErrorIs(t, err, linodego.ErrCircuitOpen)
+	require.Equal(t, 4, server.callCount(), "only a single probe should be sent")
+	require.Equal(t, []string{"closed->open", "open->half_open", "half_open->open"}, transitions)
+}
+
+func TestCircuitBreaker_DisabledByDefault(t *testing.T) {
+	client := createMockClient(t)
+	client.SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond).SetRetryCount(5)
+
+	server := mockOutage(t)
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, linodego.ErrCircuitOpen)
+	require.Equal(t, 6, server.callCount())
+}
+
+func TestCircuitBreaker_WithoutRetries(t *testing.T) {
+	var transitions []string
+
+	client := createCircuitBreakerClient(t, &transitions)
+	client.SetRetryCount(0)
+
+	server := mockOutage(t)
+
+	// Each request fails once, as it is not retried
+	for i := 0; i < 3; i++ {
+		_, err := client.GetInstance(context.Background(), 123)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, linodego.ErrCircuitOpen)
+	}
+
+	require.Equal(t, []string{"closed->open"}, transitions)
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.ErrorIs(t, err, linodego.ErrCircuitOpen)
+	require.Equal(t, 3, server.callCount())
+}
+
+func TestCircuitBreaker_TransportErrorsWithoutRetries(t *testing.T) {
+	var transitions []string
+
+	client := createCircuitBreakerClient(t, &transitions)
+	client.SetRetryCount(0)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		httpmock.NewErrorResponder(syscall.ECONNRESET))
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetInstance(context.Background(), 123)
+		require.ErrorContains(t, err, "connection reset by peer")
+	}
+
+	require.Equal(t, []string{"closed->open"}, transitions)
+}
+
+func TestCircuitBreaker_NonRetryableServerErrors(t *testing.T) {
+	var transitions []string
+
+	client := createCircuitBreakerClient(t, &transitions)
+
+	// 500 responses are not retried, but count as failures
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		httpmock.NewJsonResponderOrPanic(http.StatusInternalServerError, linodego.APIError{
+			Errors: []linodego.APIErrorReason{{Reason: "Internal Server Error"}},
+		}))
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetInstance(context.Background(), 123)
+		require.Error(t, err)
+	}
+
+	require.Equal(t, []string{"closed->open"}, transitions)
+	require.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestCircuitBreaker_DisabledByZeroThreshold(t *testing.T) {
+	var transitions []string
+
+	client := createCircuitBreakerClient(t, &transitions)
+	client.SetRetryCount(5)
+
+	// A zero FailureThreshold disables the circuit breaker
+	client.SetCircuitBreaker(linodego.CircuitBreakerOptions{})
+
+	server := mockOutage(t)
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, linodego.ErrCircuitOpen)
+	require.Equal(t, 6, server.callCount())
+	require.Empty(t, transitions)
+}
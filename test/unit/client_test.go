@@ -9,6 +9,7 @@ import (
 
 	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClient_NGINXRetry(t *testing.T) {
@@ -72,3 +73,60 @@ func TestClient_GoAwayRetry(t *testing.T) {
 		t.Fatalf("retry checks did not finish")
 	}
 }
+
+func TestClient_StrictDecoding(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "nodebalancers/123/stats"),
+		httpmock.NewStringResponder(200, `{"title": "stats", "data": {"connections": [], "unexpected": true}}`))
+
+	stats, err := client.GetNodeBalancerStats(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, "stats", stats.Title)
+
+	client.SetStrictDecoding(true)
+
+	_, err = client.GetNodeBalancerStats(context.Background(), 123)
+	require.ErrorContains(t, err, `unknown field "unexpected"`)
+
+	// API errors should still be surfaced while decoding strictly
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "nodebalancers/456/stats"),
+		httpmock.NewJsonResponderOrPanic(400, map[string]any{
+			"errors": []map[string]any{{"reason": "Stats are unavailable at this time."}},
+			"extra":  1,
+		}))
+
+	_, err = client.GetNodeBalancerStats(context.Background(), 456)
+	require.True(t, linodego.ErrHasStatus(err, 400))
+	require.ErrorContains(t, err, "Stats are unavailable")
+
+	client.SetStrictDecoding(false)
+
+	_, err = client.GetNodeBalancerStats(context.Background(), 123)
+	require.NoError(t, err)
+}
+
+func TestClient_SetAPIVersionPath(t *testing.T) {
+	client := createMockClient(t)
+
+	client.SetAPIVersion("")
+	require.Equal(t, linodego.APIVersion, client.GetAPIVersion())
+
+	var paths []string
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "profile"),
+		func(req *http.Request) (*http.Response, error) {
+			paths = append(paths, req.URL.Path)
+			return httpmock.NewJsonResponse(200, linodego.Profile{})
+		})
+
+	_, err := client.GetProfile(context.Background())
+	require.NoError(t, err)
+
+	client.SetAPIVersion("v4beta")
+	require.Equal(t, "v4beta", client.GetAPIVersion())
+
+	_, err = client.GetProfile(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"/v4/profile", "/v4beta/profile"}, paths)
+}
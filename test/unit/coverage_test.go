@@ -0,0 +1,182 @@
+package unit
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/linode/linodego"
+)
+
+// coverageManifest maps each exported Client method to the unit tests and integration
+// fixtures that exercise it. It is maintained in testdata/coverage_manifest.json.
+//
+// Methods in Uncovered predate the manifest and have no direct coverage. New methods must
+// be added to Covered along with a unit test or fixture; remove a method from Uncovered
+// once coverage is added for it.
+type coverageManifest struct {
+	Covered   map[string]coverageEntry `json:"covered"`
+	Uncovered []string                 `json:"uncovered"`
+}
+
+type coverageEntry struct {
+	// Unit are the names of test functions in test/unit that call the method
+	Unit []string `json:"unit"`
+
+	// Fixtures are the names of recorded fixtures in test/integration/fixtures
+	// whose test calls the method
+	Fixtures []string `json:"fixtures"`
+}
+
+// testFunc is a top-level function of a test package
+type testFunc struct {
+	selectors map[string]bool
+	strings   map[string]bool
+}
+
+// TestClientMethodCoverage ensures every exported Client method is listed in the coverage
+// manifest, and that the tests and fixtures the manifest lists for it exist and call it.
+func TestClientMethodCoverage(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("testdata", "coverage_manifest.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var manifest coverageManifest
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		t.Fatal(err)
+	}
+
+	methods := make(map[string]bool)
+	clientType := reflect.TypeOf(&linodego.Client{})
+
+	for i := range clientType.NumMethod() {
+		methods[clientType.Method(i).Name] = true
+	}
+
+	unitFuncs := parseTestFuncs(t, ".")
+	integrationFuncs := parseTestFuncs(t, filepath.Join("..", "integration"))
+
+	var problems []string
+
+	for method := range methods {
+		_, covered := manifest.Covered[method]
+		uncovered := slices.Contains(manifest.Uncovered, method)
+
+		switch {
+		case !covered && !uncovered:
+			problems = append(problems, fmt.Sprintf("%s has no coverage listed in the manifest", method))
+		case covered && uncovered:
+			problems = append(problems, fmt.Sprintf("%s is listed as both covered and uncovered", method))
+		}
+	}
+
+	for _, method := range manifest.Uncovered {
+		if !methods[method] {
+			problems = append(problems, fmt.Sprintf("uncovered %s is not a Client method", method))
+		}
+	}
+
+	for method, entry := range manifest.Covered {
+		if !methods[method] {
+			problems = append(problems, fmt.Sprintf("covered %s is not a Client method", method))
+			continue
+		}
+
+		if len(entry.Unit) == 0 && len(entry.Fixtures) == 0 {
+			problems = append(problems, fmt.Sprintf("%s lists no unit tests or fixtures", method))
+		}
+
+		for _, name := range entry.Unit {
+			if f, ok := unitFuncs[name]; !ok || !f.selectors[method] {
+				problems = append(problems, fmt.Sprintf("%s: unit test %s does not exist or does not call it", method, name))
+			}
+		}
+
+		for _, name := range entry.Fixtures {
+			if !fixtureCalls(integrationFuncs, name, method) {
+				problems = append(problems, fmt.Sprintf("%s: fixture %s does not exist or its test does not call it", method, name))
+			}
+		}
+	}
+
+	sort.Strings(problems)
+
+	for _, p := range problems {
+		t.Error(p)
+	}
+}
+
+// fixtureCalls reports whether the named fixture has been recorded, and the test
+// recording it calls the given method
+func fixtureCalls(funcs map[string]testFunc, fixture, method string) bool {
+	if _, err := os.Stat(filepath.Join("..", "integration", "fixtures", fixture+".yaml")); err != nil {
+		return false
+	}
+
+	for _, f := range funcs {
+		if f.strings["fixtures/"+fixture] && f.selectors[method] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseTestFuncs returns the selectors and string literals used by each top-level
+// function of the test files in dir, keyed by function name
+func parseTestFuncs(t *testing.T, dir string) map[string]testFunc {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*_test.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	result := make(map[string]testFunc)
+
+	for _, file := range files {
+		parsed, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, decl := range parsed.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || fn.Body == nil {
+				continue
+			}
+
+			f := testFunc{selectors: make(map[string]bool), strings: make(map[string]bool)}
+
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.SelectorExpr:
+					f.selectors[n.Sel.Name] = true
+				case *ast.BasicLit:
+					if n.Kind == token.STRING {
+						if s, err := strconv.Unquote(n.Value); err == nil {
+							f.strings[s] = true
+						}
+					}
+				}
+
+				return true
+			})
+
+			result[fn.Name.Name] = f
+		}
+	}
+
+	return result
+}
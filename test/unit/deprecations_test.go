@@ -0,0 +1,63 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecations_SunsetOnGetInstance(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123}).HeaderSet(http.Header{
+			"Deprecation": {"@1704067200"},
+			"Sunset":      {"Wed, 01 Jan 2025 00:00:00 GMT"},
+			"Warning":     {`299 - "This endpoint is deprecated"`},
+		}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "profile"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Profile{}))
+
+	var notices []linodego.DeprecationNotice
+	client.OnDeprecation(func(notice linodego.DeprecationNotice) {
+		notices = append(notices, notice)
+	})
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+
+	_, err = client.GetProfile(context.Background())
+	require.NoError(t, err)
+
+	expected := linodego.DeprecationNotice{
+		Endpoint:     "GET /linode/instances/{id}",
+		DeprecatedAt: linodego.Pointer(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		Sunset:       linodego.Pointer(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		Warnings:     []string{`299 - "This endpoint is deprecated"`},
+	}
+
+	require.Equal(t, []linodego.DeprecationNotice{expected}, notices)
+	require.Equal(t, []linodego.DeprecationNotice{expected}, client.DeprecationsSeen())
+}
+
+func TestDeprecations_WithoutDates(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123}).HeaderSet(http.Header{
+			"Deprecation": {"true"},
+			"Sunset":      {"soon"},
+		}))
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+
+	require.Equal(t, []linodego.DeprecationNotice{
+		{Endpoint: "GET /linode/instances/{id}"},
+	}, client.DeprecationsSeen())
+}
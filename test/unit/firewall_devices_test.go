@@ -0,0 +1,66 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirewallDevices_AttachToEntities(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "networking/firewalls/123/devices"),
+		func(request *http.Request) (*http.Response, error) {
+			data, err := io.ReadAll(request.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var opts linodego.FirewallDeviceCreateOptions
+			if err := json.Unmarshal(data, &opts); err != nil {
+				t.Fatal(err)
+			}
+
+			if opts.ID == 3 {
+				return httpmock.NewJsonResponse(400, linodego.APIError{
+					Errors: []linodego.APIErrorReason{{Reason: "Linode already has a firewall"}},
+				})
+			}
+
+			return httpmock.NewJsonResponse(200, linodego.FirewallDevice{
+				ID: opts.ID * 10,
+				Entity: linodego.FirewallDeviceEntity{
+					ID:   opts.ID,
+					Type: opts.Type,
+				},
+			})
+		})
+
+	results, err := client.AttachFirewallToEntities(context.Background(), 123, []linodego.FirewallDeviceCreateOptions{
+		{ID: 1, Type: linodego.FirewallDeviceLinode},
+		{ID: 2, Type: linodego.FirewallDeviceLinode},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	for i, r := range results {
+		require.NoError(t, r.Err)
+		require.Equal(t, i+1, r.Device.Entity.ID)
+		require.Equal(t, linodego.FirewallDeviceLinode, r.Device.Entity.Type)
+	}
+
+	results, err = client.AttachFirewallToEntities(context.Background(), 123, []linodego.FirewallDeviceCreateOptions{
+		{ID: 1, Type: linodego.FirewallDeviceLinode},
+		{ID: 3, Type: linodego.FirewallDeviceLinode},
+	})
+	require.Error(t, err)
+	require.NoError(t, results[0].Err)
+	require.Error(t, results[1].Err)
+	require.Nil(t, results[1].Device)
+}
@@ -0,0 +1,82 @@
+package unit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirewallRuleVersions_List(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "networking/firewalls/123/history"),
+		httpmock.NewStringResponder(200, `{
+			"data": [
+				{"updated": "2025-01-01T10:00:00", "status": "enabled", "rules": {"version": 1}},
+				{"updated": "2025-01-02T10:00:00", "status": "enabled", "rules": {"version": 2}}
+			],
+			"page": 1,
+			"pages": 1,
+			"results": 2
+		}`))
+
+	versions, err := client.ListFirewallRuleVersions(context.Background(), 123, nil)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, 2, versions[1].Version)
+	require.Equal(t, linodego.FirewallEnabled, versions[1].Status)
+	require.Equal(t, time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC), *versions[1].Updated)
+}
+
+func TestFirewallRuleVersions_Revert(t *testing.T) {
+	client := createMockClient(t)
+
+	rules := linodego.FirewallRuleSet{
+		Inbound: []linodego.FirewallRule{{
+			Action:    "ACCEPT",
+			Label:     "ssh",
+			Ports:     "22",
+			Protocol:  linodego.TCP,
+			Addresses: linodego.NetworkAddresses{IPv4: &[]string{"192.0.2.0/24"}},
+		}},
+		InboundPolicy:  "DROP",
+		Outbound:       []linodego.FirewallRule{},
+		OutboundPolicy: "ACCEPT",
+	}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "networking/firewalls/123/history/rules/1"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.FirewallRuleVersion{
+			FirewallRuleSet: rules,
+			Version:         1,
+			Fingerprint:     "4ef67a05",
+		}))
+
+	version, err := client.GetFirewallRuleVersion(context.Background(), 123, 1)
+	require.NoError(t, err)
+	require.Equal(t, "4ef67a05", version.Fingerprint)
+	require.Equal(t, rules, version.FirewallRuleSet)
+
+	// The old rules are reapplied without their version and fingerprint
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "networking/firewalls/123/rules"),
+		mockRequestBodyValidate(t, rules, rules))
+
+	result, err := client.RevertFirewallRules(context.Background(), 123, 1)
+	require.NoError(t, err)
+	require.Equal(t, rules, *result)
+}
+
+func TestFirewallRuleVersions_RevertMissingVersion(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "networking/firewalls/123/history/rules/99"),
+		httpmock.NewStringResponder(404, `{"errors": [{"reason": "Not found"}]}`))
+
+	_, err := client.RevertFirewallRules(context.Background(), 123, 99)
+	require.ErrorContains(t, err, "firewall 123 has no rule version 99")
+	require.True(t, linodego.IsNotFound(err))
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
@@ -2,7 +2,9 @@ package unit
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
@@ -59,3 +61,57 @@ func TestImage_Replicate(t *testing.T) {
 	require.EqualValues(t, "us-ord", image.Regions[2].Region)
 	require.EqualValues(t, linodego.ImageRegionStatusPendingReplication, image.Regions[2].Status)
 }
+
+func mockAutomaticImages(t *testing.T) {
+	t.Helper()
+
+	old := time.Now().AddDate(0, 0, -30).UTC().Format("2006-01-02T15:04:05")
+	recent := time.Now().Add(-time.Hour).UTC().Format("2006-01-02T15:04:05")
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "images"),
+		func(req *http.Request) (*http.Response, error) {
+			require.JSONEq(t, `{"type": "automatic"}`, req.Header.Get("X-Filter"))
+
+			// The API is filtered on type, but manual images are included to check they are never deleted
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data": []map[string]any{
+					{"id": "private/1", "type": "automatic", "created": old},
+					{"id": "private/2", "type": "automatic", "created": recent},
+					{"id": "private/3", "type": "manual", "created": old},
+					{
+						"id": "private/4", "type": "automatic", "created": old,
+						"regions": []map[string]any{{"region": "us-east", "status": "replicating"}},
+					},
+				},
+				"page":    1,
+				"pages":   1,
+				"results": 4,
+			})
+		})
+}
+
+func TestImage_DeleteExpiredAutomatic(t *testing.T) {
+	client := createMockClient(t)
+
+	mockAutomaticImages(t)
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "images/private%2F1"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	deleted, err := client.DeleteExpiredAutomaticImages(context.Background(), 7*24*time.Hour, false)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	require.Equal(t, "private/1", deleted[0].ID)
+	require.Equal(t, 1, httpmock.GetCallCountInfo()["DELETE =~"+mockRequestURL(t, "images/private%2F1").String()])
+}
+
+func TestImage_DeleteExpiredAutomaticDryRun(t *testing.T) {
+	client := createMockClient(t)
+
+	mockAutomaticImages(t)
+
+	deleted, err := client.DeleteExpiredAutomaticImages(context.Background(), 7*24*time.Hour, true)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	require.Equal(t, "private/1", deleted[0].ID)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,367 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRebindInstances registers the source Linode 100 in us-east and the target Linode 200 in targetRegion
+func mockRebindInstances(t *testing.T, targetRegion string) {
+	t.Helper()
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/100$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 100, Region: "us-east"}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/200$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 200, Region: targetRegion}))
+}
+
+func TestInstanceConfigInterface_RebindIPv6Range(t *testing.T) {
+	client := createMockClient(t)
+
+	vpcID, subnetID := 1, 2
+	isPublic := false
+
+	source := linodego.InstanceConfigInterface{
+		ID:       1,
+		Purpose:  linodego.InterfacePurposeVPC,
+		VPCID:    &vpcID,
+		SubnetID: &subnetID,
+		IPv6: &linodego.InstanceConfigInterfaceIPv6{
+			SLAAC:  []linodego.InstanceConfigInterfaceIPv6SLAAC{{Range: "2600:3c03:e000:1::/64", Address: "2600:3c03:e000:1::2"}},
+			Ranges: []linodego.InstanceConfigInterfaceIPv6Range{{Range: "2600:3c03:e000:5::/64"}},
+		},
+	}
+
+	target := linodego.InstanceConfigInterface{
+		ID:       2,
+		Purpose:  linodego.InterfacePurposeVPC,
+		VPCID:    &vpcID,
+		SubnetID: &subnetID,
+		IPv6: &linodego.InstanceConfigInterfaceIPv6{
+			SLAAC: []linodego.InstanceConfigInterfaceIPv6SLAAC{{Range: "2600:3c03:e000:2::/64", Address: "2600:3c03:e000:2::2"}},
+		},
+	}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/100/configs/10/interfaces/1"),
+		httpmock.NewJsonResponderOrPanic(200, source))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/200/configs/20/interfaces/2"),
+		httpmock.NewJsonResponderOrPanic(200, target))
+
+	mockRebindInstances(t, "us-east")
+
+	sourceUpdate := linodego.InstanceConfigInterfaceUpdateOptions{
+		IPv6: &linodego.InstanceConfigInterfaceIPv6Options{
+			SLAAC:    &[]linodego.InstanceConfigInterfaceIPv6SLAACOptions{{Range: "2600:3c03:e000:1::/64"}},
+			Ranges:   &[]linodego.InstanceConfigInterfaceIPv6RangeOptions{},
+			IsPublic: &isPublic,
+		},
+	}
+
+	source.IPv6.Ranges = []linodego.InstanceConfigInterfaceIPv6Range{}
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/100/configs/10/interfaces/1"),
+		mockRequestBodyValidate(t, sourceUpdate, source))
+
+	targetUpdate := linodego.InstanceConfigInterfaceUpdateOptions{
+		IPv6: &linodego.InstanceConfigInterfaceIPv6Options{
+			SLAAC:    &[]linodego.InstanceConfigInterfaceIPv6SLAACOptions{{Range: "2600:3c03:e000:2::/64"}},
+			Ranges:   &[]linodego.InstanceConfigInterfaceIPv6RangeOptions{{Range: "2600:3c03:e000:5::/64"}},
+			IsPublic: &isPublic,
+		},
+	}
+
+	target.IPv6.Ranges = []linodego.InstanceConfigInterfaceIPv6Range{{Range: "2600:3c03:e000:5::/64"}}
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/200/configs/20/interfaces/2"),
+		mockRequestBodyValidate(t, targetUpdate, target))
+
+	result, err := client.RebindInstanceConfigInterfaceIPv6Range(
+		context.Background(),
+		linodego.InstanceConfigInterfaceIPv6RangeBinding{
+			Range:       "2600:3c03:e000:5::/64",
+			LinodeID:    100,
+			ConfigID:    10,
+			InterfaceID: 1,
+		},
+		200, 20, 2,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "2600:3c03:e000:5::/64", result.IPv6.Ranges[0].Range)
+}
+
+func TestInstanceConfigInterface_RebindIPv6RangeRollback(t *testing.T) {
+	vpcID, subnetID := 1, 2
+
+	source := linodego.InstanceConfigInterface{
+		ID:       1,
+		Purpose:  linodego.InterfacePurposeVPC,
+		VPCID:    &vpcID,
+		SubnetID: &subnetID,
+		IPv6: &linodego.InstanceConfigInterfaceIPv6{
+			Ranges: []linodego.InstanceConfigInterfaceIPv6Range{
+				{Range: "2600:3c03:e000:4::/64"},
+				{Range: "2600:3c03:e000:5::/64"},
+			},
+		},
+	}
+
+	target := linodego.InstanceConfigInterface{
+		ID:       2,
+		Purpose:  linodego.InterfacePurposeVPC,
+		VPCID:    &vpcID,
+		SubnetID: &subnetID,
+	}
+
+	from := linodego.InstanceConfigInterfaceIPv6RangeBinding{
+		Range:       "2600:3c03:e000:5::/64",
+		LinodeID:    100,
+		ConfigID:    10,
+		InterfaceID: 1,
+	}
+
+	for _, tc := range []struct {
+		name          string
+		rollbackFails bool
+	}{
+		{"restored", false},
+		{"restore failed", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := createMockClient(t)
+
+			httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/100/configs/10/interfaces/1"),
+				httpmock.NewJsonResponderOrPanic(200, source))
+			httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/200/configs/20/interfaces/2"),
+				httpmock.NewJsonResponderOrPanic(200, target))
+
+			mockRebindInstances(t, "us-east")
+
+			// The ranges of each update of the source interface
+			var sourceRanges [][]linodego.InstanceConfigInterfaceIPv6RangeOptions
+
+			httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/100/configs/10/interfaces/1"),
+				func(req *http.Request) (*http.Response, error) {
+					var opts linodego.InstanceConfigInterfaceUpdateOptions
+					require.NoError(t, json.NewDecoder(req.Body).Decode(&opts))
+					sourceRanges = append(sourceRanges, *opts.IPv6.Ranges)
+
+					if tc.rollbackFails && len(sourceRanges) > 1 {
+						return httpmock.NewJsonResponse(400, linodego.APIError{
+							Errors: []linodego.APIErrorReason{{Reason: "Range is already routed"}},
+						})
+					}
+
+					return httpmock.NewJsonResponse(200, source)
+				})
+
+			httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/200/configs/20/interfaces/2"),
+				httpmock.NewJsonResponderOrPanic(400, linodego.APIError{
+					Errors: []linodego.APIErrorReason{{Reason: "Interface does not support IPv6"}},
+				}))
+
+			_, err := client.RebindInstanceConfigInterfaceIPv6Range(context.Background(), from, 200, 20, 2)
+			require.ErrorContains(t, err, "Interface does not support IPv6")
+
+			// The range is removed from the source, then restored after the target update fails
+			require.Equal(t, [][]linodego.InstanceConfigInterfaceIPv6RangeOptions{
+				{{Range: "2600:3c03:e000:4::/64"}},
+				{{Range: "2600:3c03:e000:4::/64"}, {Range: "2600:3c03:e000:5::/64"}},
+			}, sourceRanges)
+
+			if tc.rollbackFails {
+				require.ErrorContains(t, err, "failed to restore IPv6 range 2600:3c03:e000:5::/64 to interface 1")
+				require.ErrorContains(t, err, "Range is already routed")
+			}
+		})
+	}
+}
+
+func TestInstanceConfigInterface_RebindIPv6RangeValidation(t *testing.T) {
+	vpcID, subnetID := 1, 2
+
+	source := linodego.InstanceConfigInterface{
+		ID:       1,
+		Purpose:  linodego.InterfacePurposeVPC,
+		VPCID:    &vpcID,
+		SubnetID: &subnetID,
+		IPv6: &linodego.InstanceConfigInterfaceIPv6{
+			Ranges: []linodego.InstanceConfigInterfaceIPv6Range{{Range: "2600:3c03:e000:5::/64"}},
+		},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		targetPurpose linodego.ConfigInterfacePurpose
+		targetRegion  string
+		err           string
+	}{
+		{
+			"different regions", linodego.InterfacePurposeVPC, "us-west",
+			"instance 100 in us-east and instance 200 in us-west must be in the same region",
+		},
+		{
+			"target not a VPC interface", linodego.InterfacePurposePublic, "us-east",
+			"interface 2 is not a VPC interface and cannot have IPv6 ranges",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := createMockClient(t)
+
+			httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/100/configs/10/interfaces/1"),
+				httpmock.NewJsonResponderOrPanic(200, source))
+			httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/200/configs/20/interfaces/2"),
+				httpmock.NewJsonResponderOrPanic(200, linodego.InstanceConfigInterface{ID: 2, Purpose: tc.targetPurpose}))
+
+			mockRebindInstances(t, tc.targetRegion)
+
+			_, err := client.RebindInstanceConfigInterfaceIPv6Range(
+				context.Background(),
+				linodego.InstanceConfigInterfaceIPv6RangeBinding{
+					Range:       "2600:3c03:e000:5::/64",
+					LinodeID:    100,
+					ConfigID:    10,
+					InterfaceID: 1,
+				},
+				200, 20, 2,
+			)
+			require.EqualError(t, err, tc.err)
+
+			// The range is not removed from the source interface
+			for call := range httpmock.GetCallCountInfo() {
+				require.NotContains(t, call, "PUT")
+			}
+		})
+	}
+}
+
+func TestInstanceConfigInterface_ListIPv6Ranges(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/100$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 100, Region: "us-east"}))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/100/configs"),
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"data": []linodego.InstanceConfig{
+				{
+					ID: 10,
+					Interfaces: []linodego.InstanceConfigInterface{
+						{ID: 1, Purpose: linodego.InterfacePurposePublic},
+						{
+							ID:      2,
+							Purpose: linodego.InterfacePurposeVPC,
+							IPv6: &linodego.InstanceConfigInterfaceIPv6{
+								Ranges: []linodego.InstanceConfigInterfaceIPv6Range{{Range: "2600:3c03:e000:5::/64"}},
+							},
+						},
+					},
+				},
+			},
+			"page":    1,
+			"pages":   1,
+			"results": 1,
+		}))
+
+	ranges, err := client.ListInstanceInterfaceIPv6Ranges(context.Background(), 100)
+	require.NoError(t, err)
+	require.Equal(t, []linodego.IPv6Range{{
+		Range:  "2600:3c03:e000:5::",
+		Prefix: 64,
+		Region: "us-east",
+		Interface: &linodego.InstanceConfigInterfaceIPv6RangeBinding{
+			Range: "2600:3c03:e000:5::/64", LinodeID: 100, ConfigID: 10, InterfaceID: 2,
+		},
+	}}, ranges)
+}
+
+func TestInstanceConfigInterface_SetOrder(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/100/configs/10"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceConfig{
+			ID:         10,
+			Interfaces: []linodego.InstanceConfigInterface{{ID: 1}, {ID: 2}, {ID: 3}},
+		}))
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/100/configs/10/interfaces/order"),
+		mockRequestBodyValidate(t, linodego.InstanceConfigInterfacesReorderOptions{IDs: []int{2, 1, 3}}, map[string]any{}))
+
+	require.NoError(t, client.SetInstanceConfigInterfaceOrder(context.Background(), 100, 10, []int{2, 1, 3}))
+}
+
+func TestInstanceConfigInterface_ListByPosition(t *testing.T) {
+	client := createMockClient(t)
+
+	// Positions are independent of the interface IDs
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/100/configs/10"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceConfig{
+			ID: 10,
+			Interfaces: []linodego.InstanceConfigInterface{
+				{ID: 3, Purpose: linodego.InterfacePurposePublic},
+				{ID: 1, Purpose: linodego.InterfacePurposeVLAN, Label: "backend"},
+				{ID: 2, Purpose: linodego.InterfacePurposeVPC},
+			},
+		}))
+
+	interfaces, err := client.ListInstanceConfigInterfacesByPosition(context.Background(), 100, 10)
+	require.NoError(t, err)
+	require.Len(t, interfaces, 3)
+
+	// eth0, eth1 and eth2
+	require.Equal(t, []int{3, 1, 2}, []int{interfaces[0].ID, interfaces[1].ID, interfaces[2].ID})
+	require.Equal(t, "backend", interfaces[1].Label)
+}
+
+func TestInstanceConfigInterface_SetOrderMismatch(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/100/configs/10"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceConfig{
+			ID:         10,
+			Interfaces: []linodego.InstanceConfigInterface{{ID: 1}, {ID: 2}, {ID: 3}},
+		}))
+
+	err := client.SetInstanceConfigInterfaceOrder(context.Background(), 100, 10, []int{2, 4, 2})
+	require.EqualError(t, err, "interface IDs must match those of config 10 [1 2 3]: missing [1 3], unexpected [4], duplicate [2]")
+	require.Equal(t, 1, httpmock.GetTotalCallCount(), "expected the reorder to be rejected locally")
+}
+
+func TestInstanceConfigInterface_VPCOptions(t *testing.T) {
+	opts, err := linodego.NewVPCInterfaceOptions(789).
+		Primary(true).
+		Address("10.0.0.2").
+		NAT1To1Any().
+		IPRanges("10.0.0.64/28").
+		Build()
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceConfigInterfaceCreateOptions{
+		Purpose:  linodego.InterfacePurposeVPC,
+		Primary:  true,
+		SubnetID: linodego.Pointer(789),
+		IPv4: &linodego.VPCIPv4{
+			VPC:     "10.0.0.2",
+			NAT1To1: linodego.Pointer("any"),
+		},
+		IPRanges: []string{"10.0.0.64/28"},
+	}, opts)
+
+	opts, err = linodego.NewVPCInterfaceOptions(789).Build()
+	require.NoError(t, err)
+	require.Nil(t, opts.IPv4)
+
+	_, err = linodego.NewVPCInterfaceOptions(789).NAT1To1("203.0.113.5").Build()
+	require.ErrorContains(t, err, "NAT 1:1 requires a VPC IPv4 address")
+
+	_, err = linodego.NewVPCInterfaceOptions(789).Address("10.0.0.2").NAT1To1("anywhere").Build()
+	require.ErrorContains(t, err, `invalid NAT 1:1 address "anywhere"`)
+
+	_, err = linodego.NewVPCInterfaceOptions(789).IPRanges("2001:db8::/64").Build()
+	require.ErrorContains(t, err, `invalid IPv4 range "2001:db8::/64"`)
+}
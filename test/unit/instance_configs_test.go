@@ -0,0 +1,308 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceConfig_SwapRootDisk(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/disks/2"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceDisk{
+			ID: 2, Status: linodego.DiskReady, Filesystem: linodego.FilesystemExt4,
+		}))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/configs/456"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceConfig{
+			ID:          456,
+			Label:       "blue-green",
+			Comments:    "provisioned-by: pipeline/1234",
+			MemoryLimit: 2048,
+			InitRD:      linodego.Pointer(4),
+			Kernel:      "linode/grub2",
+			RootDevice:  "/dev/sda",
+			Interfaces: []linodego.InstanceConfigInterface{
+				{ID: 7, Purpose: linodego.InterfacePurposePublic, Primary: true},
+			},
+			Devices: &linodego.InstanceConfigDeviceMap{
+				SDA: &linodego.InstanceConfigDevice{DiskID: 1},
+				SDB: &linodego.InstanceConfigDevice{DiskID: 2},
+				SDC: &linodego.InstanceConfigDevice{DiskID: 3},
+			},
+		}))
+
+	swapped := linodego.InstanceConfigDeviceMap{
+		SDA: &linodego.InstanceConfigDevice{DiskID: 2},
+		SDB: &linodego.InstanceConfigDevice{DiskID: 1},
+		SDC: &linodego.InstanceConfigDevice{DiskID: 3},
+	}
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123/configs/456"),
+		// Only the devices change; the rest of the config is preserved
+		mockRequestBodyValidate(t, linodego.InstanceConfigUpdateOptions{
+			Label:       "blue-green",
+			Comments:    "provisioned-by: pipeline/1234",
+			MemoryLimit: 2048,
+			InitRD:      linodego.Pointer(4),
+			Kernel:      "linode/grub2",
+			RootDevice:  "/dev/sda",
+			Interfaces: []linodego.InstanceConfigInterfaceCreateOptions{
+				{Purpose: linodego.InterfacePurposePublic, Primary: true},
+			},
+			Devices: &swapped,
+		}, linodego.InstanceConfig{
+			ID:         456,
+			RootDevice: "/dev/sda",
+			Devices:    &swapped,
+		}))
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/reboot"),
+		mockRequestBodyValidate(t, map[string]int{"config_id": 456}, map[string]any{}))
+
+	config, err := client.SwapInstanceConfigRootDisk(context.Background(), 123, 456, 2, true)
+	require.NoError(t, err)
+	require.Equal(t, "/dev/sda", config.RootDevice)
+	require.Equal(t, 2, config.Devices.SDA.DiskID)
+	require.Equal(t, 1, config.Devices.SDB.DiskID)
+	require.Equal(t, 1, httpmock.GetCallCountInfo()["POST =~"+mockRequestURL(t, "linode/instances/123/reboot").String()])
+}
+
+func TestInstanceConfig_SwapRootDiskNotBootable(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/disks/3"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceDisk{
+			ID: 3, Status: linodego.DiskReady, Filesystem: linodego.FilesystemSwap,
+		}))
+
+	_, err := client.SwapInstanceConfigRootDisk(context.Background(), 123, 456, 3, false)
+	require.ErrorContains(t, err, "not bootable")
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestInstanceConfig_Comments(t *testing.T) {
+	client := createMockClient(t)
+
+	const comments = "provisioned-by: pipeline/1234"
+
+	createOpts := linodego.InstanceConfigCreateOptions{
+		Label:    "annotated",
+		Comments: comments,
+		Devices: linodego.InstanceConfigDeviceMap{
+			SDA: &linodego.InstanceConfigDevice{DiskID: 1},
+		},
+	}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/configs"),
+		mockRequestBodyValidate(t, createOpts, linodego.InstanceConfig{
+			ID:       456,
+			Label:    "annotated",
+			Comments: comments,
+			Devices:  &createOpts.Devices,
+		}))
+
+	config, err := client.CreateInstanceConfig(context.Background(), 123, createOpts)
+	require.NoError(t, err)
+	require.Equal(t, comments, config.Comments)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/configs/456"),
+		httpmock.NewJsonResponderOrPanic(200, config))
+
+	config, err = client.GetInstanceConfig(context.Background(), 123, 456)
+	require.NoError(t, err)
+	require.Equal(t, comments, config.Comments)
+	require.Equal(t, comments, config.GetCreateOptions().Comments)
+
+	// Comments are always sent on update so that they can be cleared
+	updateOpts := config.GetUpdateOptions()
+	updateOpts.Comments = ""
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123/configs/456"),
+		func(req *http.Request) (*http.Response, error) {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			require.Contains(t, body, "comments")
+			require.Equal(t, "", body["comments"])
+
+			return httpmock.NewJsonResponse(200, linodego.InstanceConfig{ID: 456})
+		})
+
+	config, err = client.UpdateInstanceConfig(context.Background(), 123, 456, updateOpts)
+	require.NoError(t, err)
+	require.Empty(t, config.Comments)
+}
+
+func TestInstanceConfig_UpdateHelpers(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123/configs/456"),
+		func(req *http.Request) (*http.Response, error) {
+			var body map[string]map[string]any
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+
+			// Only the helper being changed is sent so the others keep their server value
+			require.Equal(t, map[string]map[string]any{"helpers": {"network": false}}, body)
+
+			return httpmock.NewJsonResponse(200, linodego.InstanceConfig{
+				ID: 456,
+				Helpers: &linodego.InstanceConfigHelpers{
+					Distro:     true,
+					ModulesDep: true,
+					Network:    false,
+				},
+			})
+		})
+
+	config, err := client.UpdateInstanceConfigHelpers(context.Background(), 123, 456, linodego.InstanceConfigHelpersOptions{
+		Network: linodego.Pointer(false),
+	})
+	require.NoError(t, err)
+	require.True(t, config.Helpers.Distro)
+	require.False(t, config.Helpers.Network)
+
+	// Helpers round-trip through the update options with every value set
+	helpers := config.GetUpdateOptions().Helpers
+	require.NotNil(t, helpers)
+	require.Equal(t, true, *helpers.Distro)
+	require.Equal(t, false, *helpers.Network)
+	require.Equal(t, false, *helpers.UpdateDBDisabled)
+}
+
+// mockVPCMigrationConfigs serves the configs of instance 123, returning each of the given
+// versions of config 456 in turn
+func mockVPCMigrationConfigs(t *testing.T, others []linodego.InstanceConfig, versions ...linodego.InstanceConfig) {
+	t.Helper()
+
+	calls := 0
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/configs"),
+		func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/configs/456") {
+				config := versions[min(calls, len(versions)-1)]
+				calls++
+
+				return httpmock.NewJsonResponse(200, config)
+			}
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data":    append([]linodego.InstanceConfig{versions[0]}, others...),
+				"page":    1,
+				"pages":   1,
+				"results": len(others) + 1,
+			})
+		})
+}
+
+func TestInstanceConfig_MigrateToVPC(t *testing.T) {
+	client := createMockClient(t)
+
+	subnetID, vpcID := 7, 3
+	nat := "203.0.113.5"
+
+	original := linodego.InstanceConfig{
+		ID:    456,
+		Label: "boot",
+		Interfaces: []linodego.InstanceConfigInterface{
+			{ID: 1, Purpose: linodego.InterfacePurposePublic, Primary: true},
+			{ID: 2, Purpose: linodego.InterfacePurposeVLAN, Label: "backend", IPAMAddress: "192.168.0.2/24"},
+		},
+	}
+
+	migrated := linodego.InstanceConfig{
+		ID:    456,
+		Label: "boot",
+		Interfaces: []linodego.InstanceConfigInterface{
+			{
+				ID: 3, Purpose: linodego.InterfacePurposeVPC, Primary: true, VPCID: &vpcID, SubnetID: &subnetID,
+				IPv4: &linodego.VPCIPv4{VPC: "10.0.0.2", NAT1To1: &nat},
+			},
+			{ID: 4, Purpose: linodego.InterfacePurposePublic},
+			{ID: 5, Purpose: linodego.InterfacePurposeVLAN, Label: "backend", IPAMAddress: "192.168.0.2/24"},
+		},
+	}
+
+	mockVPCMigrationConfigs(t, nil, original, migrated)
+
+	anyAddress := "any"
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123/configs/456"),
+		func(req *http.Request) (*http.Response, error) {
+			var body linodego.InstanceConfigUpdateOptions
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+
+			require.Equal(t, "boot", body.Label)
+			require.Equal(t, []linodego.InstanceConfigInterfaceCreateOptions{
+				{
+					Purpose: linodego.InterfacePurposeVPC, Primary: true, SubnetID: &subnetID,
+					IPv4: &linodego.VPCIPv4{NAT1To1: &anyAddress},
+				},
+				{Purpose: linodego.InterfacePurposePublic},
+				{Purpose: linodego.InterfacePurposeVLAN, Label: "backend", IPAMAddress: "192.168.0.2/24"},
+			}, body.Interfaces)
+
+			return httpmock.NewJsonResponse(200, migrated)
+		})
+
+	opts := linodego.VPCMigrationOptions{SubnetID: subnetID, KeepPublic: true, NAT1To1: "any"}
+
+	config, err := client.MigrateConfigToVPC(context.Background(), 123, 456, opts)
+	require.NoError(t, err)
+	require.Equal(t, migrated.Interfaces, config.Interfaces)
+	require.Equal(t, 1, httpmock.GetCallCountInfo()["PUT =~"+mockRequestURL(t, "linode/instances/123/configs/456").String()])
+}
+
+func TestInstanceConfig_MigrateToVPCIdempotent(t *testing.T) {
+	client := createMockClient(t)
+
+	subnetID := 7
+	nat := "203.0.113.5"
+
+	migrated := linodego.InstanceConfig{
+		ID: 456,
+		Interfaces: []linodego.InstanceConfigInterface{
+			{
+				ID: 3, Purpose: linodego.InterfacePurposeVPC, Primary: true, SubnetID: &subnetID,
+				IPv4: &linodego.VPCIPv4{VPC: "10.0.0.2", NAT1To1: &nat},
+			},
+		},
+	}
+
+	mockVPCMigrationConfigs(t, nil, migrated)
+
+	// No PUT or reboot responders are registered, so any update would fail
+	config, err := client.MigrateConfigToVPC(context.Background(), 123, 456, linodego.VPCMigrationOptions{
+		SubnetID: subnetID,
+		NAT1To1:  "any",
+		Reboot:   true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 456, config.ID)
+}
+
+func TestInstanceConfig_MigrateToVPCOtherSubnet(t *testing.T) {
+	client := createMockClient(t)
+
+	otherSubnetID := 9
+
+	mockVPCMigrationConfigs(t, []linodego.InstanceConfig{{
+		ID: 789,
+		Interfaces: []linodego.InstanceConfigInterface{
+			{ID: 6, Purpose: linodego.InterfacePurposeVPC, Primary: true, SubnetID: &otherSubnetID},
+		},
+	}}, linodego.InstanceConfig{ID: 456})
+
+	_, err := client.MigrateConfigToVPC(context.Background(), 123, 456, linodego.VPCMigrationOptions{SubnetID: 7})
+	require.ErrorContains(t, err, "config 789 has a VPC interface on subnet 9")
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	_, err = client.MigrateConfigToVPC(context.Background(), 123, 456, linodego.VPCMigrationOptions{SubnetID: 7, NAT1To1: "public"})
+	require.ErrorContains(t, err, "invalid NAT 1:1 address")
+}
@@ -0,0 +1,257 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// mockDiskTransitions responds with each of the given statuses in order,
+// repeating the last status once all have been returned.
+func mockDiskTransitions(t *testing.T, statuses ...linodego.DiskStatus) {
+	t.Helper()
+
+	calls := 0
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/disks"),
+		func(_ *http.Request) (*http.Response, error) {
+			status := statuses[min(calls, len(statuses)-1)]
+			calls++
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data":    []linodego.InstanceDisk{{ID: 456, Status: status}},
+				"page":    1,
+				"pages":   1,
+				"results": 1,
+			})
+		})
+}
+
+func TestInstanceDisk_WaitForStatusCtx(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	mockDiskTransitions(t, linodego.DiskNotReady, linodego.DiskReady)
+
+	disk, err := client.WaitForInstanceDiskStatusCtx(context.Background(), 123, 456, linodego.DiskReady)
+	require.NoError(t, err)
+	require.Equal(t, linodego.DiskReady, disk.Status)
+}
+
+func TestInstanceDisk_WaitForStatusCtxDeadline(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	mockDiskTransitions(t, linodego.DiskNotReady)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.WaitForInstanceDiskStatusCtx(ctx, 123, 456, linodego.DiskReady)
+	require.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestInstanceDisk_WaitForStatusTimeoutSeconds(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	mockDiskTransitions(t, linodego.DiskNotReady)
+
+	_, err := client.WaitForInstanceDiskStatus(context.Background(), 123, 456, linodego.DiskReady, 1)
+	require.ErrorContains(t, err, "Error waiting for Instance 123 Disk 456 status ready")
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func mockCloneInstances(t *testing.T, destRegion string) {
+	t.Helper()
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{
+			ID: 123, Region: "us-east", Specs: &linodego.InstanceSpec{Disk: 81920},
+		}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/789$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{
+			ID: 789, Region: destRegion, Specs: &linodego.InstanceSpec{Disk: 51200},
+		}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/disks/456$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceDisk{ID: 456, Label: "boot", Size: 25600}))
+}
+
+func TestInstanceDisk_CloneAcrossInstances(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	mockCloneInstances(t, "us-east")
+
+	listCalls := 0
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/789/disks$"),
+		func(_ *http.Request) (*http.Response, error) {
+			disks := []linodego.InstanceDisk{{ID: 1, Size: 10240}}
+			if listCalls > 0 {
+				disks = append(disks, linodego.InstanceDisk{ID: 2, Label: "boot", Size: 25600, Status: linodego.DiskReady})
+			}
+			listCalls++
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data": disks, "page": 1, "pages": 1, "results": len(disks),
+			})
+		})
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/clone"),
+		mockRequestBodyValidate(t, linodego.InstanceCloneOptions{LinodeID: 789, Disks: []int{456}},
+			linodego.Instance{ID: 789}))
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/789/disks/2$"),
+		mockRequestBodyValidate(t, linodego.InstanceDiskUpdateOptions{Label: "boot-clone"},
+			linodego.InstanceDisk{ID: 2, Label: "boot-clone", Size: 25600, Status: linodego.DiskReady}))
+
+	disk, err := client.CloneInstanceDisk(context.Background(), 123, 456, 789, linodego.InstanceDiskCloneOptions{
+		Label: "boot-clone",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, disk.ID)
+	require.Equal(t, "boot-clone", disk.Label)
+}
+
+func TestInstanceDisk_CloneSameInstance(t *testing.T) {
+	client := createMockClient(t)
+
+	mockCloneInstances(t, "us-east")
+	mockPaginatedResponse(t, "linode/instances/123/disks$", []linodego.InstanceDisk{{ID: 456, Size: 25600}}, 1)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/disks/456/clone"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceDisk{ID: 457, Label: "boot", Size: 25600}))
+
+	disk, err := client.CloneInstanceDisk(context.Background(), 123, 456, 123, linodego.InstanceDiskCloneOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 457, disk.ID)
+}
+
+func TestInstanceDisk_CloneValidation(t *testing.T) {
+	client := createMockClient(t)
+
+	mockCloneInstances(t, "eu-west")
+
+	_, err := client.CloneInstanceDisk(context.Background(), 123, 456, 789, linodego.InstanceDiskCloneOptions{})
+	require.ErrorContains(t, err, "instances must be in the same region")
+
+	httpmock.Reset()
+	mockCloneInstances(t, "us-east")
+	mockPaginatedResponse(t, "linode/instances/789/disks$", []linodego.InstanceDisk{{ID: 1, Size: 30720}}, 1)
+
+	_, err = client.CloneInstanceDisk(context.Background(), 123, 456, 789, linodego.InstanceDiskCloneOptions{})
+	require.ErrorContains(t, err, "instance 789 has 20480 MB of unallocated disk space, but 25600 MB is required")
+}
+
+func TestInstanceDisk_WaitForCreated(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	mockPaginatedResponse(t, "linode/instances/123/disks$", []map[string]any{
+		{"id": 456, "status": "not ready", "created": "2024-03-04T10:00:00"},
+	}, 1)
+
+	eventStatuses := []linodego.EventStatus{linodego.EventStarted, linodego.EventFinished}
+	calls := 0
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/events"),
+		func(req *http.Request) (*http.Response, error) {
+			require.Contains(t, req.Header.Get("X-Filter"), `"created":{"+gte":"2024-03-04T10:00:00"}`)
+			require.Equal(t, "25", req.URL.Query().Get("page_size"))
+
+			status := eventStatuses[min(calls, len(eventStatuses)-1)]
+			calls++
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data": []map[string]any{
+					// An unrelated disk created at the same time
+					{
+						"id": 2, "action": "disk_create", "status": "started",
+						"secondary_entity": map[string]any{"id": 789, "type": "disks"},
+					},
+					{
+						"id": 1, "action": "disk_create", "status": status,
+						"secondary_entity": map[string]any{"id": 456, "type": "disks"},
+					},
+				},
+				"page":    1,
+				"pages":   1,
+				"results": 2,
+			})
+		})
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/disks/456"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceDisk{ID: 456, Status: linodego.DiskReady}))
+
+	var statuses []string
+
+	disk, err := client.WaitForInstanceDiskCreated(context.Background(), 123, 456, 5,
+		linodego.WithWaitObserver(func(update linodego.WaitUpdate) {
+			statuses = append(statuses, update.Status)
+		}))
+	require.NoError(t, err)
+	require.Equal(t, linodego.DiskReady, disk.Status)
+	require.Equal(t, 2, calls)
+	require.Equal(t, []string{"started", "finished"}, statuses)
+}
+
+func TestInstanceDisk_WaitForCreatedFailed(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	mockPaginatedResponse(t, "linode/instances/123/disks$", []map[string]any{
+		{"id": 456, "status": "not ready", "created": "2024-03-04T10:00:00"},
+	}, 1)
+
+	mockPaginatedResponse(t, "account/events", []map[string]any{
+		{
+			"id": 1, "action": "disk_create", "status": "failed", "message": "image unavailable",
+			"secondary_entity": map[string]any{"id": 456, "type": "disks"},
+		},
+	}, 1)
+
+	_, err := client.WaitForInstanceDiskCreated(context.Background(), 123, 456, 5)
+	require.ErrorIs(t, err, linodego.ErrEventFailed)
+	require.ErrorContains(t, err, "creation of Instance 123 Disk 456 failed (event 1): image unavailable")
+}
+
+func TestInstanceDisk_CreateValidation(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/disks"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceDisk{ID: 456}))
+
+	_, err := client.CreateInstanceDisk(context.Background(), 123, linodego.InstanceDiskCreateOptions{
+		Label:      "disk",
+		Size:       1024,
+		Filesystem: string(linodego.FilesystemRaw),
+		Image:      "linode/debian12",
+		RootPass:   "hunter2hunter2",
+	})
+	require.ErrorContains(t, err, "disk filesystem raw cannot be used with image linode/debian12")
+
+	_, err = client.CreateInstanceDisk(context.Background(), 123, linodego.InstanceDiskCreateOptions{
+		Label:    "disk",
+		Size:     1024,
+		RootPass: "hunter2hunter2",
+	})
+	require.ErrorContains(t, err, "disk root password requires an image")
+
+	require.Equal(t, 0, httpmock.GetTotalCallCount())
+
+	disk, err := client.CreateInstanceDisk(context.Background(), 123, linodego.InstanceDiskCreateOptions{
+		Label:      "disk",
+		Size:       1024,
+		Filesystem: string(linodego.FilesystemExt4),
+		Image:      "linode/debian12",
+		RootPass:   "hunter2hunter2",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 456, disk.ID)
+}
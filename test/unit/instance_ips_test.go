@@ -0,0 +1,141 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceIPs_AssignReservedIPValidatesType(t *testing.T) {
+	client := createMockClient(t)
+
+	opts := linodego.InstanceReserveIPOptions{
+		Type:    "ipv4 ",
+		Public:  true,
+		Address: "192.0.2.1",
+	}
+
+	_, err := client.AssignInstanceReservedIP(context.Background(), 123, opts)
+	require.ErrorContains(t, err, `invalid reserved IP type "ipv4 "`)
+	require.Zero(t, httpmock.GetTotalCallCount())
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/ips"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceIP{Address: "192.0.2.1", Reserved: true}))
+
+	opts.Type = linodego.IPTypeIPv4.String()
+
+	ip, err := client.AssignInstanceReservedIP(context.Background(), 123, opts)
+	require.NoError(t, err)
+	require.True(t, ip.Reserved)
+}
+
+// mockIPv6RangedInstanceIPs is an Instance with a /64 range routed to it.
+var mockIPv6RangedInstanceIPs = linodego.InstanceIPAddressResponse{
+	IPv4: &linodego.InstanceIPv4Response{},
+	IPv6: &linodego.InstanceIPv6Response{
+		SLAAC: &linodego.InstanceIP{
+			Address:    "2600:3c03::f03c:91ff:fe24:3a2f",
+			Gateway:    "fe80::1",
+			SubnetMask: "ffff:ffff:ffff:ffff::",
+			Prefix:     64,
+			Type:       linodego.IPTypeIPv6,
+			Public:     true,
+			RDNS:       "li123-456.members.linode.com",
+			LinodeID:   123,
+			Region:     "us-east",
+		},
+		LinkLocal: &linodego.InstanceIP{
+			Address:    "fe80::f03c:91ff:fe24:3a2f",
+			Gateway:    "fe80::1",
+			SubnetMask: "ffff:ffff:ffff:ffff::",
+			Prefix:     64,
+			Type:       linodego.IPTypeIPv6,
+			LinodeID:   123,
+			Region:     "us-east",
+		},
+		Global: []linodego.IPv6Range{
+			{
+				Range:       "2600:3c03:e000:123::",
+				Region:      "us-east",
+				Prefix:      64,
+				RouteTarget: "2600:3c03::f03c:91ff:fe24:3a2f",
+			},
+		},
+	},
+}
+
+func TestInstanceIPs_GetIPv6(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/ips"),
+		httpmock.NewJsonResponderOrPanic(200, mockIPv6RangedInstanceIPs))
+
+	ips, err := client.GetInstanceIPAddresses(context.Background(), 123)
+	require.NoError(t, err)
+
+	require.Equal(t, "2600:3c03::f03c:91ff:fe24:3a2f", linodego.PrimaryIPv6(ips))
+	require.Equal(t, "li123-456.members.linode.com", ips.IPv6.SLAAC.RDNS)
+	require.Equal(t, "fe80::f03c:91ff:fe24:3a2f", ips.IPv6.LinkLocal.Address)
+	require.Len(t, ips.IPv6.Global, 1)
+	require.Equal(t, 64, ips.IPv6.Global[0].Prefix)
+	require.Equal(t, ips.IPv6.SLAAC.Address, ips.IPv6.Global[0].RouteTarget)
+}
+
+func TestInstanceIPs_PrimaryIPv6Missing(t *testing.T) {
+	require.Empty(t, linodego.PrimaryIPv6(nil))
+	require.Empty(t, linodego.PrimaryIPv6(&linodego.InstanceIPAddressResponse{IPv4: &linodego.InstanceIPv4Response{}}))
+}
+
+func TestInstanceIPs_UpdateSLAACRDNS(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/ips"),
+		httpmock.NewJsonResponderOrPanic(200, mockIPv6RangedInstanceIPs))
+
+	rdns := "v6.example.com"
+	httpmock.RegisterRegexpResponder("PUT",
+		mockRequestURL(t, "linode/instances/123/ips/2600:3c03::f03c:91ff:fe24:3a2f"),
+		mockRequestBodyValidate(t, linodego.IPAddressUpdateOptions{RDNS: &rdns},
+			linodego.InstanceIP{Address: "2600:3c03::f03c:91ff:fe24:3a2f", RDNS: rdns}))
+
+	ip, err := client.UpdateInstanceSLAACRDNS(context.Background(), 123, &rdns)
+	require.NoError(t, err)
+	require.Equal(t, rdns, ip.RDNS)
+}
+
+func TestInstanceIPs_UpdateSLAACRDNSWithoutSLAAC(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/ips"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceIPAddressResponse{IPv4: &linodego.InstanceIPv4Response{}}))
+
+	_, err := client.UpdateInstanceSLAACRDNS(context.Background(), 123, nil)
+	require.ErrorContains(t, err, "instance 123 has no IPv6 SLAAC address")
+}
+
+func TestInstanceIPs_GetIPAddressInvalidAddress(t *testing.T) {
+	client := createMockClient(t)
+
+	for _, address := range []string{"", "12345", "192.0.2.10/24", "../../account", "fe80::1%eth0"} {
+		_, err := client.GetInstanceIPAddress(context.Background(), 123, address)
+		require.ErrorIs(t, err, linodego.ErrInvalidAddress, address)
+	}
+
+	require.Zero(t, httpmock.GetTotalCallCount(), "expected the requests to be rejected locally")
+}
+
+func TestInstanceIPs_GetIPAddress(t *testing.T) {
+	client := createMockClient(t)
+
+	for _, address := range []string{"192.0.2.10", "2600:3c03::f03c:91ff:fe24:3a2f"} {
+		httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/ips/"+address),
+			httpmock.NewJsonResponderOrPanic(200, linodego.InstanceIP{Address: address}))
+
+		ip, err := client.GetInstanceIPAddress(context.Background(), 123, address)
+		require.NoError(t, err)
+		require.Equal(t, address, ip.Address)
+	}
+}
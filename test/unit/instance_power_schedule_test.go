@@ -0,0 +1,264 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock reports a fixed time, and fires a timer each time fire is called
+type fakeClock struct {
+	now    time.Time
+	timers chan time.Duration
+	fire   chan time.Time
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now, timers: make(chan time.Duration, 1), fire: make(chan time.Time)}
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.timers <- d
+	return c.fire
+}
+
+// nextTimer returns the duration of the next timer started by the schedule
+func (c *fakeClock) nextTimer(t *testing.T) time.Duration {
+	t.Helper()
+
+	select {
+	case d := <-c.timers:
+		return d
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a timer")
+		return 0
+	}
+}
+
+type powerAction struct {
+	action linodego.PowerAction
+	err    error
+}
+
+// startPowerSchedule starts a schedule booting at 08:00 and shutting down at 18:00 UTC,
+// after applying each of the modifiers to it
+func startPowerSchedule(
+	t *testing.T,
+	client *linodego.Client,
+	clock *fakeClock,
+	modifiers ...func(*linodego.PowerSchedule),
+) <-chan powerAction {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	actions := make(chan powerAction, 1)
+
+	schedule := linodego.PowerSchedule{
+		BootAt:     8 * time.Hour,
+		ShutdownAt: 18 * time.Hour,
+		RetryDelay: time.Minute,
+		Clock:      clock,
+		OnAction: func(action linodego.PowerAction, err error) {
+			actions <- powerAction{action, err}
+		},
+	}
+
+	for _, modifier := range modifiers {
+		modifier(&schedule)
+	}
+
+	err := client.ScheduleInstancePower(ctx, 123, schedule)
+	require.NoError(t, err)
+
+	return actions
+}
+
+func TestInstancePowerSchedule_Shutdown(t *testing.T) {
+	client := createMockClient(t)
+	clock := newFakeClock(time.Date(2024, time.March, 4, 17, 59, 0, 0, time.UTC))
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/shutdown"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	actions := startPowerSchedule(t, client, clock)
+
+	require.Equal(t, time.Minute, clock.nextTimer(t))
+	clock.fire <- clock.now
+
+	require.Equal(t, powerAction{linodego.PowerShutdown, nil}, <-actions)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	// The next action is the following morning's boot
+	require.Equal(t, 14*time.Hour+time.Minute, clock.nextTimer(t))
+}
+
+func TestInstancePowerSchedule_RetriesFailedAction(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		status int
+		reason string
+	}{
+		{"server error", http.StatusInternalServerError, "Internal server error"},
+		{"busy", http.StatusBadRequest, "Linode busy."},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := createMockClient(t)
+			client.SetRetryCount(0)
+			clock := newFakeClock(time.Date(2024, time.March, 4, 7, 0, 0, 0, time.UTC))
+
+			var calls atomic.Int32
+			httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/boot"),
+				func(_ *http.Request) (*http.Response, error) {
+					if calls.Add(1) == 1 {
+						return httpmock.NewJsonResponse(tc.status, linodego.APIError{
+							Errors: []linodego.APIErrorReason{{Reason: tc.reason}},
+						})
+					}
+
+					return httpmock.NewStringResponse(200, "{}"), nil
+				})
+
+			actions := startPowerSchedule(t, client, clock)
+
+			require.Equal(t, time.Hour, clock.nextTimer(t))
+			clock.fire <- clock.now
+
+			require.Equal(t, time.Minute, clock.nextTimer(t), "expected the boot to be retried")
+			clock.fire <- clock.now
+
+			require.Equal(t, powerAction{linodego.PowerBoot, nil}, <-actions)
+			require.Equal(t, int32(2), calls.Load())
+		})
+	}
+}
+
+func TestInstancePowerSchedule_DoesNotRetryClientErrors(t *testing.T) {
+	client := createMockClient(t)
+	clock := newFakeClock(time.Date(2024, time.March, 4, 7, 0, 0, 0, time.UTC))
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/boot"),
+		httpmock.NewJsonResponderOrPanic(http.StatusForbidden, linodego.APIError{
+			Errors: []linodego.APIErrorReason{{Reason: "Unauthorized"}},
+		}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusForbidden, linodego.APIError{
+			Errors: []linodego.APIErrorReason{{Reason: "Unauthorized"}},
+		}))
+
+	actions := startPowerSchedule(t, client, clock)
+
+	require.Equal(t, time.Hour, clock.nextTimer(t))
+	clock.fire <- clock.now
+
+	action := <-actions
+	require.Equal(t, linodego.PowerBoot, action.action)
+	require.True(t, linodego.ErrHasStatus(action.err, http.StatusForbidden))
+	require.Equal(t, 1, httpmock.GetCallCountInfo()["POST =~"+mockRequestURL(t, "linode/instances/123/boot").String()])
+
+	// The next timer is the evening's shutdown rather than a retry
+	require.Equal(t, 11*time.Hour, clock.nextTimer(t))
+}
+
+func TestInstancePowerSchedule_AlreadyInState(t *testing.T) {
+	client := createMockClient(t)
+	clock := newFakeClock(time.Date(2024, time.March, 4, 7, 0, 0, 0, time.UTC))
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/boot"),
+		httpmock.NewJsonResponderOrPanic(http.StatusBadRequest, linodego.APIError{
+			Errors: []linodego.APIErrorReason{{Reason: "Linode 123 is already running."}},
+		}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123, Status: linodego.InstanceRunning}))
+
+	actions := startPowerSchedule(t, client, clock)
+
+	require.Equal(t, time.Hour, clock.nextTimer(t))
+	clock.fire <- clock.now
+
+	require.Equal(t, powerAction{linodego.PowerBoot, nil}, <-actions)
+	require.Equal(t, 11*time.Hour, clock.nextTimer(t))
+}
+
+func TestInstancePowerSchedule_NoRetries(t *testing.T) {
+	client := createMockClient(t)
+	client.SetRetryCount(0)
+	clock := newFakeClock(time.Date(2024, time.March, 4, 7, 0, 0, 0, time.UTC))
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/boot"),
+		httpmock.NewJsonResponderOrPanic(http.StatusInternalServerError, linodego.APIError{
+			Errors: []linodego.APIErrorReason{{Reason: "Internal server error"}},
+		}))
+
+	actions := startPowerSchedule(t, client, clock, func(schedule *linodego.PowerSchedule) {
+		schedule.Retries = linodego.PowerScheduleNoRetries
+	})
+
+	require.Equal(t, time.Hour, clock.nextTimer(t))
+	clock.fire <- clock.now
+
+	action := <-actions
+	require.True(t, linodego.ErrHasStatus(action.err, http.StatusInternalServerError))
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	// The next timer is the evening's shutdown rather than a retry
+	require.Equal(t, 11*time.Hour, clock.nextTimer(t))
+}
+
+func TestInstancePowerSchedule_DaylightSavingTime(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/boot"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	// Clocks go forward an hour at 02:00 on March 10th, 2024 in New York
+	clock := newFakeClock(time.Date(2024, time.March, 10, 0, 0, 0, 0, location))
+
+	actions := startPowerSchedule(t, client, clock, func(schedule *linodego.PowerSchedule) {
+		schedule.Location = location
+	})
+
+	// 08:00 is only 7 hours after midnight
+	require.Equal(t, 7*time.Hour, clock.nextTimer(t))
+	clock.now = time.Date(2024, time.March, 10, 8, 0, 0, 0, location)
+	clock.fire <- clock.now
+
+	require.Equal(t, powerAction{linodego.PowerBoot, nil}, <-actions)
+	require.Equal(t, 10*time.Hour, clock.nextTimer(t))
+
+	// Clocks go back an hour at 02:00 on November 3rd, 2024, so 08:00 is 9 hours after midnight
+	clock = newFakeClock(time.Date(2024, time.November, 3, 0, 0, 0, 0, location))
+
+	startPowerSchedule(t, client, clock, func(schedule *linodego.PowerSchedule) {
+		schedule.Location = location
+	})
+
+	require.Equal(t, 9*time.Hour, clock.nextTimer(t))
+}
+
+func TestInstancePowerSchedule_Validate(t *testing.T) {
+	client := createMockClient(t)
+
+	err := client.ScheduleInstancePower(context.Background(), 123, linodego.PowerSchedule{
+		BootAt:     8 * time.Hour,
+		ShutdownAt: 8 * time.Hour,
+	})
+	require.ErrorContains(t, err, "boot and shutdown times must differ")
+
+	err = client.ScheduleInstancePower(context.Background(), 123, linodego.PowerSchedule{
+		ShutdownAt: 25 * time.Hour,
+	})
+	require.ErrorContains(t, err, "must be between 0 and 24 hours")
+}
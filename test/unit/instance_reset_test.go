@@ -0,0 +1,113 @@
+package unit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstance_Reset(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	instanceCalls := 0
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123$"),
+		func(_ *http.Request) (*http.Response, error) {
+			status := linodego.InstanceRunning
+			if instanceCalls > 0 {
+				status = linodego.InstanceOffline
+			}
+			instanceCalls++
+
+			return httpmock.NewJsonResponse(200, linodego.Instance{
+				ID: 123, Label: "qa-env", Status: status, Tags: []string{"qa", "owner:team"},
+				IPv4: []*net.IP{linodego.Pointer(net.ParseIP("192.0.2.1"))},
+			})
+		})
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/shutdown"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	mockPaginatedResponse(t, "linode/instances/123/configs$", []linodego.InstanceConfig{{ID: 1}, {ID: 2}}, 2)
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "linode/instances/123/configs/[12]"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	mockPaginatedResponse(t, "linode/instances/123/disks$", []linodego.InstanceDisk{{ID: 10}, {ID: 11}}, 2)
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "linode/instances/123/disks/1[01]"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	// Each disk reports as deleting once before it is gone
+	diskPolls := map[string]int{}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/disks/1[01]"),
+		func(req *http.Request) (*http.Response, error) {
+			diskPolls[req.URL.Path]++
+			if diskPolls[req.URL.Path] == 1 {
+				return httpmock.NewJsonResponse(200, linodego.InstanceDisk{Status: linodego.DiskDeleting})
+			}
+
+			return httpmock.NewJsonResponse(404, linodego.APIError{Errors: []linodego.APIErrorReason{{Reason: "Not found"}}})
+		})
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/configs$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceConfig{ID: 3, Label: "Default Config"}))
+
+	// The primary address is not listed first
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/ips$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceIPAddressResponse{
+			IPv4: &linodego.InstanceIPv4Response{
+				Public:  []*linodego.InstanceIP{{Address: "192.0.2.2"}, {Address: "192.0.2.1"}},
+				Private: []*linodego.InstanceIP{{Address: "192.168.1.1"}},
+			},
+		}))
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "linode/instances/123/ips/"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123$"),
+		mockRequestBodyValidate(t, linodego.InstanceUpdateOptions{
+			Label: "linode123",
+			Tags:  &[]string{},
+		}, linodego.Instance{ID: 123, Label: "linode123"}))
+
+	var statuses []string
+
+	report, err := client.ResetInstance(context.Background(), 123, linodego.InstanceResetOptions{
+		Timeout: time.Minute,
+		WaitOptions: []linodego.WaitOption{
+			linodego.WithWaitObserver(func(update linodego.WaitUpdate) {
+				statuses = append(statuses, update.Status)
+			}),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &linodego.InstanceResetReport{
+		ConfigsDeleted:  []int{1, 2},
+		DisksDeleted:    []int{10, 11},
+		IPsRemoved:      []string{"192.168.1.1", "192.0.2.2"},
+		TagsRemoved:     []string{"qa", "owner:team"},
+		DefaultConfigID: 3,
+	}, report)
+	require.Equal(t, map[string]int{
+		"/v4/linode/instances/123/disks/10": 2,
+		"/v4/linode/instances/123/disks/11": 2,
+	}, diskPolls)
+	require.Equal(t, []string{"offline", "deleting", "deleting"}, statuses)
+}
+
+func TestInstance_ResetRefusesLKENodes(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123, LKEClusterID: 456}))
+
+	_, err := client.ResetInstance(context.Background(), 123, linodego.InstanceResetOptions{})
+	require.ErrorContains(t, err, "LKE cluster 456")
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,85 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSnapshotTransitions responds with each of the given statuses in order,
+// repeating the last status once all have been returned.
+func mockSnapshotTransitions(t *testing.T, statuses ...linodego.InstanceSnapshotStatus) {
+	t.Helper()
+
+	calls := 0
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/backups/456"),
+		func(_ *http.Request) (*http.Response, error) {
+			status := statuses[min(calls, len(statuses)-1)]
+			calls++
+
+			return httpmock.NewJsonResponse(200, linodego.InstanceSnapshot{ID: 456, Status: status})
+		})
+}
+
+func TestInstanceSnapshot_WaitForStatusSuccessful(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	mockSnapshotTransitions(t,
+		linodego.SnapshotPending,
+		linodego.SnapshotRunning,
+		linodego.SnapshotNeedsPostProcessing,
+		linodego.SnapshotSuccessful,
+	)
+
+	snapshot, err := client.WaitForSnapshotStatus(context.Background(), 123, 456, linodego.SnapshotSuccessful, 5)
+	require.NoError(t, err)
+	require.Equal(t, linodego.SnapshotSuccessful, snapshot.Status)
+}
+
+func TestInstanceSnapshot_WaitForStatusFailsFast(t *testing.T) {
+	for _, status := range []linodego.InstanceSnapshotStatus{linodego.SnapshotFailed, linodego.SnapshotUserAborted} {
+		t.Run(string(status), func(t *testing.T) {
+			client := createMockClient(t)
+			client.SetPollDelay(time.Millisecond)
+
+			mockSnapshotTransitions(t, linodego.SnapshotPending, linodego.SnapshotRunning, status)
+
+			snapshot, err := client.WaitForSnapshotStatus(context.Background(), 123, 456, linodego.SnapshotSuccessful, 5)
+			require.Error(t, err)
+			require.NotErrorIs(t, err, context.DeadlineExceeded)
+			require.Equal(t, status, snapshot.Status)
+		})
+	}
+}
+
+func TestInstanceSnapshot_RestoreProgress(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/events"),
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"data": []linodego.Event{
+				{
+					ID:              789,
+					Action:          linodego.ActionBackupsRestore,
+					Status:          linodego.EventStarted,
+					PercentComplete: 42,
+				},
+			},
+			"page":    1,
+			"pages":   1,
+			"results": 1,
+		}))
+
+	progress, err := client.GetInstanceBackupRestoreProgress(context.Background(), 123, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 789, progress.EventID)
+	require.Equal(t, linodego.EventStarted, progress.Status)
+	require.Equal(t, 42, progress.PercentComplete)
+}
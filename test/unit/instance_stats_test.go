@@ -4,10 +4,12 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInstanceStats_Get(t *testing.T) {
@@ -48,3 +50,43 @@ func TestInstanceStats_Get(t *testing.T) {
 		t.Fatalf("actual response does not equal desired response: %s", cmp.Diff(questions, desiredResponse))
 	}
 }
+
+func TestInstanceStats_GetByDateSparse(t *testing.T) {
+	client := createMockClient(t)
+
+	// A newly created instance reports empty series, and may omit some entirely
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "/instances/123/stats/2024/2"),
+		httpmock.NewStringResponder(200, `{
+			"title": "linode.com - sparse (123) - month of February 2024",
+			"data": {
+				"cpu": [],
+				"io": {"io": [], "swap": []},
+				"netv4": {"in": [], "out": [], "private_in": [], "private_out": []},
+				"netv6": {}
+			}
+		}`))
+
+	stats, err := client.GetInstanceStatsByDate(context.Background(), 123, 2024, 2)
+	require.NoError(t, err)
+	require.Contains(t, stats.Title, "sparse")
+	require.Empty(t, stats.Data.CPU)
+	require.Empty(t, stats.Data.IO.Swap)
+	require.Empty(t, stats.Data.NetV4.PrivateOut)
+	require.Nil(t, stats.Data.NetV6.In)
+}
+
+func TestInstanceStats_GetByDateValidation(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.GetInstanceStatsByDate(context.Background(), 123, 2024, 13)
+	require.ErrorContains(t, err, "invalid month 13")
+
+	_, err = client.GetInstanceStatsByDate(context.Background(), 123, 2024, 0)
+	require.ErrorContains(t, err, "invalid month 0")
+
+	next := time.Now().UTC().AddDate(0, 1, 0)
+	_, err = client.GetInstanceStatsByDate(context.Background(), 123, next.Year(), int(next.Month()))
+	require.ErrorContains(t, err, "the month is in the future")
+
+	require.Zero(t, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,103 @@
+package unit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func mockInstanceCreated(t *testing.T, created time.Time) {
+	t.Helper()
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123$"),
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"id":      123,
+			"created": created.Format("2006-01-02T15:04:05"),
+		}))
+}
+
+func TestInstanceTransfer_ListMonths(t *testing.T) {
+	client := createMockClient(t)
+
+	now := time.Now().UTC()
+	mockInstanceCreated(t, now.AddDate(0, 0, -now.Day()+1).AddDate(0, -2, 0))
+
+	months, err := client.ListInstanceTransferMonths(context.Background(), 123)
+	require.NoError(t, err)
+	require.Len(t, months, 3)
+	require.Equal(t, linodego.InstanceTransferMonth{Year: now.Year(), Month: now.Month()}, months[2])
+}
+
+func TestInstanceTransfer_GetMonthly(t *testing.T) {
+	client := createMockClient(t)
+
+	mockInstanceCreated(t, time.Date(2023, time.November, 15, 0, 0, 0, 0, time.UTC))
+
+	desired := linodego.InstanceTransferMonthly{
+		BytesIn:    30471077120,
+		BytesOut:   22956600198,
+		BytesTotal: 53427677318,
+	}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/transfer/2024/1"),
+		httpmock.NewJsonResponderOrPanic(200, desired))
+
+	transfer, err := client.GetInstanceTransferMonthly(context.Background(), 123, 2024, 1)
+	require.NoError(t, err)
+	require.Equal(t, desired, *transfer)
+}
+
+func TestInstanceTransfer_GetMonthlyOutOfRange(t *testing.T) {
+	client := createMockClient(t)
+
+	mockInstanceCreated(t, time.Date(2023, time.November, 15, 0, 0, 0, 0, time.UTC))
+
+	_, err := client.GetInstanceTransferMonthly(context.Background(), 123, 2023, 13)
+	require.ErrorContains(t, err, "invalid month 13")
+
+	_, err = client.GetInstanceTransferMonthly(context.Background(), 123, 2023, 10)
+	require.ErrorContains(t, err, "transfer for instance 123 is only available from 2023-11 to")
+	require.ErrorContains(t, err, "2023-10 requested")
+
+	_, err = client.GetInstanceTransferMonthly(context.Background(), 123, time.Now().Year()+1, 1)
+	require.ErrorContains(t, err, "transfer for instance 123 is only available from 2023-11 to")
+}
+
+func TestInstanceTransfer_GetNewInstance(t *testing.T) {
+	client := createMockClient(t)
+
+	// A brand-new instance has not used any transfer and may not yet contribute to any region
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/transfer$"),
+		httpmock.NewStringResponder(200, `{"billable": 0, "quota": 0, "used": 0, "region_transfers": []}`))
+
+	transfer, err := client.GetInstanceTransfer(context.Background(), 123)
+	require.NoError(t, err)
+	require.Zero(t, transfer.Used)
+	require.Zero(t, transfer.Billable)
+	require.Zero(t, transfer.Quota)
+	require.Empty(t, transfer.RegionTransfers)
+}
+
+func TestInstanceTransfer_GetRegions(t *testing.T) {
+	client := createMockClient(t)
+
+	desired := linodego.InstanceTransfer{
+		Used:     1073741824,
+		Billable: 0,
+		Quota:    1000,
+		RegionTransfers: []linodego.InstanceTransferRegion{
+			{ID: "us-east", Used: 1073741824, Quota: 1000},
+		},
+	}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/transfer$"),
+		httpmock.NewJsonResponderOrPanic(200, desired))
+
+	transfer, err := client.GetInstanceTransfer(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, desired, *transfer)
+}
@@ -0,0 +1,87 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceVolumes_FindAttachmentsDetached(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes/123"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Volume{ID: 123}))
+
+	attachments, err := client.FindVolumeAttachments(context.Background(), 123)
+	require.NoError(t, err)
+	require.Empty(t, attachments)
+}
+
+func TestInstanceVolumes_FindAttachmentsConfigured(t *testing.T) {
+	client := createMockClient(t)
+
+	linodeID := 456
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes/123"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Volume{ID: 123, LinodeID: &linodeID}))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/456/configs"),
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"data": []linodego.InstanceConfig{
+				{
+					ID: 1,
+					Devices: &linodego.InstanceConfigDeviceMap{
+						SDA: &linodego.InstanceConfigDevice{DiskID: 10},
+						SDC: &linodego.InstanceConfigDevice{VolumeID: 123},
+					},
+				},
+				{
+					ID: 2,
+					Devices: &linodego.InstanceConfigDeviceMap{
+						SDA: &linodego.InstanceConfigDevice{DiskID: 10},
+						SDB: &linodego.InstanceConfigDevice{VolumeID: 999},
+					},
+				},
+			},
+			"page":    1,
+			"pages":   1,
+			"results": 2,
+		}))
+
+	attachments, err := client.FindVolumeAttachments(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, []linodego.VolumeAttachment{
+		{LinodeID: 456, ConfigID: 1, Device: "sdc"},
+	}, attachments)
+}
+
+func TestInstanceVolumes_FindAttachmentsUnconfigured(t *testing.T) {
+	client := createMockClient(t)
+
+	linodeID := 456
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes/123"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Volume{ID: 123, LinodeID: &linodeID}))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/456/configs"),
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"data": []linodego.InstanceConfig{
+				{
+					ID: 1,
+					Devices: &linodego.InstanceConfigDeviceMap{
+						SDA: &linodego.InstanceConfigDevice{DiskID: 10},
+					},
+				},
+			},
+			"page":    1,
+			"pages":   1,
+			"results": 1,
+		}))
+
+	attachments, err := client.FindVolumeAttachments(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, []linodego.VolumeAttachment{{LinodeID: 456}}, attachments)
+}
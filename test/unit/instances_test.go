@@ -0,0 +1,707 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstance_GetBackupSchedule(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		httpmock.NewStringResponder(200, `{
+			"id": 123,
+			"label": "test",
+			"backups": {
+				"available": true,
+				"enabled": true,
+				"last_successful": "2018-01-01T00:01:01",
+				"schedule": {
+					"day": "Saturday",
+					"window": "W22"
+				}
+			}
+		}`))
+
+	instance, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+
+	require.True(t, instance.Backups.Enabled)
+	require.True(t, instance.Backups.Available)
+	require.Equal(t, "Saturday", instance.Backups.Schedule.Day)
+	require.Equal(t, "W22", instance.Backups.Schedule.Window)
+	require.NotNil(t, instance.Backups.LastSuccessful)
+	require.True(t, instance.Backups.LastSuccessful.Equal(time.Date(2018, 1, 1, 0, 1, 1, 0, time.UTC)))
+}
+
+func TestInstance_GetBackups(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/backups"),
+		httpmock.NewStringResponder(200, `{
+			"automatic": [
+				{"id": 1, "type": "auto", "status": "successful", "created": "2018-01-01T00:01:01"}
+			],
+			"snapshot": {
+				"current": {"id": 2, "label": "manual", "type": "snapshot", "status": "successful"},
+				"in_progress": null
+			}
+		}`))
+
+	backups, err := client.GetInstanceBackups(context.Background(), 123)
+	require.NoError(t, err)
+
+	require.Len(t, backups.Automatic, 1)
+	require.Equal(t, 1, backups.Automatic[0].ID)
+	require.Equal(t, "manual", backups.Snapshot.Current.Label)
+	require.Nil(t, backups.Snapshot.InProgress)
+}
+
+func TestInstance_CreateWithRegionFallback(t *testing.T) {
+	client := createMockClient(t)
+
+	var attempted []string
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances"),
+		func(r *http.Request) (*http.Response, error) {
+			var opts linodego.InstanceCreateOptions
+			if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+				t.Fatal(err)
+			}
+
+			attempted = append(attempted, opts.Region)
+
+			if opts.Region == "us-east" {
+				return httpmock.NewJsonResponse(400, linodego.APIError{
+					Errors: []linodego.APIErrorReason{
+						{Reason: "We're sorry, but there is not enough capacity in this region to deploy this Linode."},
+					},
+				})
+			}
+
+			return httpmock.NewJsonResponse(200, linodego.Instance{ID: 123, Region: opts.Region, Type: opts.Type})
+		})
+
+	instance, region, err := client.CreateInstanceWithRegionFallback(context.Background(), linodego.InstanceCreateOptions{
+		Type: "g6-standard-1",
+	}, []string{"us-east", "us-central", "us-west"})
+	require.NoError(t, err)
+
+	require.Equal(t, "us-central", region)
+	require.Equal(t, "us-central", instance.Region)
+	require.Equal(t, []string{"us-east", "us-central"}, attempted)
+}
+
+func TestInstance_CreateWithRegionFallbackOtherError(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason linodego.APIErrorReason
+	}{
+		{"invalid plan", linodego.APIErrorReason{Field: "type", Reason: "A valid plan type is required"}},
+		{"unavailable plan", linodego.APIErrorReason{Field: "type", Reason: "This plan is not available"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := createMockClient(t)
+
+			httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances"),
+				httpmock.NewJsonResponderOrPanic(400, linodego.APIError{
+					Errors: []linodego.APIErrorReason{tt.reason},
+				}))
+
+			_, _, err := client.CreateInstanceWithRegionFallback(context.Background(), linodego.InstanceCreateOptions{
+				Type: "invalid",
+			}, []string{"us-east", "us-central"})
+			require.ErrorContains(t, err, tt.reason.Reason)
+			require.Equal(t, 1, httpmock.GetTotalCallCount())
+		})
+	}
+}
+
+func TestInstance_MigrateValidatesType(t *testing.T) {
+	client := createMockClient(t)
+
+	err := client.MigrateInstance(context.Background(), 123, linodego.InstanceMigrateOptions{Type: "Warm"})
+	require.ErrorContains(t, err, `invalid migration type "Warm"`)
+
+	err = client.ResizeInstance(context.Background(), 123, linodego.InstanceResizeOptions{
+		Type:          "g6-standard-2",
+		MigrationType: "hot",
+	})
+	require.ErrorContains(t, err, `invalid migration type "hot"`)
+	require.Zero(t, httpmock.GetTotalCallCount())
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/migrate"),
+		mockRequestBodyValidate(t, linodego.InstanceMigrateOptions{Type: linodego.ColdMigration}, map[string]any{}))
+
+	require.NoError(t, client.MigrateInstance(context.Background(), 123, linodego.InstanceMigrateOptions{Type: linodego.ColdMigration}))
+}
+
+func TestInstance_MigrateOmitsUnsetOptions(t *testing.T) {
+	client := createMockClient(t)
+
+	var bodies []string
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/migrate"),
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+
+			bodies = append(bodies, string(body))
+
+			return httpmock.NewJsonResponse(200, map[string]any{})
+		})
+
+	require.NoError(t, client.MigrateInstance(context.Background(), 123, linodego.InstanceMigrateOptions{
+		Upgrade: linodego.Pointer(true),
+	}))
+
+	require.NoError(t, client.MigrateInstance(context.Background(), 123, linodego.InstanceMigrateOptions{
+		Region: "us-west",
+		Type:   linodego.WarmMigration,
+		PlacementGroup: &linodego.InstanceCreatePlacementGroupOptions{
+			ID: 456,
+		},
+	}))
+
+	require.Len(t, bodies, 2)
+	require.JSONEq(t, `{"upgrade": true}`, bodies[0])
+	require.JSONEq(t, `{"region": "us-west", "type": "warm", "placement_group": {"id": 456}}`, bodies[1])
+}
+
+func TestInstance_CreateIPv4ValidationTable(t *testing.T) {
+	addresses := func(n int) []string {
+		result := make([]string, n)
+		for i := range result {
+			result[i] = fmt.Sprintf("192.0.2.%d", i+1)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name       string
+		apiVersion string
+		strict     bool
+		count      int
+		wantErr    bool
+	}{
+		{"non-strict over limit", "v4", false, linodego.MaxInstanceCreateIPv4 + 1, false},
+		{"strict at limit", "v4", true, linodego.MaxInstanceCreateIPv4, false},
+		{"strict over limit", "v4", true, linodego.MaxInstanceCreateIPv4 + 1, true},
+		{"strict beta over limit", "v4beta", true, linodego.MaxInstanceCreateIPv4 + 1, true},
+		{"strict unknown version", "v5", true, linodego.MaxInstanceCreateIPv4 + 1, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := createMockClient(t)
+			client.SetAPIVersion(tc.apiVersion)
+			client.SetStrictValidation(tc.strict)
+
+			httpmock.RegisterRegexpResponder("POST", regexp.MustCompile("/linode/instances$"),
+				httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123}))
+
+			_, err := client.CreateInstance(context.Background(), linodego.InstanceCreateOptions{
+				Region: "us-east",
+				Type:   "g6-nanode-1",
+				IPv4:   addresses(tc.count),
+			})
+
+			if tc.wantErr {
+				require.ErrorContains(t, err, fmt.Sprintf("at most %d IPv4", linodego.MaxInstanceCreateIPv4))
+				require.Zero(t, httpmock.GetTotalCallCount())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, 1, httpmock.GetTotalCallCount())
+		})
+	}
+}
+
+func TestInstance_RebuildImageCompatibility(t *testing.T) {
+	tests := []struct {
+		name    string
+		image   linodego.Image
+		wantErr string
+	}{
+		{
+			name: "replicated to region",
+			image: linodego.Image{
+				ID:      "private/456",
+				Status:  linodego.ImageStatusAvailable,
+				Regions: []linodego.ImageRegion{{Region: "us-east", Status: linodego.ImageRegionStatusAvailable}},
+			},
+		},
+		{
+			name: "not replicated to region",
+			image: linodego.Image{
+				ID:      "private/456",
+				Status:  linodego.ImageStatusAvailable,
+				Regions: []linodego.ImageRegion{{Region: "us-west", Status: linodego.ImageRegionStatusAvailable}},
+			},
+			wantErr: "image private/456 is not replicated to region us-east of instance 123",
+		},
+		{
+			name: "replicating to region",
+			image: linodego.Image{
+				ID:      "private/456",
+				Status:  linodego.ImageStatusAvailable,
+				Regions: []linodego.ImageRegion{{Region: "us-east", Status: linodego.ImageRegionStatusReplicating}},
+			},
+			wantErr: "image private/456 is replicating in region us-east",
+		},
+		{
+			name:    "private image not available",
+			image:   linodego.Image{ID: "private/456", Status: linodego.ImageStatusPendingUpload},
+			wantErr: "image private/456 is pending_upload",
+		},
+		{
+			name:  "public image",
+			image: linodego.Image{ID: "private/456", IsPublic: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := createMockClient(t)
+			client.SetStrictValidation(true)
+
+			httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+				httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123, Region: "us-east"}))
+			httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "images/private%2F456"),
+				httpmock.NewJsonResponderOrPanic(200, tt.image))
+
+			rebuilds := 0
+			httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/rebuild"),
+				func(_ *http.Request) (*http.Response, error) {
+					rebuilds++
+					return httpmock.NewJsonResponse(200, linodego.Instance{ID: 123})
+				})
+
+			_, err := client.RebuildInstance(context.Background(), 123, linodego.InstanceRebuildOptions{
+				Image:    "private/456",
+				RootPass: "hunter2hunter2",
+			})
+
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, 1, rebuilds)
+				return
+			}
+
+			require.ErrorContains(t, err, tt.wantErr)
+			require.Zero(t, rebuilds, "expected the rebuild to be rejected locally")
+		})
+	}
+}
+
+func TestInstance_CheckRebuildImageCompatibilityMissingImage(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123, Region: "us-east"}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "images/private%2F456"),
+		httpmock.NewJsonResponderOrPanic(404, linodego.APIError{Errors: []linodego.APIErrorReason{{Reason: "Not found"}}}))
+
+	err := client.CheckRebuildImageCompatibility(context.Background(), 123, "private/456")
+	require.ErrorContains(t, err, "image private/456 does not exist")
+	require.True(t, linodego.IsNotFound(err))
+}
+
+// mockResizeEvents responds to event listings with a resize event having each
+// of the given statuses in order, repeating the last status once all have been returned.
+func mockResizeEvents(t *testing.T, message string, statuses ...linodego.EventStatus) {
+	t.Helper()
+
+	mockInstanceEvents(t, linodego.ActionLinodeResize, message, statuses...)
+}
+
+// mockInstanceEvents responds to event listings with an event for Instance 123 having the given
+// action and each of the given statuses in order, repeating the last status once all have been returned.
+func mockInstanceEvents(t *testing.T, action linodego.EventAction, message string, statuses ...linodego.EventStatus) {
+	t.Helper()
+
+	calls := 0
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/events"),
+		func(req *http.Request) (*http.Response, error) {
+			require.Contains(t, req.Header.Get("X-Filter"), fmt.Sprintf(`"action":"%s"`, action))
+
+			status := statuses[min(calls, len(statuses)-1)]
+			calls++
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data": []linodego.Event{{
+					ID:      456,
+					Action:  action,
+					Status:  status,
+					Message: message,
+					Entity:  &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode},
+				}},
+				"page":    1,
+				"pages":   1,
+				"results": 1,
+			})
+		})
+}
+
+func TestInstance_WaitForResize(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	mockResizeEvents(t, "", linodego.EventScheduled, linodego.EventStarted, linodego.EventFinished)
+
+	var statuses []string
+
+	event, err := client.WaitForInstanceResize(context.Background(), 123, time.Now(), 5,
+		linodego.WithWaitObserver(func(update linodego.WaitUpdate) {
+			statuses = append(statuses, update.Status)
+		}))
+	require.NoError(t, err)
+	require.Equal(t, 456, event.ID)
+	require.Equal(t, linodego.EventFinished, event.Status)
+	require.Equal(t, []string{"scheduled", "started", "finished"}, statuses)
+}
+
+func TestInstance_WaitForResizeFilter(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/events"),
+		func(req *http.Request) (*http.Response, error) {
+			var filter map[string]any
+			require.NoError(t, json.Unmarshal([]byte(req.Header.Get("X-Filter")), &filter))
+			require.Equal(t, map[string]any{"+gte": "2026-01-02T03:04:05"}, filter["created"])
+			require.Equal(t, "25", req.URL.Query().Get("page_size"))
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data": []linodego.Event{{
+					ID:     456,
+					Action: linodego.ActionLinodeResize,
+					Status: linodego.EventFinished,
+					Entity: &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode},
+				}},
+				"page":    1,
+				"pages":   1,
+				"results": 1,
+			})
+		})
+
+	// Only resizes started at or after minStart, given in UTC, are listed
+	minStart := time.Date(2026, 1, 2, 4, 4, 5, 0, time.FixedZone("UTC+1", 60*60))
+	event, err := client.WaitForInstanceResize(context.Background(), 123, minStart, 5)
+	require.NoError(t, err)
+	require.Equal(t, 456, event.ID)
+}
+
+func TestInstance_WaitForResizeFailed(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	mockResizeEvents(t, "Insufficient disk space", linodego.EventStarted, linodego.EventFailed)
+
+	event, err := client.WaitForInstanceResize(context.Background(), 123, time.Now(), 5)
+	require.ErrorIs(t, err, linodego.ErrEventFailed)
+	require.ErrorContains(t, err, "resize of Instance 123 failed (event 456): Insufficient disk space")
+	require.Equal(t, linodego.EventFailed, event.Status)
+}
+
+func TestInstance_MutateExplicitFalse(t *testing.T) {
+	client := createMockClient(t)
+
+	var bodies []string
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/mutate"),
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+
+			bodies = append(bodies, string(body))
+
+			return httpmock.NewJsonResponse(200, map[string]any{})
+		})
+
+	require.NoError(t, client.MutateInstance(context.Background(), 123, linodego.InstanceMutateOptions{
+		AllowAutoDiskResize: linodego.Pointer(false),
+	}))
+
+	require.NoError(t, client.MutateInstance(context.Background(), 123, linodego.InstanceMutateOptions{}))
+
+	require.Len(t, bodies, 2)
+	require.JSONEq(t, `{"allow_auto_disk_resize": false}`, bodies[0])
+	require.JSONEq(t, `{}`, bodies[1])
+}
+
+func TestInstance_WaitForStatusObserver(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	polls := 0
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		func(req *http.Request) (*http.Response, error) {
+			polls++
+
+			status := linodego.InstanceBooting
+			if polls >= 3 {
+				status = linodego.InstanceRunning
+			}
+
+			return httpmock.NewJsonResponse(200, linodego.Instance{ID: 123, Status: status})
+		})
+
+	var updates []linodego.WaitUpdate
+	instance, err := client.WaitForInstanceStatus(context.Background(), 123, linodego.InstanceRunning, 5,
+		linodego.WithWaitObserver(func(u linodego.WaitUpdate) {
+			updates = append(updates, u)
+		}))
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceRunning, instance.Status)
+
+	require.Len(t, updates, 3)
+	for i, u := range updates {
+		require.Equal(t, i+1, u.Attempt)
+		require.Nil(t, u.Percent)
+	}
+	require.Equal(t, "booting", updates[0].Status)
+	require.Equal(t, "running", updates[2].Status)
+	require.GreaterOrEqual(t, updates[2].Elapsed, updates[0].Elapsed)
+}
+
+func TestInstance_WaitForEventFinishedObserver(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	mockResizeEvents(t, "", linodego.EventStarted, linodego.EventStarted, linodego.EventFinished)
+
+	var updates []linodego.WaitUpdate
+	event, err := client.WaitForEventFinished(context.Background(), 123, linodego.EntityLinode,
+		linodego.ActionLinodeResize, time.Now(), 5,
+		linodego.WithWaitObserver(func(u linodego.WaitUpdate) {
+			updates = append(updates, u)
+		}))
+	require.NoError(t, err)
+	require.Equal(t, linodego.EventFinished, event.Status)
+
+	require.Len(t, updates, 3)
+	require.Equal(t, 3, updates[2].Attempt)
+	require.Equal(t, "started", updates[0].Status)
+	require.Equal(t, "finished", updates[2].Status)
+	require.NotNil(t, updates[2].Percent)
+}
+
+func TestInstance_MaintenancePolicy(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances$"),
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			require.Contains(t, string(body), `"maintenance_policy":"linode/power_off_on"`)
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"id":                 123,
+				"maintenance_policy": "linode/power_off_on",
+			})
+		})
+
+	instance, err := client.CreateInstance(context.Background(), linodego.InstanceCreateOptions{
+		Region:            "us-east",
+		Type:              "g6-standard-2",
+		MaintenancePolicy: linodego.InstanceMaintenancePolicyPowerOffOn,
+	})
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceMaintenancePolicyPowerOffOn, instance.MaintenancePolicy)
+	require.Equal(t, linodego.InstanceMaintenancePolicyPowerOffOn, *instance.GetUpdateOptions().MaintenancePolicy)
+
+	var updateBody string
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123"),
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			updateBody = string(body)
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"id":                 123,
+				"maintenance_policy": "linode/migrate",
+			})
+		})
+
+	instance, err = client.UpdateInstance(context.Background(), 123, linodego.InstanceUpdateOptions{
+		MaintenancePolicy: linodego.Pointer(linodego.InstanceMaintenancePolicyMigrate),
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"maintenance_policy": "linode/migrate"}`, updateBody)
+	require.Equal(t, linodego.InstanceMaintenancePolicyMigrate, instance.MaintenancePolicy)
+}
+
+func TestInstance_ListByGroup(t *testing.T) {
+	client := createMockClient(t)
+
+	instances := []linodego.Instance{
+		{ID: 1, Label: "web-1", Group: "web"},
+		{ID: 2, Label: "db-1", Group: "db"},
+		{ID: 3, Label: "web-2", Group: "web"},
+	}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances$"),
+		func(req *http.Request) (*http.Response, error) {
+			var filter map[string]any
+			require.NoError(t, json.Unmarshal([]byte(req.Header.Get("X-Filter")), &filter))
+
+			var matched []linodego.Instance
+			for _, instance := range instances {
+				if instance.Group == filter["group"] {
+					matched = append(matched, instance)
+				}
+			}
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data":    matched,
+				"page":    1,
+				"pages":   1,
+				"results": len(matched),
+			})
+		})
+
+	result, err := client.ListInstancesByGroup(context.Background(), "web")
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+
+	for _, instance := range result {
+		require.Equal(t, "web", instance.Group)
+	}
+}
+
+func TestInstance_BootWithConfig(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/boot"),
+		mockRequestBodyValidate(t, map[string]int{"config_id": 456}, map[string]any{}))
+
+	err := client.BootInstanceWithConfig(context.Background(), 123, 0)
+	require.ErrorContains(t, err, "a config ID is required to boot instance 123")
+	require.Zero(t, httpmock.GetTotalCallCount())
+
+	require.NoError(t, client.BootInstanceWithConfig(context.Background(), 123, 456))
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestInstance_ExitRescueMode(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/rescue"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	// The instance is booted into config 456
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/reboot"),
+		mockRequestBodyValidate(t, map[string]int{"config_id": 456}, map[string]any{}))
+
+	mockInstanceEvents(t, linodego.ActionLinodeReboot, "", linodego.EventStarted, linodego.EventFinished)
+
+	// The instance is running throughout, as it was running in rescue mode
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123, Status: linodego.InstanceRunning}))
+
+	require.NoError(t, client.RescueInstance(context.Background(), 123, linodego.InstanceRescueOptions{}))
+
+	_, err := client.ExitRescueMode(context.Background(), 123, 0)
+	require.ErrorContains(t, err, "a config ID is required to exit rescue mode on instance 123")
+
+	instance, err := client.ExitRescueMode(context.Background(), 123, 456)
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceRunning, instance.Status)
+
+	// The wait ends once the reboot event has finished rather than on the first poll of the instance
+	info := httpmock.GetCallCountInfo()
+	require.Equal(t, 1, info["POST =~"+mockRequestURL(t, "linode/instances/123/reboot").String()])
+	require.Equal(t, 2, info["GET =~"+mockRequestURL(t, "account/events").String()])
+	require.Equal(t, 1, info["GET =~"+mockRequestURL(t, "linode/instances/123$").String()])
+}
+
+func TestInstance_BootAndWait(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/boot"),
+		mockRequestBodyValidate(t, map[string]int{"config_id": 456}, map[string]any{}))
+
+	mockInstanceEvents(t, linodego.ActionLinodeBoot, "", linodego.EventStarted, linodego.EventFinished)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123, Status: linodego.InstanceRunning}))
+
+	instance, err := client.BootInstanceAndWait(context.Background(), 123, 456, 5)
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceRunning, instance.Status)
+}
+
+func TestInstance_RebootAndWaitFailed(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/reboot"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	mockInstanceEvents(t, linodego.ActionLinodeReboot, "Config not found", linodego.EventStarted, linodego.EventFailed)
+
+	_, err := client.RebootInstanceAndWait(context.Background(), 123, 0, 5)
+	require.ErrorIs(t, err, linodego.ErrEventFailed)
+	require.ErrorContains(t, err, "linode_reboot of instance 123 (event 456): Config not found")
+	require.NotErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestInstance_ShutdownAndWaitTimeout(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/shutdown"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	mockInstanceEvents(t, linodego.ActionLinodeShutdown, "", linodego.EventStarted)
+
+	// The deadline of the context is respected even though timeoutSeconds is longer
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.ShutdownInstanceAndWait(ctx, 123, 60)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NotErrorIs(t, err, linodego.ErrEventFailed)
+}
+
+func TestInstance_UpdateBackupSchedule(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123"),
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, `{"backups": {"schedule": {"day": "Sunday", "window": "W4"}}}`, string(body))
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"id": 123,
+				"backups": map[string]any{
+					"enabled":  true,
+					"schedule": map[string]any{"day": "Sunday", "window": "W4"},
+				},
+			})
+		})
+
+	instance, err := client.UpdateInstance(context.Background(), 123, linodego.InstanceUpdateOptions{
+		Backups: &linodego.InstanceBackup{
+			Schedule: linodego.InstanceBackupSchedule{Day: "Sunday", Window: "W4"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceBackupSchedule{Day: "Sunday", Window: "W4"}, instance.Backups.Schedule)
+}
@@ -0,0 +1,320 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTwoPageInstances responds with instances 1-3 on page 1 and 4-5 on page 2
+func mockTwoPageInstances(t *testing.T) *[]string {
+	t.Helper()
+
+	requested := make([]string, 0)
+	pages := map[string][]linodego.Instance{
+		"1": {{ID: 1}, {ID: 2}, {ID: 3}},
+		"2": {{ID: 4}, {ID: 5}},
+	}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances"),
+		func(req *http.Request) (*http.Response, error) {
+			page := req.URL.Query().Get("page")
+			requested = append(requested, page)
+
+			pageNumber, err := strconv.Atoi(page)
+			require.NoError(t, err)
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data":    pages[page],
+				"page":    pageNumber,
+				"pages":   2,
+				"results": 5,
+			})
+		})
+
+	return &requested
+}
+
+func TestIterator_TwoPages(t *testing.T) {
+	client := createMockClient(t)
+	requested := mockTwoPageInstances(t)
+
+	it := client.InstancesIterator(nil)
+	ids := make([]int, 0)
+
+	for it.HasNext() {
+		instance, ok, err := it.Next(context.Background())
+		require.NoError(t, err)
+
+		if !ok {
+			break
+		}
+
+		ids = append(ids, instance.ID)
+
+		// Pages are only fetched once the previous page has been consumed
+		if instance.ID <= 3 {
+			require.Equal(t, []string{"1"}, *requested)
+		}
+	}
+
+	require.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+	require.Equal(t, []string{"1", "2"}, *requested)
+
+	_, ok, err := it.Next(context.Background())
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestIterator_ErrorMidIteration(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances"),
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("page") == "2" {
+				return httpmock.NewJsonResponse(400, linodego.APIError{
+					Errors: []linodego.APIErrorReason{{Reason: "page unavailable"}},
+				})
+			}
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data":    []linodego.Instance{{ID: 1}},
+				"page":    1,
+				"pages":   2,
+				"results": 2,
+			})
+		})
+
+	it := client.InstancesIterator(nil)
+	require.Zero(t, it.Results())
+
+	instance, ok, err := it.Next(context.Background())
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 1, instance.ID)
+	require.Equal(t, 2, it.Results())
+
+	_, ok, err = it.Next(context.Background())
+	require.ErrorContains(t, err, "page unavailable")
+	require.False(t, ok)
+}
+
+func TestIterator_StartPage(t *testing.T) {
+	client := createMockClient(t)
+	requested := mockTwoPageInstances(t)
+
+	it := linodego.NewIterator(client.ListInstances, linodego.NewListOptions(2, ""))
+	ids := make([]int, 0)
+
+	for it.HasNext() {
+		instance, ok, err := it.Next(context.Background())
+		require.NoError(t, err)
+
+		if !ok {
+			break
+		}
+
+		ids = append(ids, instance.ID)
+	}
+
+	require.Equal(t, []int{4, 5}, ids)
+	require.Equal(t, []string{"2"}, *requested)
+}
+
+func TestIterator_Empty(t *testing.T) {
+	client := createMockClient(t)
+
+	mockPaginatedResponse(t, "volumes", []linodego.Volume{}, 0)
+
+	it := client.VolumesIterator(nil)
+	require.True(t, it.HasNext())
+
+	_, ok, err := it.Next(context.Background())
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.False(t, it.HasNext())
+}
+
+func TestIterator_PageSizeAndFilter(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances"),
+		func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, "25", req.URL.Query().Get("page_size"))
+			require.Equal(t, `{"label":"foo"}`, req.Header.Get("X-Filter"))
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data":    []linodego.Instance{{ID: 1}},
+				"page":    1,
+				"pages":   1,
+				"results": 1,
+			})
+		})
+
+	it := client.InstancesIterator(&linodego.ListOptions{PageSize: 25, Filter: `{"label":"foo"}`})
+
+	instance, ok, err := it.Next(context.Background())
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 1, instance.ID)
+
+	_, ok, err = it.Next(context.Background())
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestIterator_ContextCancelled(t *testing.T) {
+	client := createMockClient(t)
+	requested := mockTwoPageInstances(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	it := client.InstancesIterator(nil)
+
+	for range 2 {
+		_, ok, err := it.Next(ctx)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	cancel()
+
+	// Buffered results are not returned once the context is cancelled
+	_, ok, err := it.Next(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.False(t, ok)
+	require.Equal(t, []string{"1"}, *requested, "only the consumed page should be fetched")
+}
+
+func TestForEach_ContextCancelled(t *testing.T) {
+	client := createMockClient(t)
+	mockTwoPageInstances(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ids := make([]int, 0)
+	err := client.ForEachInstance(ctx, nil, func(instance linodego.Instance) error {
+		ids = append(ids, instance.ID)
+		if instance.ID == 2 {
+			cancel()
+		}
+
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int{1, 2}, ids)
+}
+
+func TestForEach_Instances(t *testing.T) {
+	client := createMockClient(t)
+	requested := mockTwoPageInstances(t)
+
+	ids := make([]int, 0)
+	err := client.ForEachInstance(context.Background(), nil, func(instance linodego.Instance) error {
+		ids = append(ids, instance.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+	require.Equal(t, []string{"1", "2"}, *requested)
+}
+
+func TestForEach_StopIteration(t *testing.T) {
+	client := createMockClient(t)
+	requested := mockTwoPageInstances(t)
+
+	ids := make([]int, 0)
+	err := client.ForEachInstance(context.Background(), nil, func(instance linodego.Instance) error {
+		ids = append(ids, instance.ID)
+		if instance.ID == 2 {
+			return linodego.ErrStopIteration
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, ids)
+
+	// The second page is never fetched
+	require.Equal(t, []string{"1"}, *requested)
+}
+
+func TestForEach_CallbackError(t *testing.T) {
+	client := createMockClient(t)
+	mockTwoPageInstances(t)
+
+	errBoom := errors.New("boom")
+
+	err := client.ForEachInstance(context.Background(), nil, func(instance linodego.Instance) error {
+		if instance.ID == 4 {
+			return fmt.Errorf("instance %d: %w", instance.ID, errBoom)
+		}
+
+		return nil
+	})
+	require.ErrorIs(t, err, errBoom)
+	require.ErrorContains(t, err, "instance 4")
+}
+
+func TestForEach_VolumesAndEvents(t *testing.T) {
+	client := createMockClient(t)
+
+	mockPaginatedResponse(t, "volumes", []linodego.Volume{{ID: 1}, {ID: 2}}, 2)
+	mockPaginatedResponse(t, "account/events", []linodego.Event{{ID: 3}}, 1)
+
+	ids := make([]int, 0)
+
+	require.NoError(t, client.ForEachVolume(context.Background(), nil, func(volume linodego.Volume) error {
+		ids = append(ids, volume.ID)
+		return nil
+	}))
+
+	require.NoError(t, client.ForEachEvent(context.Background(), nil, func(event linodego.Event) error {
+		ids = append(ids, event.ID)
+		return nil
+	}))
+
+	require.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestIterator_Events(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/events"),
+		func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, `{"action":"linode_boot"}`, req.Header.Get("X-Filter"))
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data":    []linodego.Event{{ID: 11}, {ID: 12}},
+				"page":    1,
+				"pages":   1,
+				"results": 2,
+			})
+		})
+
+	it := client.EventsIterator(linodego.NewListOptions(0, `{"action":"linode_boot"}`))
+	ids := make([]int, 0)
+
+	for it.HasNext() {
+		event, ok, err := it.Next(context.Background())
+		require.NoError(t, err)
+
+		if !ok {
+			break
+		}
+
+		ids = append(ids, event.ID)
+	}
+
+	require.Equal(t, []int{11, 12}, ids)
+	require.Equal(t, 2, it.Results())
+}
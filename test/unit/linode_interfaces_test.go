@@ -0,0 +1,217 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+const interfaceSettingsResponse = `{
+	"network_helper": true,
+	"default_route": {
+		"ipv4_interface_id": 101,
+		"ipv4_eligible_interface_ids": [101, 102],
+		"ipv6_interface_id": 101,
+		"ipv6_eligible_interface_ids": [101]
+	}
+}`
+
+func TestLinodeInterface_Get(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/interfaces/102"),
+		httpmock.NewStringResponder(200, `{
+			"id": 102,
+			"version": 1,
+			"mac_address": "22:00:AB:CD:EF:01",
+			"created": "2025-01-01T00:01:01",
+			"updated": "2025-01-01T00:01:01",
+			"default_route": {"ipv4": true},
+			"public": null,
+			"vpc": {
+				"vpc_id": 5,
+				"subnet_id": 6,
+				"ipv4": {
+					"addresses": [{"address": "10.0.0.2", "primary": true, "nat_1_1_address": null}],
+					"ranges": [{"range": "10.0.0.16/28"}]
+				}
+			},
+			"vlan": null
+		}`))
+
+	iface, err := client.GetInterface(context.Background(), 123, 102)
+	require.NoError(t, err)
+	require.Equal(t, 102, iface.ID)
+	require.NotNil(t, iface.Created)
+	require.True(t, *iface.DefaultRoute.IPv4)
+	require.Nil(t, iface.DefaultRoute.IPv6)
+	require.Nil(t, iface.Public)
+	require.Equal(t, 5, iface.VPC.VPCID)
+	require.Equal(t, "10.0.0.2", iface.VPC.IPv4.Addresses[0].Address)
+	require.Equal(t, "10.0.0.16/28", iface.VPC.IPv4.Ranges[0].Range)
+}
+
+func TestLinodeInterface_SettingsRoundTrip(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/interfaces/settings"),
+		httpmock.NewStringResponder(200, interfaceSettingsResponse))
+
+	settings, err := client.GetInterfaceSettings(context.Background(), 123)
+	require.NoError(t, err)
+	require.True(t, settings.NetworkHelper)
+	require.Equal(t, 101, *settings.DefaultRoute.IPv4InterfaceID)
+	require.Equal(t, []int{101, 102}, settings.DefaultRoute.IPv4EligibleInterfaceIDs)
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123/interfaces/settings"),
+		mockRequestBodyValidate(t, linodego.InterfaceSettingsUpdateOptions{
+			NetworkHelper: linodego.Pointer(false),
+		}, linodego.InterfaceSettings{DefaultRoute: settings.DefaultRoute}))
+
+	updated, err := client.UpdateInterfaceSettings(context.Background(), 123, linodego.InterfaceSettingsUpdateOptions{
+		NetworkHelper: linodego.Pointer(false),
+	})
+	require.NoError(t, err)
+	require.False(t, updated.NetworkHelper)
+	require.Equal(t, settings.DefaultRoute, updated.DefaultRoute)
+}
+
+func TestLinodeInterface_SetDefaultRouteInterface(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/interfaces/settings"),
+		httpmock.NewStringResponder(200, interfaceSettingsResponse))
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123/interfaces/settings"),
+		func(req *http.Request) (*http.Response, error) {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+
+			// Only the IPv4 route should be moved; IPv6 is left untouched.
+			require.Equal(t, map[string]any{
+				"default_route": map[string]any{"ipv4_interface_id": float64(102)},
+			}, body)
+
+			return httpmock.NewStringResponse(200, `{
+				"network_helper": true,
+				"default_route": {
+					"ipv4_interface_id": 102,
+					"ipv4_eligible_interface_ids": [101, 102],
+					"ipv6_interface_id": 101,
+					"ipv6_eligible_interface_ids": [101]
+				}
+			}`), nil
+		})
+
+	settings, err := client.SetDefaultRouteInterface(context.Background(), 123, 102, true, false)
+	require.NoError(t, err)
+	require.Equal(t, 102, *settings.DefaultRoute.IPv4InterfaceID)
+	require.Equal(t, 101, *settings.DefaultRoute.IPv6InterfaceID)
+}
+
+func TestLinodeInterface_SetDefaultRouteInterfaceNotEligible(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/interfaces/settings"),
+		httpmock.NewStringResponder(200, interfaceSettingsResponse))
+
+	_, err := client.SetDefaultRouteInterface(context.Background(), 123, 102, true, true)
+	require.ErrorContains(t, err, "IPv6 default route")
+}
+
+func TestLinodeInterface_SetPrimaryInterface(t *testing.T) {
+	client := createMockClient(t)
+
+	// 101 is the public interface and 102 the VPC interface, which has no IPv6 route
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/interfaces/settings"),
+		httpmock.NewStringResponder(200, interfaceSettingsResponse))
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123/interfaces/settings"),
+		mockRequestBodyValidate(t, linodego.InterfaceSettingsUpdateOptions{
+			DefaultRoute: &linodego.InterfaceDefaultRouteSettingUpdate{IPv4InterfaceID: linodego.Pointer(102)},
+		}, linodego.InterfaceSettings{
+			NetworkHelper: true,
+			DefaultRoute: linodego.InterfaceDefaultRouteSetting{
+				IPv4InterfaceID:          linodego.Pointer(102),
+				IPv4EligibleInterfaceIDs: []int{101, 102},
+				IPv6InterfaceID:          linodego.Pointer(101),
+				IPv6EligibleInterfaceIDs: []int{101},
+			},
+		}))
+
+	settings, err := client.SetPrimaryInterface(context.Background(), 123, 102)
+	require.NoError(t, err)
+	require.Equal(t, 102, *settings.DefaultRoute.IPv4InterfaceID)
+
+	// The public interface is already primary, so no update is made
+	settings, err = client.SetPrimaryInterface(context.Background(), 123, 101)
+	require.NoError(t, err)
+	require.Equal(t, 101, *settings.DefaultRoute.IPv4InterfaceID)
+	require.Equal(t, 1, httpmock.GetCallCountInfo()["PUT =~"+mockRequestURL(t, "linode/instances/123/interfaces/settings").String()])
+}
+
+func TestLinodeInterface_SetPrimaryInterfaceNotEligible(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/interfaces/settings"),
+		httpmock.NewStringResponder(200, interfaceSettingsResponse))
+
+	_, err := client.SetPrimaryInterface(context.Background(), 123, 103)
+	require.ErrorContains(t, err, "not eligible to be the IPv4 default route")
+}
+
+func TestLinodeInterface_List(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/interfaces$"),
+		httpmock.NewStringResponder(200, `{"interfaces": [
+			{"id": 101, "mac_address": "22:00:AB:CD:EF:00", "public": {"ipv4": null, "ipv6": null}},
+			{"id": 102, "mac_address": "22:00:AB:CD:EF:01", "vlan": {"vlan_label": "backend", "ipam_address": "10.0.0.1/24"}}
+		]}`))
+
+	ifaces, err := client.ListInterfaces(context.Background(), 123)
+	require.NoError(t, err)
+	require.Len(t, ifaces, 2)
+	require.Equal(t, 101, ifaces[0].ID)
+	require.NotNil(t, ifaces[0].Public)
+	require.Equal(t, "backend", ifaces[1].VLAN.VLANLabel)
+}
+
+func TestLinodeInterface_CreateUpdateDelete(t *testing.T) {
+	client := createMockClient(t)
+
+	createOpts := linodego.LinodeInterfaceCreateOptions{
+		FirewallID: linodego.Pointer(7),
+		VLAN:       &linodego.VLANInterface{VLANLabel: "backend", IPAMAddress: linodego.Pointer("10.0.0.1/24")},
+	}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/interfaces$"),
+		mockRequestBodyValidate(t, createOpts, linodego.LinodeInterface{ID: 103, VLAN: createOpts.VLAN}))
+
+	updateOpts := linodego.LinodeInterfaceUpdateOptions{
+		DefaultRoute: &linodego.InterfaceDefaultRoute{IPv4: linodego.Pointer(true)},
+	}
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123/interfaces/103$"),
+		mockRequestBodyValidate(t, updateOpts, linodego.LinodeInterface{ID: 103, DefaultRoute: updateOpts.DefaultRoute}))
+
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "linode/instances/123/interfaces/103$"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	iface, err := client.CreateInterface(context.Background(), 123, createOpts)
+	require.NoError(t, err)
+	require.Equal(t, 103, iface.ID)
+	require.Equal(t, "backend", iface.VLAN.VLANLabel)
+
+	iface, err = client.UpdateInterface(context.Background(), 123, 103, updateOpts)
+	require.NoError(t, err)
+	require.True(t, *iface.DefaultRoute.IPv4)
+
+	require.NoError(t, client.DeleteInterface(context.Background(), 123, 103))
+	require.Equal(t, 1, httpmock.GetCallCountInfo()["DELETE =~"+mockRequestURL(t, "linode/instances/123/interfaces/103$").String()])
+}
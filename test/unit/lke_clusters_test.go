@@ -2,10 +2,14 @@ package unit
 
 import (
 	"context"
+	"encoding/base64"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLKECluster_Regenerate(t *testing.T) {
@@ -24,6 +28,65 @@ func TestLKECluster_Regenerate(t *testing.T) {
 	}
 }
 
+func TestLKECluster_Recycle(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "lke/clusters/1234/recycle"), httpmock.NewStringResponder(200, "{}"))
+
+	if err := client.RecycleLKEClusterNodes(context.Background(), 1234); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLKECluster_CreateEnterprise(t *testing.T) {
+	client := createMockClient(t)
+
+	strategy := linodego.LKENodePoolUpdateStrategyOnRecycle
+
+	opts := linodego.LKEClusterCreateOptions{
+		Label:      "enterprise",
+		Region:     "us-lax",
+		K8sVersion: "v1.31.1+lke1",
+		Tier:       linodego.LKEClusterTierEnterprise,
+		NodePools: []linodego.LKENodePoolCreateOptions{
+			{Count: 3, Type: "g6-standard-2", UpdateStrategy: &strategy},
+		},
+	}
+
+	cluster := linodego.LKECluster{ID: 1234, Label: "enterprise", Tier: linodego.LKEClusterTierEnterprise}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "lke/clusters"),
+		mockRequestBodyValidate(t, opts, cluster))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "lke/clusters/1234"),
+		httpmock.NewStringResponder(200, `{"id": 1234, "label": "enterprise", "tier": "enterprise"}`))
+
+	created, err := client.CreateLKECluster(context.Background(), opts)
+	require.NoError(t, err)
+	require.Equal(t, linodego.LKEClusterTierEnterprise, created.Tier)
+
+	fetched, err := client.GetLKECluster(context.Background(), 1234)
+	require.NoError(t, err)
+	require.Equal(t, linodego.LKEClusterTierEnterprise, fetched.Tier)
+	require.Equal(t, linodego.LKEClusterTierEnterprise, fetched.GetCreateOptions().Tier)
+}
+
+func TestLKECluster_CreateTierValidation(t *testing.T) {
+	client := createMockClient(t)
+
+	for _, tier := range []string{"", linodego.LKEClusterTierStandard} {
+		_, err := client.CreateLKECluster(context.Background(), linodego.LKEClusterCreateOptions{
+			Tier: tier,
+			NodePools: []linodego.LKENodePoolCreateOptions{
+				{Count: 1, Type: "g6-standard-2"},
+				{Count: 1, Type: "g6-standard-2", K8sVersion: linodego.Pointer("v1.31.1+lke1")},
+			},
+		})
+		require.EqualError(t, err, "node_pools[1].k8s_version is only available for enterprise LKE clusters")
+	}
+
+	require.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
 func TestLKECluster_DeleteServiceToken(t *testing.T) {
 	client := createMockClient(t)
 
@@ -33,3 +96,157 @@ func TestLKECluster_DeleteServiceToken(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestLKECluster_UpdateControlPlaneACL(t *testing.T) {
+	client := createMockClient(t)
+
+	// Empty address lists are sent to clear the addresses, rather than omitted
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "clusters/1234/control_plane_acl"),
+		mockRequestBodyValidate(t, map[string]any{
+			"acl": map[string]any{
+				"enabled":   false,
+				"addresses": map[string]any{"ipv4": []any{}, "ipv6": []any{}},
+			},
+		}, map[string]any{
+			"acl": map[string]any{"enabled": false},
+		}))
+
+	result, err := client.UpdateLKEClusterControlPlaneACL(context.Background(), 1234, linodego.LKEClusterControlPlaneACLUpdateOptions{
+		ACL: linodego.LKEClusterControlPlaneACLOptions{
+			Enabled: linodego.Pointer(false),
+			Addresses: &linodego.LKEClusterControlPlaneACLAddressesOptions{
+				IPv4: &[]string{},
+				IPv6: &[]string{},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, result.ACL.Enabled)
+	require.Nil(t, result.ACL.Addresses)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "clusters/1234/control_plane_acl"),
+		httpmock.NewStringResponder(200, `{"acl": {"enabled": true, "addresses": {"ipv4": ["10.0.0.1", "192.0.2.0/24"], "ipv6": []}}}`))
+
+	acl, err := client.GetLKEClusterControlPlaneACL(context.Background(), 1234)
+	require.NoError(t, err)
+	require.True(t, acl.ACL.Enabled)
+	require.Equal(t, []string{"10.0.0.1", "192.0.2.0/24"}, acl.ACL.Addresses.IPv4)
+	require.Empty(t, acl.ACL.Addresses.IPv6)
+}
+
+func TestLKECluster_UpdateControlPlaneACLValidation(t *testing.T) {
+	client := createMockClient(t)
+
+	valid := linodego.LKEClusterControlPlaneACLAddressesOptions{
+		IPv4: &[]string{"10.0.0.1", "192.0.2.0/24"},
+		IPv6: &[]string{"2001:db8::1", "2001:db8::/32"},
+	}
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "clusters/1234/control_plane_acl"),
+		httpmock.NewStringResponder(200, `{"acl": {"enabled": true}}`))
+
+	_, err := client.UpdateLKEClusterControlPlaneACL(context.Background(), 1234, linodego.LKEClusterControlPlaneACLUpdateOptions{
+		ACL: linodego.LKEClusterControlPlaneACLOptions{Enabled: linodego.Pointer(true), Addresses: &valid},
+	})
+	require.NoError(t, err)
+
+	for _, addresses := range []linodego.LKEClusterControlPlaneACLAddressesOptions{
+		{IPv4: &[]string{"10.0.0.0/33"}},
+		{IPv4: &[]string{"not-an-address"}},
+		{IPv4: &[]string{"2001:db8::/32"}},
+		{IPv6: &[]string{"192.0.2.0/24"}},
+		{IPv6: &[]string{"::ffff:192.0.2.1"}},
+	} {
+		_, err := client.UpdateLKEClusterControlPlaneACL(context.Background(), 1234, linodego.LKEClusterControlPlaneACLUpdateOptions{
+			ACL: linodego.LKEClusterControlPlaneACLOptions{Enabled: linodego.Pointer(true), Addresses: &addresses},
+		})
+		require.Error(t, err, "expected an error for %+v", addresses)
+	}
+
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestLKECluster_WaitForReady(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	kubeconfig := "apiVersion: v1\nclusters:\n- name: lke1234\nkind: Config\n"
+	kubeconfigCalls := 0
+
+	// The Kubeconfig is not found while the cluster is being provisioned
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "clusters/1234/kubeconfig"),
+		func(_ *http.Request) (*http.Response, error) {
+			kubeconfigCalls++
+			if kubeconfigCalls == 1 {
+				return httpmock.NewJsonResponse(404, linodego.APIError{Errors: []linodego.APIErrorReason{{Reason: "Not found"}}})
+			}
+
+			return httpmock.NewJsonResponse(200, linodego.LKEClusterKubeconfig{
+				KubeConfig: base64.StdEncoding.EncodeToString([]byte(kubeconfig)),
+			})
+		})
+
+	statuses := []linodego.LKELinodeStatus{linodego.LKELinodeNotReady, linodego.LKELinodeReady}
+	poolCalls := 0
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "clusters/1234/pools"),
+		func(_ *http.Request) (*http.Response, error) {
+			status := statuses[min(poolCalls, len(statuses)-1)]
+			poolCalls++
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data": []linodego.LKENodePool{{
+					ID:    1,
+					Count: 2,
+					Linodes: []linodego.LKENodePoolLinode{
+						{ID: "1-a", Status: linodego.LKELinodeReady},
+						{ID: "1-b", Status: status},
+					},
+				}},
+				"page":    1,
+				"pages":   1,
+				"results": 1,
+			})
+		})
+
+	var updates []string
+
+	result, err := client.WaitForLKEClusterReady(context.Background(), 1234, 5,
+		linodego.WithWaitObserver(func(update linodego.WaitUpdate) {
+			updates = append(updates, update.Status)
+		}))
+	require.NoError(t, err)
+	require.Equal(t, kubeconfig, string(result))
+	require.Equal(t, []string{"", "not_ready", "ready"}, updates)
+
+	// The Kubeconfig is not requested again once it has been retrieved
+	require.Equal(t, 2, kubeconfigCalls)
+	require.Equal(t, 2, poolCalls)
+}
+
+func TestLKECluster_WaitForReadyInvalidKubeconfig(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		kubeconfig string
+		err        string
+	}{
+		{"not yaml", "<html>not a kubeconfig</html>", "invalid kubeconfig"},
+		{"no apiVersion", "clusters:\n- name: lke1234\n", "kubeconfig has no apiVersion"},
+		{"no clusters", "apiVersion: v1\nclusters: []\nkind: Config\n", "kubeconfig has no clusters"},
+		// A clusters key that is not at the top level is not the Kubeconfig's list of clusters
+		{"nested clusters", "apiVersion: v1\nkind: Config\npreferences:\n  clusters:\n  - name: lke1234\n", "kubeconfig has no clusters"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := createMockClient(t)
+			client.SetPollDelay(time.Millisecond)
+
+			httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "clusters/1234/kubeconfig"),
+				httpmock.NewJsonResponderOrPanic(200, linodego.LKEClusterKubeconfig{
+					KubeConfig: base64.StdEncoding.EncodeToString([]byte(tc.kubeconfig)),
+				}))
+
+			_, err := client.WaitForLKEClusterReady(context.Background(), 1234, 5)
+			require.ErrorContains(t, err, "failed to decode Kubeconfig for LKE cluster 1234: "+tc.err)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLKENodePool_UpdateAutoscaler(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "lke/clusters/123/pools/456"),
+		func(req *http.Request) (*http.Response, error) {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+
+			// Only the autoscaler is sent so that the count and tags are left unchanged
+			require.Equal(t, map[string]any{
+				"autoscaler": map[string]any{"enabled": true, "min": float64(2), "max": float64(5)},
+			}, body)
+
+			return httpmock.NewJsonResponse(200, linodego.LKENodePool{
+				ID:         456,
+				Count:      3,
+				Tags:       []string{"production"},
+				Autoscaler: linodego.LKENodePoolAutoscaler{Enabled: true, Min: 2, Max: 5},
+			})
+		})
+
+	pool, err := client.UpdateLKENodePoolAutoscaler(context.Background(), 123, 456, linodego.LKENodePoolAutoscaler{
+		Enabled: true,
+		Min:     2,
+		Max:     5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, linodego.LKENodePoolAutoscaler{Enabled: true, Min: 2, Max: 5}, pool.Autoscaler)
+	require.Equal(t, 3, pool.Count)
+}
+
+func TestLKENodePool_UpdateAutoscalerValidation(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.UpdateLKENodePoolAutoscaler(context.Background(), 123, 456, linodego.LKENodePoolAutoscaler{
+		Enabled: true,
+		Min:     0,
+		Max:     3,
+	})
+	require.ErrorContains(t, err, "autoscaler min 0 must be at least 1")
+
+	_, err = client.UpdateLKENodePoolAutoscaler(context.Background(), 123, 456, linodego.LKENodePoolAutoscaler{
+		Enabled: true,
+		Min:     4,
+		Max:     3,
+	})
+	require.ErrorContains(t, err, "autoscaler min 4 must not be greater than max 3")
+
+	require.Zero(t, httpmock.GetTotalCallCount())
+}
+
+func TestLKENodePool_Recycle(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "lke/clusters/123/pools/456/recycle"),
+		httpmock.NewStringResponder(200, "{}"))
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "lke/clusters/123/nodes/123-abc/recycle"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	require.NoError(t, client.RecycleLKENodePool(context.Background(), 123, 456))
+	require.NoError(t, client.RecycleLKENodePoolNode(context.Background(), 123, "123-abc"))
+}
+
+func TestLKENodePool_RecycleNodeError(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "lke/clusters/123/nodes/123-abc/recycle"),
+		func(_ *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(404, linodego.APIError{
+				Errors: []linodego.APIErrorReason{{Reason: "Not found"}},
+			})
+		})
+
+	err := client.RecycleLKENodePoolNode(context.Background(), 123, "123-abc")
+	require.Error(t, err)
+	require.True(t, linodego.IsNotFound(err))
+}
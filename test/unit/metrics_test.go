@@ -0,0 +1,143 @@
+package unit
+
+import (
+	"context"
+	"expvar"
+	"net/http"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+type observedRequest struct {
+	Endpoint string
+	Method   string
+	Status   int
+	Retries  int
+}
+
+type fakeMetricsCollector struct {
+	mu       sync.Mutex
+	observed []observedRequest
+}
+
+func (f *fakeMetricsCollector) ObserveRequest(endpoint, method string, status int, _ time.Duration, retries int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.observed = append(f.observed, observedRequest{endpoint, method, status, retries})
+}
+
+func TestMetrics_EndpointTemplate(t *testing.T) {
+	client := createMockClient(t)
+
+	collector := &fakeMetricsCollector{}
+	client.SetMetricsCollector(collector)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/disks/456"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceDisk{ID: 456}))
+
+	_, err := client.GetInstanceDisk(context.Background(), 123, 456)
+	require.NoError(t, err)
+
+	require.Equal(t, []observedRequest{
+		{Endpoint: "/linode/instances/{id}/disks/{id}", Method: http.MethodGet, Status: 200},
+	}, collector.observed)
+}
+
+func TestMetrics_Retries(t *testing.T) {
+	client := createMockClient(t)
+	client.SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond)
+
+	collector := &fakeMetricsCollector{}
+	client.SetMetricsCollector(collector)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		httpmock.NewJsonResponderOrPanic(http.StatusTooManyRequests, linodego.APIError{
+			Errors: []linodego.APIErrorReason{{Reason: "Too Many Requests"}},
+		}).Times(1).Then(httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123})))
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+
+	require.Equal(t, []observedRequest{
+		{Endpoint: "/linode/instances/{id}", Method: http.MethodGet, Status: http.StatusTooManyRequests},
+		{Endpoint: "/linode/instances/{id}", Method: http.MethodGet, Status: 200, Retries: 1},
+	}, collector.observed)
+}
+
+func TestMetrics_RetriedConnectionReset(t *testing.T) {
+	client := createMockClient(t)
+	client.SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond)
+
+	collector := &fakeMetricsCollector{}
+	client.SetMetricsCollector(collector)
+
+	// The connection is reset twice before the request succeeds
+	calls := 0
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		func(_ *http.Request) (*http.Response, error) {
+			calls++
+			if calls <= 2 {
+				return nil, syscall.ECONNRESET
+			}
+
+			return httpmock.NewJsonResponse(200, linodego.Instance{ID: 123})
+		})
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+
+	// Each attempt failing without a response is observed, not only the last attempt
+	require.Equal(t, []observedRequest{
+		{Endpoint: "/linode/instances/{id}", Method: http.MethodGet},
+		{Endpoint: "/linode/instances/{id}", Method: http.MethodGet, Retries: 1},
+		{Endpoint: "/linode/instances/{id}", Method: http.MethodGet, Status: 200, Retries: 2},
+	}, collector.observed)
+}
+
+func TestMetrics_ConnectionResetWithoutRetries(t *testing.T) {
+	client := createMockClient(t)
+	client.SetRetryCount(0)
+
+	collector := &fakeMetricsCollector{}
+	client.SetMetricsCollector(collector)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		httpmock.NewErrorResponder(syscall.ECONNRESET))
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.Error(t, err)
+
+	require.Equal(t, []observedRequest{
+		{Endpoint: "/linode/instances/{id}", Method: http.MethodGet},
+	}, collector.observed)
+}
+
+func TestMetrics_Expvar(t *testing.T) {
+	client := createMockClient(t)
+
+	collector := linodego.NewExpvarMetricsCollector("linodego_test_metrics")
+	client.SetMetricsCollector(collector)
+
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "volumes/789"),
+		httpmock.NewJsonResponderOrPanic(http.StatusNotFound, linodego.APIError{
+			Errors: []linodego.APIErrorReason{{Reason: "Not found"}},
+		}))
+
+	err := client.DeleteVolume(context.Background(), 789)
+	require.True(t, linodego.IsNotFound(err))
+
+	published, ok := expvar.Get("linodego_test_metrics").(*expvar.Map)
+	require.True(t, ok)
+
+	key := "DELETE /volumes/{id}"
+	require.Equal(t, "1", published.Get("requests").(*expvar.Map).Get(key).String())
+	require.Equal(t, "1", published.Get("errors").(*expvar.Map).Get(key).String())
+	require.Nil(t, collector.Retries.Get(key))
+}
@@ -0,0 +1,153 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationChannel_CreateAndAttach(t *testing.T) {
+	client := createMockClient(t)
+
+	opts := linodego.NotificationChannelCreateOptions{
+		Label:       "On-call",
+		ChannelType: linodego.NotificationChannelTypeEmail,
+		Content: linodego.NotificationChannelContent{
+			Email: &linodego.NotificationChannelEmail{EmailAddresses: []string{"oncall@example.com"}},
+		},
+	}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "monitor/alert-channels"),
+		mockRequestBodyValidate(t, opts, linodego.NotificationChannel{
+			ID:          20,
+			Label:       opts.Label,
+			ChannelType: opts.ChannelType,
+			Content:     opts.Content,
+		}))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "monitor/services/linode/alert-definitions/1"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.AlertDefinition{
+			ID:            1,
+			AlertChannels: []linodego.AlertDefinitionChannel{{ID: 10}},
+		}))
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "monitor/services/linode/alert-definitions/1"),
+		mockRequestBodyValidate(t, linodego.AlertDefinitionUpdateOptions{ChannelIDs: []int{10, 20}},
+			linodego.AlertDefinition{
+				ID:            1,
+				AlertChannels: []linodego.AlertDefinitionChannel{{ID: 10}, {ID: 20, Label: "On-call", Type: "email"}},
+			}))
+
+	channel, err := client.CreateNotificationChannel(context.Background(), opts)
+	require.NoError(t, err)
+	require.Equal(t, 20, channel.ID)
+
+	alert, err := client.AttachNotificationChannels(context.Background(), linodego.MonitorServiceTypeLinode, 1, channel.ID)
+	require.NoError(t, err)
+	require.Len(t, alert.AlertChannels, 2)
+	require.Equal(t, "On-call", alert.AlertChannels[1].Label)
+
+	// Attaching a channel again does not update the alert definition
+	_, err = client.AttachNotificationChannels(context.Background(), linodego.MonitorServiceTypeLinode, 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, httpmock.GetCallCountInfo()["PUT =~"+mockRequestURL(t, "monitor/services/linode/alert-definitions/1").String()])
+}
+
+func TestNotificationChannel_CreateValidation(t *testing.T) {
+	client := createMockClient(t)
+
+	tests := []struct {
+		name    string
+		opts    linodego.NotificationChannelCreateOptions
+		wantErr string
+	}{
+		{
+			name: "invalid email",
+			opts: linodego.NotificationChannelCreateOptions{
+				Label:       "email",
+				ChannelType: linodego.NotificationChannelTypeEmail,
+				Content: linodego.NotificationChannelContent{
+					Email: &linodego.NotificationChannelEmail{EmailAddresses: []string{"ops@example.com", "not-an-email"}},
+				},
+			},
+			wantErr: `invalid email address "not-an-email"`,
+		},
+		{
+			name: "email with display name",
+			opts: linodego.NotificationChannelCreateOptions{
+				Label:       "email",
+				ChannelType: linodego.NotificationChannelTypeEmail,
+				Content: linodego.NotificationChannelContent{
+					Email: &linodego.NotificationChannelEmail{EmailAddresses: []string{"Ops <ops@example.com>"}},
+				},
+			},
+			wantErr: `invalid email address "Ops <ops@example.com>"`,
+		},
+		{
+			name:    "email without addresses",
+			opts:    linodego.NotificationChannelCreateOptions{Label: "email", ChannelType: linodego.NotificationChannelTypeEmail},
+			wantErr: "content.email.email_addresses is required",
+		},
+		{
+			name: "webhook scheme",
+			opts: linodego.NotificationChannelCreateOptions{
+				Label:       "webhook",
+				ChannelType: linodego.NotificationChannelTypeWebhook,
+				Content: linodego.NotificationChannelContent{
+					Webhook: &linodego.NotificationChannelWebhook{WebhookURL: "ftp://example.com/alerts"},
+				},
+			},
+			wantErr: `invalid webhook URL "ftp://example.com/alerts"`,
+		},
+		{
+			name: "webhook without host",
+			opts: linodego.NotificationChannelCreateOptions{
+				Label:       "webhook",
+				ChannelType: linodego.NotificationChannelTypeWebhook,
+				Content: linodego.NotificationChannelContent{
+					Webhook: &linodego.NotificationChannelWebhook{WebhookURL: "example.com/alerts"},
+				},
+			},
+			wantErr: `invalid webhook URL "example.com/alerts"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.CreateNotificationChannel(context.Background(), tt.opts)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	require.Zero(t, httpmock.GetTotalCallCount(), "expected the requests to be rejected locally")
+}
+
+func TestNotificationChannel_ListAndGet(t *testing.T) {
+	client := createMockClient(t)
+
+	channel := linodego.NotificationChannel{
+		ID:          20,
+		Label:       "Webhook",
+		ChannelType: linodego.NotificationChannelTypeWebhook,
+		Content: linodego.NotificationChannelContent{
+			Webhook: &linodego.NotificationChannelWebhook{WebhookURL: "https://example.com/alerts"},
+		},
+		Alerts: linodego.NotificationChannelAlerts{AlertCount: 2},
+	}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "monitor/alert-channels/20"),
+		httpmock.NewJsonResponderOrPanic(200, channel))
+
+	mockPaginatedResponse(t, "monitor/alert-channels", []linodego.NotificationChannel{channel}, 1)
+
+	channels, err := client.ListNotificationChannels(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, []linodego.NotificationChannel{channel}, channels)
+
+	result, err := client.GetNotificationChannel(context.Background(), 20)
+	require.NoError(t, err)
+	require.Equal(t, channel, *result)
+}
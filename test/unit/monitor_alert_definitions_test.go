@@ -0,0 +1,147 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertDefinition_CreateAndGet(t *testing.T) {
+	client := createMockClient(t)
+
+	opts := linodego.AlertDefinitionCreateOptions{
+		Label:      "High CPU",
+		Severity:   linodego.AlertSeverityMedium,
+		ChannelIDs: []int{10},
+		EntityIDs:  []string{"123"},
+		RuleCriteria: linodego.AlertRuleCriteria{
+			Rules: []linodego.AlertRule{{
+				Metric:            "cpu_usage",
+				AggregateFunction: linodego.MetricAggregateAverage,
+				Operator:          linodego.AlertRuleOperatorGreaterThan,
+				Threshold:         90,
+			}},
+		},
+		TriggerConditions: linodego.AlertTriggerConditions{
+			CriteriaCondition:       "ALL",
+			EvaluationPeriodSeconds: 300,
+			PollingIntervalSeconds:  60,
+			TriggerOccurrences:      3,
+		},
+	}
+
+	var created map[string]any
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "monitor/services/linode/alert-definitions"),
+		func(req *http.Request) (*http.Response, error) {
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&created))
+
+			created["id"] = 1
+			created["service_type"] = "linode"
+			created["status"] = "enabled"
+			created["type"] = "user"
+			created["created"] = "2025-01-02T03:04:05"
+			created["alert_channels"] = []map[string]any{{"id": 10, "label": "email", "type": "email"}}
+			delete(created, "channel_ids")
+
+			return httpmock.NewJsonResponse(200, created)
+		})
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "monitor/services/linode/alert-definitions/1"),
+		func(_ *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, created)
+		})
+
+	alert, err := client.CreateAlertDefinition(context.Background(), linodego.MonitorServiceTypeLinode, opts)
+	require.NoError(t, err)
+	require.Equal(t, 1, alert.ID)
+
+	alert, err = client.GetAlertDefinition(context.Background(), linodego.MonitorServiceTypeLinode, alert.ID)
+	require.NoError(t, err)
+	require.Equal(t, linodego.AlertDefinitionStatusEnabled, alert.Status)
+	require.Equal(t, 2025, alert.Created.Year())
+	require.Equal(t, opts, alert.GetCreateOptions())
+}
+
+func TestAlertDefinition_CreateValidation(t *testing.T) {
+	client := createMockClient(t)
+
+	rule := linodego.AlertRule{
+		Metric:            "cpu_usage",
+		AggregateFunction: linodego.MetricAggregateAverage,
+		Operator:          linodego.AlertRuleOperatorGreaterThan,
+		Threshold:         90,
+	}
+
+	tests := []struct {
+		name    string
+		opts    linodego.AlertDefinitionCreateOptions
+		wantErr string
+	}{
+		{
+			name:    "no channels",
+			opts:    linodego.AlertDefinitionCreateOptions{Label: "cpu", RuleCriteria: linodego.AlertRuleCriteria{Rules: []linodego.AlertRule{rule}}},
+			wantErr: "at least one channel ID is required",
+		},
+		{
+			name:    "no rules",
+			opts:    linodego.AlertDefinitionCreateOptions{Label: "cpu", ChannelIDs: []int{10}},
+			wantErr: "at least one rule is required",
+		},
+		{
+			name: "unknown operator",
+			opts: linodego.AlertDefinitionCreateOptions{
+				Label:        "cpu",
+				ChannelIDs:   []int{10},
+				RuleCriteria: linodego.AlertRuleCriteria{Rules: []linodego.AlertRule{{Metric: "cpu_usage", AggregateFunction: "avg", Operator: ">"}}},
+			},
+			wantErr: `rule_criteria.rules[0].operator ">" is not a known operator`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.CreateAlertDefinition(context.Background(), linodego.MonitorServiceTypeLinode, tt.opts)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	require.Zero(t, httpmock.GetTotalCallCount(), "expected the requests to be rejected locally")
+}
+
+func TestAlertDefinition_ListUpdateDelete(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "monitor/alert-definitions"),
+		httpmock.NewStringResponder(200, `{
+			"data": [{"id": 1, "label": "High CPU", "service_type": "linode", "severity": 1}],
+			"page": 1,
+			"pages": 1,
+			"results": 1
+		}`))
+
+	status := linodego.AlertDefinitionStatusDisabled
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "monitor/services/linode/alert-definitions/1"),
+		mockRequestBodyValidate(t, linodego.AlertDefinitionUpdateOptions{Status: status},
+			linodego.AlertDefinition{ID: 1, Status: status}))
+
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "monitor/services/linode/alert-definitions/1"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	alerts, err := client.ListAlertDefinitions(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	require.Equal(t, linodego.AlertSeverityMedium, alerts[0].Severity)
+
+	alert, err := client.UpdateAlertDefinition(context.Background(), linodego.MonitorServiceTypeLinode, 1,
+		linodego.AlertDefinitionUpdateOptions{Status: status})
+	require.NoError(t, err)
+	require.Equal(t, status, alert.Status)
+
+	require.NoError(t, client.DeleteAlertDefinition(context.Background(), linodego.MonitorServiceTypeLinode, 1))
+}
@@ -0,0 +1,91 @@
+package unit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+const monitorDashboardJSON = `{
+	"id": 1,
+	"label": "Linode Service I/O Statistics",
+	"service_type": "linode",
+	"type": "standard",
+	"created": "2024-10-10T05:01:58",
+	"updated": "2024-10-10T05:01:58",
+	"widgets": [{
+		"metric": "cpu_usage",
+		"unit": "%",
+		"label": "CPU Usage",
+		"color": "blue",
+		"size": 12,
+		"chart_type": "area",
+		"y_label": "cpu_usage",
+		"aggregate_function": "avg"
+	}]
+}`
+
+func TestMonitorServices_List(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "monitor/services"),
+		httpmock.NewStringResponder(200, `{
+			"data": [
+				{"service_type": "dbaas", "label": "Databases"},
+				{"service_type": "linode", "label": "Linodes"},
+				{"service_type": "nodebalancer", "label": "NodeBalancers"}
+			],
+			"page": 1,
+			"pages": 1,
+			"results": 3
+		}`))
+
+	services, err := client.ListMonitorServices(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, services, 3)
+
+	for _, service := range services {
+		require.True(t, service.ServiceType.IsValid(), "unknown service type %q", service.ServiceType)
+	}
+
+	require.Equal(t, "Linodes", services[1].Label)
+}
+
+func TestMonitorDashboards_List(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "monitor/dashboards"),
+		httpmock.NewStringResponder(200, `{"data": [`+monitorDashboardJSON+`], "page": 1, "pages": 1, "results": 1}`))
+
+	dashboards, err := client.ListMonitorDashboards(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, dashboards, 1)
+	require.Equal(t, linodego.MonitorServiceTypeLinode, dashboards[0].ServiceType)
+	require.Equal(t, "cpu_usage", dashboards[0].Widgets[0].Metric)
+}
+
+func TestMonitorDashboards_Get(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "monitor/dashboards/1"),
+		httpmock.NewStringResponder(200, monitorDashboardJSON))
+
+	dashboard, err := client.GetMonitorDashboard(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, dashboard.ID)
+	require.Equal(t, time.Date(2024, 10, 10, 5, 1, 58, 0, time.UTC), *dashboard.Created)
+	require.Equal(t, linodego.MonitorDashboardWidget{
+		Metric:            "cpu_usage",
+		Unit:              "%",
+		Label:             "CPU Usage",
+		Color:             "blue",
+		Size:              12,
+		ChartType:         "area",
+		YLabel:            "cpu_usage",
+		AggregateFunction: linodego.MetricAggregateAverage,
+	}, dashboard.Widgets[0])
+}
@@ -0,0 +1,73 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstance_GetMetrics(t *testing.T) {
+	client := createMockClient(t)
+	client.SetMonitorAPIURL("https://monitor.example.com/v2beta/")
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "monitor/services/linode/token"),
+		mockRequestBodyValidate(t, map[string]any{"entity_ids": []any{float64(123)}}, map[string]any{"token": "jwe-token"}))
+
+	httpmock.RegisterResponder("POST", "https://monitor.example.com/v2beta/monitor/services/linode/metrics",
+		func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, "Bearer jwe-token", req.Header.Get("Authorization"))
+
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			require.Equal(t, map[string]any{
+				"entity_ids":             []any{float64(123)},
+				"metrics":                []any{map[string]any{"name": "cpu_usage", "aggregate_function": "max"}},
+				"relative_time_duration": map[string]any{"unit": "hr", "value": float64(1)},
+				"time_granularity":       map[string]any{"unit": "min", "value": float64(5)},
+			}, body)
+
+			return httpmock.NewStringResponse(200, `{
+				"status": "success",
+				"data": {
+					"resultType": "matrix",
+					"result": [{
+						"metric": {"entity_id": 123, "metric_name": "cpu_usage"},
+						"values": [[1715000000, "12.5"], [1715000300, "40"]]
+					}]
+				}
+			}`), nil
+		})
+
+	series, err := client.GetInstanceMetrics(context.Background(), 123, linodego.MetricsQueryOptions{
+		Metrics:     []string{"cpu_usage"},
+		Aggregation: linodego.MetricAggregateMax,
+		Window:      time.Hour,
+		Granularity: 5 * time.Minute,
+	})
+	require.NoError(t, err)
+	require.Len(t, series, 1)
+
+	require.Equal(t, "cpu_usage", series[0].Name)
+	require.Equal(t, 123, series[0].EntityID)
+	require.Equal(t, []linodego.MetricPoint{
+		{Time: time.Unix(1715000000, 0).UTC(), Value: 12.5},
+		{Time: time.Unix(1715000300, 0).UTC(), Value: 40},
+	}, series[0].Points)
+}
+
+func TestInstance_GetMetricsInvalidWindow(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.GetInstanceMetrics(context.Background(), 123, linodego.MetricsQueryOptions{
+		Metrics: []string{"cpu_usage"},
+		Window:  90 * time.Second,
+	})
+	require.ErrorContains(t, err, "invalid window")
+	require.Equal(t, 0, httpmock.GetTotalCallCount())
+}
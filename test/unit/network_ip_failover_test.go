@@ -0,0 +1,161 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// mockFailoverLinodes registers a primary Linode 123 and a secondary Linode 456 in the given
+// region for the secondary, with the secondary already sharing an IPv4 address and an IPv6 range.
+// The primary is assigned 45.79.1.2 and 2600:3c03:e000:123::/64.
+func mockFailoverLinodes(t *testing.T, secondaryRegion string) {
+	t.Helper()
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123, Region: "us-east"}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/456$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 456, Region: secondaryRegion}))
+
+	// Ranges routed to the primary Linode's SLAAC address belong to it
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/ips"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceIPAddressResponse{
+			IPv4: &linodego.InstanceIPv4Response{
+				Public: []*linodego.InstanceIP{{Address: "45.79.1.2"}},
+				Shared: []*linodego.InstanceIP{{Address: "45.79.1.9"}},
+			},
+			IPv6: &linodego.InstanceIPv6Response{
+				SLAAC: &linodego.InstanceIP{Address: "2600:3c03::1"},
+				Global: []linodego.IPv6Range{
+					{Range: "2600:3c03:e000:1::", Prefix: 64, RouteTarget: "2600:3c03::1"},
+					{Range: "2600:3c03:e000:123::", Prefix: 64, RouteTarget: "2600:3c03::1"},
+					{Range: "2600:3c03:e000:9::", Prefix: 64, RouteTarget: "2600:3c03::7"},
+				},
+			},
+		}))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/456/ips"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceIPAddressResponse{
+			IPv4: &linodego.InstanceIPv4Response{
+				Shared: []*linodego.InstanceIP{{Address: "45.79.1.3"}},
+			},
+			IPv6: &linodego.InstanceIPv6Response{
+				SLAAC: &linodego.InstanceIP{Address: "2600:3c03::2"},
+				Global: []linodego.IPv6Range{
+					{Range: "2600:3c03:e000:1::", Prefix: 64, RouteTarget: "2600:3c03::1"},
+				},
+			},
+		}))
+}
+
+func TestIPFailover_ConfigureIPv4(t *testing.T) {
+	client := createMockClient(t)
+	mockFailoverLinodes(t, "us-east")
+
+	// Addresses already shared with the secondary Linode are preserved
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "networking/ips/share"),
+		mockRequestBodyValidate(t, linodego.IPAddressesShareOptions{
+			IPs:      []string{"45.79.1.3", "2600:3c03:e000:1::/64", "45.79.1.2"},
+			LinodeID: 456,
+		}, map[string]any{}))
+
+	guidance, err := client.ConfigureFailover(context.Background(), linodego.FailoverOptions{
+		PrimaryLinodeID:   123,
+		SecondaryLinodeID: 456,
+		Address:           "45.79.1.2",
+	})
+	require.NoError(t, err)
+	require.Equal(t, &linodego.FailoverGuidance{
+		Method:  linodego.FailoverMethodBGP,
+		Address: "45.79.1.2",
+		Prefix:  32,
+		Daemon:  linodego.FailoverDaemonLelastic,
+	}, guidance)
+}
+
+func TestIPFailover_ConfigureIPv6RangeClassic(t *testing.T) {
+	client := createMockClient(t)
+	mockFailoverLinodes(t, "us-east")
+
+	// The range's region does not use BGP, so it cannot be shared
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "networking/ipv6/ranges/"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.IPv6Range{Range: "2600:3c03:e000:123::", Prefix: 64}))
+
+	_, err := client.ConfigureFailover(context.Background(), linodego.FailoverOptions{
+		PrimaryLinodeID:   123,
+		SecondaryLinodeID: 456,
+		Address:           "2600:3c03:e000:123::/64",
+		Method:            linodego.FailoverMethodBGP,
+	})
+	require.ErrorContains(t, err, "can only be shared in regions using BGP-based failover")
+	require.Zero(t, httpmock.GetCallCountInfo()["POST =~"+mockRequestURL(t, "networking/ips/share").String()])
+}
+
+func TestIPFailover_ConfigureIPv6RangeBGP(t *testing.T) {
+	client := createMockClient(t)
+	mockFailoverLinodes(t, "us-east")
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "networking/ipv6/ranges/"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.IPv6Range{Range: "2600:3c03:e000:123::", Prefix: 64, IsBGP: true}))
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "networking/ips/share"),
+		mockRequestBodyValidate(t, linodego.IPAddressesShareOptions{
+			IPs:      []string{"45.79.1.3", "2600:3c03:e000:1::/64", "2600:3c03:e000:123::/64"},
+			LinodeID: 456,
+		}, map[string]any{}))
+
+	guidance, err := client.ConfigureFailover(context.Background(), linodego.FailoverOptions{
+		PrimaryLinodeID:   123,
+		SecondaryLinodeID: 456,
+		Address:           "2600:3c03:e000:123::/64",
+	})
+	require.NoError(t, err)
+	require.Equal(t, &linodego.FailoverGuidance{
+		Method:  linodego.FailoverMethodBGP,
+		Address: "2600:3c03:e000:123::/64",
+		Prefix:  64,
+		Daemon:  linodego.FailoverDaemonLelastic,
+	}, guidance)
+}
+
+func TestIPFailover_ConfigureUnassignedAddress(t *testing.T) {
+	for _, address := range []string{
+		// An address of another Linode in the region
+		"45.79.1.4",
+		// An address shared with the primary Linode rather than assigned to it
+		"45.79.1.9",
+		// A range routed to another Linode and shared with the primary Linode
+		"2600:3c03:e000:9::/64",
+	} {
+		t.Run(address, func(t *testing.T) {
+			client := createMockClient(t)
+			mockFailoverLinodes(t, "us-east")
+
+			httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "networking/ipv6/ranges/"),
+				httpmock.NewJsonResponderOrPanic(200, linodego.IPv6Range{Range: "2600:3c03:e000:9::", Prefix: 64, IsBGP: true}))
+
+			_, err := client.ConfigureFailover(context.Background(), linodego.FailoverOptions{
+				PrimaryLinodeID:   123,
+				SecondaryLinodeID: 456,
+				Address:           address,
+			})
+			require.EqualError(t, err, "address "+address+" is not assigned to linode 123")
+			require.Zero(t, httpmock.GetCallCountInfo()["POST =~"+mockRequestURL(t, "networking/ips/share").String()])
+		})
+	}
+}
+
+func TestIPFailover_ConfigureDifferentRegions(t *testing.T) {
+	client := createMockClient(t)
+	mockFailoverLinodes(t, "us-west")
+
+	_, err := client.ConfigureFailover(context.Background(), linodego.FailoverOptions{
+		PrimaryLinodeID:   123,
+		SecondaryLinodeID: 456,
+		Address:           "45.79.1.2",
+	})
+	require.ErrorContains(t, err, "linodes 123 and 456 must be in the same region to share addresses")
+}
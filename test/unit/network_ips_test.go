@@ -0,0 +1,174 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPAddresses_ListByRegion(t *testing.T) {
+	client := createMockClient(t)
+
+	ips := []linodego.InstanceIP{
+		{Address: "192.0.2.10", Type: linodego.IPTypeIPv4, Public: true, LinodeID: 123, Region: "us-east"},
+		{Address: "192.168.128.10", Type: linodego.IPTypeIPv4, LinodeID: 123, Region: "us-east"},
+		{Address: "192.0.2.20", Type: linodego.IPTypeIPv4, Public: true, Region: "us-east", Reserved: true},
+		{Address: "198.51.100.5", Type: linodego.IPTypeIPv4, Public: true, LinodeID: 456, Region: "eu-west"},
+	}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "networking/ips$"),
+		func(req *http.Request) (*http.Response, error) {
+			var filter map[string]any
+			require.NoError(t, json.Unmarshal([]byte(req.Header.Get("X-Filter")), &filter))
+
+			var matched []linodego.InstanceIP
+			for _, ip := range ips {
+				if ip.Region == filter["region"] {
+					matched = append(matched, ip)
+				}
+			}
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data":    matched,
+				"page":    1,
+				"pages":   1,
+				"results": len(matched),
+			})
+		})
+
+	result, err := client.ListIPAddressesByRegion(context.Background(), "us-east")
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+
+	// The listing includes the public IP of the instance and the unassigned reserved IP
+	require.Equal(t, "192.0.2.10", result[0].Address)
+	require.Equal(t, 123, result[0].LinodeID)
+	require.True(t, result[0].Public)
+	require.Zero(t, result[2].LinodeID)
+	require.True(t, result[2].Reserved)
+}
+
+func TestIPAddresses_UpdateInvalidAddress(t *testing.T) {
+	client := createMockClient(t)
+
+	for _, address := range []string{"", "12345", "192.0.2.10/../../account"} {
+		_, err := client.UpdateIPAddress(context.Background(), address, linodego.IPAddressUpdateOptions{})
+		require.ErrorIs(t, err, linodego.ErrInvalidAddress, address)
+	}
+
+	require.Zero(t, httpmock.GetTotalCallCount(), "expected the requests to be rejected locally")
+}
+
+func TestIPAddresses_UpdateIPv6(t *testing.T) {
+	client := createMockClient(t)
+
+	rdns := "v6.example.com"
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "networking/ips/2001:db8::1"),
+		mockRequestBodyValidate(t, linodego.IPAddressUpdateOptions{RDNS: &rdns},
+			linodego.InstanceIP{Address: "2001:db8::1", RDNS: rdns}))
+
+	ip, err := client.UpdateIPAddress(context.Background(), "2001:db8::1", linodego.IPAddressUpdateOptions{RDNS: &rdns})
+	require.NoError(t, err)
+	require.Equal(t, rdns, ip.RDNS)
+}
+
+func TestIPAddresses_AssignIPv6Range(t *testing.T) {
+	client := createMockClient(t)
+
+	opts := linodego.LinodesAssignIPsOptions{
+		Region: "us-east",
+		Assignments: []linodego.LinodeIPAssignment{
+			{Address: "192.0.2.10", LinodeID: 123},
+			{Address: "2600:3c03:e000:3e6::/64", LinodeID: 456},
+		},
+	}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "networking/ips/assign"),
+		mockRequestBodyValidate(t, opts, map[string]any{}))
+
+	require.NoError(t, client.InstancesAssignIPs(context.Background(), opts))
+}
+
+func TestIPAddresses_AssignRequiresAssignments(t *testing.T) {
+	client := createMockClient(t)
+
+	err := client.InstancesAssignIPs(context.Background(), linodego.LinodesAssignIPsOptions{Region: "us-east"})
+	require.ErrorContains(t, err, "at least one assignment is required")
+	require.Zero(t, httpmock.GetTotalCallCount(), "expected the request to be rejected locally")
+}
+
+func TestIPAddresses_Share(t *testing.T) {
+	client := createMockClient(t)
+
+	opts := linodego.IPAddressesShareOptions{IPs: []string{"192.0.2.10"}, LinodeID: 456}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "networking/ips/share"),
+		mockRequestBodyValidate(t, opts, map[string]any{}))
+
+	require.NoError(t, client.ShareIPAddresses(context.Background(), opts))
+}
+
+func TestIPAddresses_UpdateRDNSBody(t *testing.T) {
+	client := createMockClient(t)
+
+	var bodies []string
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "networking/ips/"),
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+
+			bodies = append(bodies, string(body))
+
+			return httpmock.NewJsonResponse(200, linodego.InstanceIP{Address: path.Base(req.URL.Path)})
+		})
+
+	rdns := "www.example.com"
+
+	_, err := client.UpdateIPAddress(context.Background(), "192.0.2.10", linodego.IPAddressUpdateOptions{RDNS: &rdns})
+	require.NoError(t, err)
+
+	_, err = client.UpdateIPAddress(context.Background(), "192.0.2.10", linodego.IPAddressUpdateOptions{})
+	require.NoError(t, err)
+
+	require.JSONEq(t, `{"rdns":"www.example.com"}`, bodies[0])
+	require.Contains(t, bodies[1], `"rdns":null`)
+}
+
+func TestIPAddresses_ResetRDNS(t *testing.T) {
+	client := createMockClient(t)
+
+	var addresses []string
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "networking/ips/"),
+		func(req *http.Request) (*http.Response, error) {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			require.Equal(t, map[string]any{"rdns": nil}, body)
+
+			address := path.Base(req.URL.Path)
+			if address == "192.0.2.11" {
+				return httpmock.NewStringResponse(404, `{"errors":[{"reason":"Not found"}]}`), nil
+			}
+
+			addresses = append(addresses, address)
+
+			return httpmock.NewJsonResponse(200, linodego.InstanceIP{Address: address})
+		})
+
+	// The IPv6 address is within a range routed to an Instance
+	ips, err := client.ResetIPAddressesRDNS(context.Background(), "192.0.2.10", "192.0.2.11", "2600:3c03:e000:3e6::10")
+	require.ErrorContains(t, err, "failed to reset the RDNS of 192.0.2.11")
+	require.True(t, linodego.IsNotFound(err))
+
+	require.Equal(t, []string{"192.0.2.10", "2600:3c03:e000:3e6::10"}, addresses)
+	require.Len(t, ips, 2)
+	require.Equal(t, "2600:3c03:e000:3e6::10", ips[1].Address)
+}
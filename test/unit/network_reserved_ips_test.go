@@ -0,0 +1,140 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservedIPs_ListUnassigned(t *testing.T) {
+	client := createMockClient(t)
+
+	reserved := linodego.InstanceIP{Address: "192.0.2.10", Region: "us-east", Reserved: true, Public: true}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "networking/reserved/ips"),
+		mockRequestBodyValidate(t, linodego.ReserveIPOptions{Region: "us-east"}, reserved))
+
+	ip, err := client.ReserveIPAddress(context.Background(), linodego.ReserveIPOptions{Region: "us-east"})
+	require.NoError(t, err)
+
+	mockPaginatedResponse(t, "networking/reserved/ips", []linodego.InstanceIP{
+		{Address: "192.0.2.1", Region: "us-east", Reserved: true, LinodeID: 123},
+		*ip,
+	}, 2)
+
+	unassigned, err := client.ListUnassignedReservedIPs(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []linodego.UnassignedReservedIP{{InstanceIP: reserved}}, unassigned)
+}
+
+func TestReservedIPs_ListUnassignedAge(t *testing.T) {
+	client := createMockClient(t)
+
+	created := time.Now().UTC().Add(-48 * time.Hour).Format("2006-01-02T15:04:05")
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "networking/reserved/ips"),
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"data": []map[string]any{
+				{"address": "192.0.2.1", "region": "us-east", "created": created},
+				{"address": "192.0.2.2", "region": "us-east"},
+			},
+			"page":    1,
+			"pages":   1,
+			"results": 2,
+		}))
+
+	unassigned, err := client.ListUnassignedReservedIPs(context.Background())
+	require.NoError(t, err)
+	require.Len(t, unassigned, 2)
+
+	require.NotNil(t, unassigned[0].Age)
+	require.InDelta(t, 48*time.Hour, *unassigned[0].Age, float64(time.Minute))
+
+	// The age is unknown when the API does not return when the address was reserved
+	require.Nil(t, unassigned[1].Age)
+
+	_, ok := linodego.ReservedIPAge(unassigned[1].InstanceIP)
+	require.False(t, ok)
+}
+
+func TestReservedIPs_ListInRegionSortedByCreated(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "networking/reserved/ips"),
+		func(req *http.Request) (*http.Response, error) {
+			require.JSONEq(t, `{"region": "us-east"}`, req.Header.Get("X-Filter"))
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data": []map[string]any{
+					{"address": "192.0.2.3", "region": "us-east", "created": "2024-03-01T10:00:00"},
+					{"address": "192.0.2.1", "region": "us-east"},
+					{
+						"address": "192.0.2.2", "region": "us-east",
+						"created": "2023-11-15T08:30:00", "updated": "2024-01-02T12:00:00",
+					},
+				},
+				"page":    1,
+				"pages":   1,
+				"results": 3,
+			})
+		})
+
+	ips, err := client.ListReservedIPAddressesInRegion(context.Background(), "us-east")
+	require.NoError(t, err)
+	require.Len(t, ips, 3)
+
+	require.Equal(t, "192.0.2.2", ips[0].Address)
+	require.Equal(t, time.Date(2023, time.November, 15, 8, 30, 0, 0, time.UTC), *ips[0].Created)
+	require.Equal(t, time.Date(2024, time.January, 2, 12, 0, 0, 0, time.UTC), *ips[0].Updated)
+
+	require.Equal(t, "192.0.2.3", ips[1].Address)
+	require.Nil(t, ips[1].Updated)
+
+	require.Equal(t, "192.0.2.1", ips[2].Address)
+	require.Nil(t, ips[2].Created)
+}
+
+func TestReservedIPs_InvalidAddress(t *testing.T) {
+	client := createMockClient(t)
+
+	for _, address := range []string{
+		"",
+		"12345",
+		"999.999.999.999",
+		"192.0.2.10/32",
+		"../../linode/instances",
+		"192.0.2.10/../../account",
+		"2001:db8::1",
+		"::ffff:192.0.2.10",
+	} {
+		t.Run(address, func(t *testing.T) {
+			_, err := client.GetReservedIPAddress(context.Background(), address)
+			require.ErrorIs(t, err, linodego.ErrInvalidAddress)
+
+			err = client.DeleteReservedIPAddress(context.Background(), address)
+			require.ErrorIs(t, err, linodego.ErrInvalidAddress)
+		})
+	}
+
+	require.Zero(t, httpmock.GetTotalCallCount(), "expected the requests to be rejected locally")
+}
+
+func TestReservedIPs_GetAndDelete(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "networking/reserved/ips/192.0.2.10"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceIP{Address: "192.0.2.10", Reserved: true}))
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "networking/reserved/ips/192.0.2.10"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	ip, err := client.GetReservedIPAddress(context.Background(), "192.0.2.10")
+	require.NoError(t, err)
+	require.True(t, ip.Reserved)
+
+	require.NoError(t, client.DeleteReservedIPAddress(context.Background(), "192.0.2.10"))
+}
@@ -0,0 +1,145 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeBalancerNode_DrainAndDelete(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	var calls []string
+
+	nodePath := mockRequestURL(t, "nodebalancers/123/configs/456/nodes/789")
+
+	httpmock.RegisterRegexpResponder("PUT", nodePath, func(req *http.Request) (*http.Response, error) {
+		var opts linodego.NodeBalancerNodeUpdateOptions
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&opts))
+		require.Equal(t, linodego.ModeDrain, opts.Mode)
+
+		calls = append(calls, "drain")
+
+		return httpmock.NewJsonResponse(200, linodego.NodeBalancerNode{ID: 789, Mode: linodego.ModeDrain})
+	})
+
+	connections := []float64{3, 1, 0}
+	statsCalls := 0
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "nodebalancers/123/stats"),
+		func(_ *http.Request) (*http.Response, error) {
+			require.Equal(t, []string{"drain"}, calls, "stats should only be polled after draining")
+
+			value := connections[min(statsCalls, len(connections)-1)]
+			statsCalls++
+
+			return httpmock.NewJsonResponse(200, linodego.NodeBalancerStats{
+				Data: linodego.NodeBalancerStatsData{
+					Connections: [][]float64{{1700000000000, 5}, {1700000300000, value}},
+				},
+			})
+		})
+
+	httpmock.RegisterRegexpResponder("DELETE", nodePath, func(_ *http.Request) (*http.Response, error) {
+		calls = append(calls, "delete")
+		return httpmock.NewStringResponse(200, "{}"), nil
+	})
+
+	var statuses []string
+
+	err := client.DrainAndDeleteNodeBalancerNode(context.Background(), 123, 456, 789, time.Minute,
+		linodego.WithWaitObserver(func(update linodego.WaitUpdate) {
+			statuses = append(statuses, update.Status)
+		}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"drain", "delete"}, calls)
+	require.Equal(t, len(connections), statsCalls)
+	require.Equal(t, []string{"draining", "draining", "drained"}, statuses)
+}
+
+func TestNodeBalancerNode_DrainAndDeleteTimeout(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	var calls []string
+
+	nodePath := mockRequestURL(t, "nodebalancers/123/configs/456/nodes/789")
+
+	httpmock.RegisterRegexpResponder("PUT", nodePath, func(_ *http.Request) (*http.Response, error) {
+		calls = append(calls, "drain")
+		return httpmock.NewJsonResponse(200, linodego.NodeBalancerNode{ID: 789, Mode: linodego.ModeDrain})
+	})
+
+	// Stats are unavailable for new NodeBalancers; the drain timeout should still apply.
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "nodebalancers/123/stats"),
+		httpmock.NewStringResponder(400, `{"errors": [{"reason": "Stats are unavailable at this time."}]}`))
+
+	httpmock.RegisterRegexpResponder("DELETE", nodePath, func(_ *http.Request) (*http.Response, error) {
+		calls = append(calls, "delete")
+		return httpmock.NewStringResponse(200, "{}"), nil
+	})
+
+	err := client.DrainAndDeleteNodeBalancerNode(context.Background(), 123, 456, 789, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, []string{"drain", "delete"}, calls)
+}
+
+func TestNodeBalancerNode_DrainAndDeleteEmptyStats(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	nodePath := mockRequestURL(t, "nodebalancers/123/configs/456/nodes/789")
+
+	httpmock.RegisterRegexpResponder("PUT", nodePath,
+		httpmock.NewJsonResponderOrPanic(200, linodego.NodeBalancerNode{ID: 789, Mode: linodego.ModeDrain}))
+
+	// Stats without a connections sample do not show that the node has drained
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "nodebalancers/123/stats"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.NodeBalancerStats{
+			Data: linodego.NodeBalancerStatsData{Connections: [][]float64{}},
+		}))
+
+	httpmock.RegisterRegexpResponder("DELETE", nodePath, httpmock.NewStringResponder(200, "{}"))
+
+	start := time.Now()
+
+	err := client.DrainAndDeleteNodeBalancerNode(context.Background(), 123, 456, 789, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	require.Equal(t, 1, httpmock.GetCallCountInfo()["DELETE =~"+nodePath.String()])
+}
+
+func TestNodeBalancerNode_DrainAndDeleteCanceled(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	nodePath := mockRequestURL(t, "nodebalancers/123/configs/456/nodes/789")
+
+	httpmock.RegisterRegexpResponder("PUT", nodePath,
+		httpmock.NewJsonResponderOrPanic(200, linodego.NodeBalancerNode{ID: 789, Mode: linodego.ModeDrain}))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "nodebalancers/123/stats"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.NodeBalancerStats{
+			Data: linodego.NodeBalancerStatsData{
+				Connections: [][]float64{{1700000000000, 5}},
+			},
+		}))
+
+	httpmock.RegisterRegexpResponder("DELETE", nodePath, httpmock.NewStringResponder(200, "{}"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// The context ending before the drain timeout is an error, and the node is kept
+	err := client.DrainAndDeleteNodeBalancerNode(ctx, 123, 456, 789, time.Minute)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, "failed to wait for NodeBalancer 123 to drain")
+	require.Zero(t, httpmock.GetCallCountInfo()["DELETE =~"+nodePath.String()])
+}
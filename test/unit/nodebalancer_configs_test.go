@@ -0,0 +1,129 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeBalancerConfig_CreateHTTPCheckWithoutPath(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.CreateNodeBalancerConfig(context.Background(), 123, linodego.NodeBalancerConfigCreateOptions{
+		Port:  80,
+		Check: linodego.CheckHTTP,
+	})
+	require.ErrorContains(t, err, "check_path is required")
+	require.Zero(t, httpmock.GetTotalCallCount(), "expected the request to be rejected locally")
+}
+
+func TestNodeBalancerConfig_CreateOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    linodego.NodeBalancerConfigCreateOptions
+		wantErr string
+	}{
+		{
+			name: "connection check",
+			opts: linodego.NodeBalancerConfigCreateOptions{Check: linodego.CheckConnection},
+		},
+		{
+			name: "http check with path",
+			opts: linodego.NodeBalancerConfigCreateOptions{
+				Check:         linodego.CheckHTTP,
+				CheckPath:     "/healthz",
+				CheckInterval: 10,
+				CheckTimeout:  5,
+				CheckAttempts: 3,
+			},
+		},
+		{
+			name:    "http_body check without body",
+			opts:    linodego.NodeBalancerConfigCreateOptions{Check: linodego.CheckHTTPBody, CheckPath: "/"},
+			wantErr: "check_body is required",
+		},
+		{
+			name:    "interval out of bounds",
+			opts:    linodego.NodeBalancerConfigCreateOptions{CheckInterval: 1},
+			wantErr: "check_interval must be between 2 and 3600",
+		},
+		{
+			name:    "attempts out of bounds",
+			opts:    linodego.NodeBalancerConfigCreateOptions{CheckAttempts: 31},
+			wantErr: "check_attempts must be between 1 and 30",
+		},
+		{
+			name:    "timeout not less than interval",
+			opts:    linodego.NodeBalancerConfigCreateOptions{CheckInterval: 5, CheckTimeout: 5},
+			wantErr: "check_timeout (5) must be less than check_interval (5)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestNodeBalancerConfig_Rebuild(t *testing.T) {
+	client := createMockClient(t)
+
+	opts := linodego.NodeBalancerConfigRebuildOptions{
+		Port:     80,
+		Protocol: linodego.ProtocolHTTP,
+		Check:    linodego.CheckConnection,
+		Nodes: []linodego.NodeBalancerConfigRebuildNodeOptions{
+			{
+				ID: 456,
+				NodeBalancerNodeCreateOptions: linodego.NodeBalancerNodeCreateOptions{
+					Address: "192.168.0.10:80",
+					Label:   "existing",
+					Mode:    linodego.ModeDrain,
+				},
+			},
+			{
+				NodeBalancerNodeCreateOptions: linodego.NodeBalancerNodeCreateOptions{
+					Address: "[fd00::1]:8080",
+					Label:   "new",
+				},
+			},
+		},
+	}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "nodebalancers/123/configs/789/rebuild"),
+		mockRequestBodyValidate(t, opts, linodego.NodeBalancerConfig{ID: 789, Port: 80, NodesStatus: &linodego.NodeBalancerNodeStatus{Up: 2}}))
+
+	config, err := client.RebuildNodeBalancerConfig(context.Background(), 123, 789, opts)
+	require.NoError(t, err)
+	require.Equal(t, 789, config.ID)
+	require.Equal(t, 2, config.NodesStatus.Up)
+}
+
+func TestNodeBalancerConfig_RebuildInvalidNodes(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.RebuildNodeBalancerConfig(context.Background(), 123, 789, linodego.NodeBalancerConfigRebuildOptions{
+		Port: 80,
+		Nodes: []linodego.NodeBalancerConfigRebuildNodeOptions{
+			{NodeBalancerNodeCreateOptions: linodego.NodeBalancerNodeCreateOptions{Address: "192.168.0.10:80"}},
+			{NodeBalancerNodeCreateOptions: linodego.NodeBalancerNodeCreateOptions{Address: "192.168.0.11"}},
+			{NodeBalancerNodeCreateOptions: linodego.NodeBalancerNodeCreateOptions{Address: "192.168.0.12:99999"}},
+			{NodeBalancerNodeCreateOptions: linodego.NodeBalancerNodeCreateOptions{Address: "192.168.0.13:80", Mode: "standby"}},
+		},
+	})
+	require.ErrorContains(t, err, `nodes[1].address must be in the form host:port, got "192.168.0.11"`)
+	require.ErrorContains(t, err, `nodes[2].address must be in the form host:port, got "192.168.0.12:99999"`)
+	require.ErrorContains(t, err, `nodes[3].mode must be one of accept, reject, drain or backup, got "standby"`)
+	require.NotContains(t, err.Error(), "nodes[0]")
+	require.Zero(t, httpmock.GetTotalCallCount(), "expected the request to be rejected locally")
+}
@@ -0,0 +1,32 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeBalancerStats_Get(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "nodebalancers/123/stats"),
+		httpmock.NewStringResponder(200, `{
+			"title": "linode.com - balancer12345 (12345) - day (5 min avg)",
+			"data": {
+				"connections": [[1700000000000, 12], [1700000300000, 7.5]],
+				"traffic": {
+					"in": [[1700000000000, 2048.25]],
+					"out": [[1700000000000, 4096]]
+				}
+			}
+		}`))
+
+	stats, err := client.GetNodeBalancerStats(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, "linode.com - balancer12345 (12345) - day (5 min avg)", stats.Title)
+	require.Equal(t, [][]float64{{1700000000000, 12}, {1700000300000, 7.5}}, stats.Data.Connections)
+	require.Equal(t, [][]float64{{1700000000000, 2048.25}}, stats.Data.Traffic.In)
+	require.Equal(t, [][]float64{{1700000000000, 4096}}, stats.Data.Traffic.Out)
+}
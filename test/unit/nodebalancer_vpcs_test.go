@@ -0,0 +1,157 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeBalancer_CreateWithVPC(t *testing.T) {
+	client := createMockClient(t)
+
+	opts := linodego.NodeBalancerCreateOptions{
+		Label:  linodego.Pointer("internal-lb"),
+		Region: "us-mia",
+		Tags:   []string{},
+		VPCs: []linodego.NodeBalancerVPCOptions{
+			{SubnetID: 10, IPv4Range: "10.100.5.0/30"},
+		},
+		Configs: []*linodego.NodeBalancerConfigCreateOptions{
+			{
+				Port:     80,
+				Protocol: linodego.ProtocolHTTP,
+				Nodes: []linodego.NodeBalancerNodeCreateOptions{
+					{Address: "10.0.0.4:80", Label: "backend-1", SubnetID: 10},
+				},
+			},
+		},
+	}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "nodebalancers"),
+		mockRequestBodyValidate(t, opts, linodego.NodeBalancer{ID: 123, Region: "us-mia"}))
+
+	nodebalancer, err := client.CreateNodeBalancer(context.Background(), opts)
+	require.NoError(t, err)
+	require.Equal(t, 123, nodebalancer.ID)
+}
+
+func TestNodeBalancer_VPCConfigs(t *testing.T) {
+	client := createMockClient(t)
+
+	config := linodego.NodeBalancerVPCConfig{
+		ID:             7,
+		NodeBalancerID: 123,
+		VPCID:          1,
+		SubnetID:       10,
+		IPv4Range:      "10.100.5.0/30",
+	}
+
+	// The single config is registered first, as the list URL pattern also matches it
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "nodebalancers/123/vpcs/7"),
+		httpmock.NewJsonResponderOrPanic(200, config))
+
+	mockPaginatedResponse(t, "nodebalancers/123/vpcs", []linodego.NodeBalancerVPCConfig{config}, 1)
+
+	configs, err := client.ListNodeBalancerVPCConfigs(context.Background(), 123, nil)
+	require.NoError(t, err)
+	require.Equal(t, []linodego.NodeBalancerVPCConfig{config}, configs)
+
+	result, err := client.GetNodeBalancerVPCConfig(context.Background(), 123, 7)
+	require.NoError(t, err)
+	require.Equal(t, config, *result)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "nodebalancers/123/configs/456/nodes/789"),
+		httpmock.NewStringResponder(200, `{"id": 789, "address": "10.0.0.4:80", "vpc_config_id": 7}`))
+
+	node, err := client.GetNodeBalancerNode(context.Background(), 123, 456, 789)
+	require.NoError(t, err)
+	require.Equal(t, 7, node.VPCConfigID)
+}
+
+func TestNodeBalancer_VPCStrictValidation(t *testing.T) {
+	client := createMockClient(t)
+	client.SetStrictValidation(true)
+
+	mockPaginatedResponse(t, "vpcs", []linodego.VPC{
+		{ID: 1, Region: "us-mia", Subnets: []linodego.VPCSubnet{{ID: 10}}},
+		{ID: 2, Region: "us-east", Subnets: []linodego.VPCSubnet{{ID: 20}}},
+	}, 2)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "nodebalancers$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.NodeBalancer{ID: 123, Region: "us-mia"}))
+
+	_, err := client.CreateNodeBalancer(context.Background(), linodego.NodeBalancerCreateOptions{
+		Region: "us-mia",
+		VPCs:   []linodego.NodeBalancerVPCOptions{{SubnetID: 20}},
+	})
+	require.ErrorContains(t, err, "VPC subnet 20 is in region us-east, but the NodeBalancer is in region us-mia")
+
+	_, err = client.CreateNodeBalancer(context.Background(), linodego.NodeBalancerCreateOptions{
+		Region: "us-mia",
+		VPCs:   []linodego.NodeBalancerVPCOptions{{SubnetID: 10}},
+		Configs: []*linodego.NodeBalancerConfigCreateOptions{
+			{Port: 80, Nodes: []linodego.NodeBalancerNodeCreateOptions{{Address: "10.0.0.4:80", SubnetID: 30}}},
+		},
+	})
+	require.ErrorContains(t, err, "VPC subnet 30 does not exist")
+
+	_, err = client.CreateNodeBalancer(context.Background(), linodego.NodeBalancerCreateOptions{
+		Region: "us-mia",
+		VPCs:   []linodego.NodeBalancerVPCOptions{{SubnetID: 10}},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 1, httpmock.GetCallCountInfo()["POST =~"+mockRequestURL(t, "nodebalancers$").String()])
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "nodebalancers/123$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.NodeBalancer{ID: 123, Region: "us-mia"}))
+
+	_, err = client.CreateNodeBalancerNode(context.Background(), 123, 456, linodego.NodeBalancerNodeCreateOptions{
+		Address: "10.0.1.4:80", SubnetID: 20,
+	})
+	require.ErrorContains(t, err, "VPC subnet 20 is in region us-east")
+}
+
+func TestNodeBalancer_CreateVPCBackendValidation(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.CreateNodeBalancer(context.Background(), linodego.NodeBalancerCreateOptions{
+		Region: "us-mia",
+		VPCs:   []linodego.NodeBalancerVPCOptions{{SubnetID: 10}},
+		Configs: []*linodego.NodeBalancerConfigCreateOptions{
+			{Port: 80, Nodes: []linodego.NodeBalancerNodeCreateOptions{{Address: "10.0.0.4:80", SubnetID: 10}}},
+			{Port: 443, Nodes: []linodego.NodeBalancerNodeCreateOptions{
+				{Address: "10.0.0.4:443", SubnetID: 10},
+				{Address: "192.0.2.10:443"},
+			}},
+		},
+	})
+	require.ErrorContains(t, err, "configs[1]: nodes[0] and nodes[1] mix VPC and public backends")
+
+	_, err = client.CreateNodeBalancer(context.Background(), linodego.NodeBalancerCreateOptions{
+		Region: "us-mia",
+		Configs: []*linodego.NodeBalancerConfigCreateOptions{
+			{Port: 80, Nodes: []linodego.NodeBalancerNodeCreateOptions{{Address: "10.0.0.4:80", SubnetID: 10}}},
+		},
+	})
+	require.ErrorContains(t, err, "configs[0] has VPC backend nodes, but the NodeBalancer is not attached to a VPC")
+
+	require.Zero(t, httpmock.GetTotalCallCount(), "expected the requests to be rejected locally")
+}
+
+func TestNodeBalancerConfig_RebuildMixedBackends(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.RebuildNodeBalancerConfig(context.Background(), 123, 456, linodego.NodeBalancerConfigRebuildOptions{
+		Port: 80,
+		Nodes: []linodego.NodeBalancerConfigRebuildNodeOptions{
+			{NodeBalancerNodeCreateOptions: linodego.NodeBalancerNodeCreateOptions{Address: "192.0.2.10:80"}},
+			{NodeBalancerNodeCreateOptions: linodego.NodeBalancerNodeCreateOptions{Address: "10.0.0.4:80", SubnetID: 10}},
+		},
+	})
+	require.ErrorContains(t, err, "nodes[0] and nodes[1] mix VPC and public backends")
+	require.Zero(t, httpmock.GetTotalCallCount(), "expected the request to be rejected locally")
+}
@@ -0,0 +1,73 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPages responds with the requested page of the given number of pages,
+// each containing the single entry returned by entry for that page.
+func mockPages[T any](t *testing.T, path string, pages int, entry func(page int) T) {
+	t.Helper()
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, path),
+		func(req *http.Request) (*http.Response, error) {
+			page, err := strconv.Atoi(req.URL.Query().Get("page"))
+			require.NoError(t, err)
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data":    []T{entry(page)},
+				"page":    page,
+				"pages":   pages,
+				"results": pages,
+			})
+		})
+}
+
+func TestPagination_ListInstancesConcurrently(t *testing.T) {
+	client := createMockClient(t)
+
+	mockPages(t, "linode/instances", 25, func(page int) linodego.Instance {
+		return linodego.Instance{ID: page, Label: "linode-" + strconv.Itoa(page)}
+	})
+
+	sequential, err := client.ListInstances(context.Background(), nil)
+	require.NoError(t, err)
+
+	concurrent, err := client.ListInstances(context.Background(), &linodego.ListOptions{Concurrency: 5})
+	require.NoError(t, err)
+
+	require.Len(t, concurrent, 25)
+	require.Equal(t, sequential, concurrent)
+
+	for i, instance := range concurrent {
+		require.Equal(t, i+1, instance.ID)
+	}
+}
+
+func TestPagination_ListEventsConcurrently(t *testing.T) {
+	client := createMockClient(t)
+
+	mockPages(t, "account/events", 12, func(page int) linodego.Event {
+		return linodego.Event{ID: 1000 - page, Action: linodego.ActionLinodeBoot}
+	})
+
+	sequential, err := client.ListEvents(context.Background(), &linodego.ListOptions{Concurrency: 1})
+	require.NoError(t, err)
+
+	concurrent, err := client.ListEvents(context.Background(), &linodego.ListOptions{Concurrency: 16})
+	require.NoError(t, err)
+
+	require.Len(t, concurrent, 12)
+	require.Equal(t, sequential, concurrent)
+
+	for i, event := range concurrent {
+		require.Equal(t, 999-i, event.ID)
+	}
+}
@@ -0,0 +1,202 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// stackScriptDataOfSize returns stackscript_data whose JSON encoding is exactly size bytes
+func stackScriptDataOfSize(t *testing.T, size int) map[string]string {
+	t.Helper()
+
+	overhead, err := json.Marshal(map[string]string{"k": ""})
+	require.NoError(t, err)
+
+	data := map[string]string{"k": strings.Repeat("a", size-len(overhead))}
+
+	b, err := json.Marshal(data)
+	require.NoError(t, err)
+	require.Len(t, b, size)
+
+	return data
+}
+
+func requirePayloadTooLarge(t *testing.T, err error, field string, limit, size int) {
+	t.Helper()
+
+	require.ErrorIs(t, err, linodego.ErrPayloadTooLarge)
+
+	var payloadErr *linodego.PayloadTooLargeError
+	require.True(t, errors.As(err, &payloadErr))
+	require.Equal(t, field, payloadErr.Field)
+	require.Equal(t, limit, payloadErr.Limit)
+	require.Equal(t, size, payloadErr.Size)
+}
+
+func TestPayloadLimits_CreateInstance(t *testing.T) {
+	client := createMockClient(t)
+	limits := linodego.DefaultPayloadLimits
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123}))
+
+	atLimit := linodego.InstanceCreateOptions{
+		Region:          "us-east",
+		Type:            "g6-nanode-1",
+		Label:           strings.Repeat("a", limits.InstanceLabel),
+		Metadata:        &linodego.InstanceMetadataOptions{UserData: strings.Repeat("a", limits.UserData)},
+		StackScriptData: stackScriptDataOfSize(t, limits.StackScriptData),
+	}
+
+	_, err := client.CreateInstance(context.Background(), atLimit)
+	require.NoError(t, err)
+
+	opts := atLimit
+	opts.Label += "a"
+	_, err = client.CreateInstance(context.Background(), opts)
+	requirePayloadTooLarge(t, err, "label", limits.InstanceLabel, limits.InstanceLabel+1)
+
+	opts = atLimit
+	opts.Metadata = &linodego.InstanceMetadataOptions{UserData: strings.Repeat("a", limits.UserData+1)}
+	_, err = client.CreateInstance(context.Background(), opts)
+	requirePayloadTooLarge(t, err, "metadata.user_data", limits.UserData, limits.UserData+1)
+
+	opts = atLimit
+	opts.StackScriptData = stackScriptDataOfSize(t, limits.StackScriptData+1)
+	_, err = client.CreateInstance(context.Background(), opts)
+	requirePayloadTooLarge(t, err, "stackscript_data", limits.StackScriptData, limits.StackScriptData+1)
+
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestPayloadLimits_RebuildInstance(t *testing.T) {
+	client := createMockClient(t)
+	limits := linodego.DefaultPayloadLimits
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/rebuild"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123}))
+
+	_, err := client.RebuildInstance(context.Background(), 123, linodego.InstanceRebuildOptions{
+		Image:    "linode/debian12",
+		Metadata: &linodego.InstanceMetadataOptions{UserData: strings.Repeat("a", limits.UserData)},
+	})
+	require.NoError(t, err)
+
+	_, err = client.RebuildInstance(context.Background(), 123, linodego.InstanceRebuildOptions{
+		Image:    "linode/debian12",
+		Metadata: &linodego.InstanceMetadataOptions{UserData: strings.Repeat("a", limits.UserData+1)},
+	})
+	requirePayloadTooLarge(t, err, "metadata.user_data", limits.UserData, limits.UserData+1)
+
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestPayloadLimits_Stackscript(t *testing.T) {
+	client := createMockClient(t)
+	limits := linodego.DefaultPayloadLimits
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/stackscripts"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Stackscript{ID: 123}))
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/stackscripts/123"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Stackscript{ID: 123}))
+
+	atLimit := linodego.StackscriptCreateOptions{
+		Label:  strings.Repeat("a", limits.StackScriptLabel),
+		Script: strings.Repeat("a", limits.StackScript),
+	}
+
+	_, err := client.CreateStackscript(context.Background(), atLimit)
+	require.NoError(t, err)
+
+	_, err = client.UpdateStackscript(context.Background(), 123, linodego.StackscriptUpdateOptions(atLimit))
+	require.NoError(t, err)
+
+	opts := atLimit
+	opts.Label += "a"
+
+	_, err = client.CreateStackscript(context.Background(), opts)
+	requirePayloadTooLarge(t, err, "label", limits.StackScriptLabel, limits.StackScriptLabel+1)
+
+	_, err = client.UpdateStackscript(context.Background(), 123, linodego.StackscriptUpdateOptions(opts))
+	requirePayloadTooLarge(t, err, "label", limits.StackScriptLabel, limits.StackScriptLabel+1)
+
+	opts = atLimit
+	opts.Script += "a"
+
+	_, err = client.CreateStackscript(context.Background(), opts)
+	requirePayloadTooLarge(t, err, "script", limits.StackScript, limits.StackScript+1)
+
+	_, err = client.UpdateStackscript(context.Background(), 123, linodego.StackscriptUpdateOptions(opts))
+	requirePayloadTooLarge(t, err, "script", limits.StackScript, limits.StackScript+1)
+
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestPayloadLimits_Body(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/stackscripts"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Stackscript{ID: 123}))
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/stackscripts/123"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Stackscript{ID: 123}))
+
+	opts := linodego.StackscriptCreateOptions{Label: "test"}
+
+	body, err := json.Marshal(opts)
+	require.NoError(t, err)
+
+	limits := linodego.DefaultPayloadLimits
+	limits.Body = len(body)
+	client.SetPayloadLimits(limits)
+
+	_, err = client.CreateStackscript(context.Background(), opts)
+	require.NoError(t, err)
+
+	_, err = client.UpdateStackscript(context.Background(), 123, linodego.StackscriptUpdateOptions(opts))
+	require.NoError(t, err)
+
+	opts.Label += "a"
+
+	_, err = client.CreateStackscript(context.Background(), opts)
+	requirePayloadTooLarge(t, err, "body", len(body), len(body)+1)
+
+	_, err = client.UpdateStackscript(context.Background(), 123, linodego.StackscriptUpdateOptions(opts))
+	requirePayloadTooLarge(t, err, "body", len(body), len(body)+1)
+
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	// A limit of zero disables the check
+	limits.Body = 0
+	client.SetPayloadLimits(limits)
+
+	_, err = client.CreateStackscript(context.Background(), opts)
+	require.NoError(t, err)
+}
+
+func TestPayloadLimits_Override(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Instance{ID: 123}))
+
+	limits := linodego.DefaultPayloadLimits
+	limits.InstanceLabel = 4
+	client.SetPayloadLimits(limits)
+
+	_, err := client.CreateInstance(context.Background(), linodego.InstanceCreateOptions{Label: "abcde"})
+	requirePayloadTooLarge(t, err, "label", 4, 5)
+
+	// A limit of zero disables the check
+	limits.InstanceLabel = 0
+	client.SetPayloadLimits(limits)
+
+	_, err = client.CreateInstance(context.Background(), linodego.InstanceCreateOptions{Label: strings.Repeat("a", 1000)})
+	require.NoError(t, err)
+}
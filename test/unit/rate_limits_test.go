@@ -0,0 +1,96 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func mockRateLimitHeaders(t *testing.T, header http.Header) {
+	t.Helper()
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "profile"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Profile{}).HeaderSet(header))
+}
+
+func TestRateLimits_LastRateLimit(t *testing.T) {
+	client := createMockClient(t)
+
+	require.Zero(t, client.LastRateLimit())
+
+	mockRateLimitHeaders(t, http.Header{
+		"X-Ratelimit-Limit":     {"800"},
+		"X-Ratelimit-Remaining": {"799"},
+		"X-Ratelimit-Reset":     {"1700000000"},
+	})
+
+	var hooked []linodego.RateLimitInfo
+	client.OnRateLimit(func(info linodego.RateLimitInfo) {
+		hooked = append(hooked, info)
+	})
+
+	_, err := client.GetProfile(context.Background())
+	require.NoError(t, err)
+
+	expected := linodego.RateLimitInfo{
+		Limit:     800,
+		Remaining: 799,
+		Reset:     time.Unix(1700000000, 0),
+	}
+
+	require.Equal(t, expected, client.LastRateLimit())
+	require.Equal(t, []linodego.RateLimitInfo{expected}, hooked)
+}
+
+func TestRateLimits_InvalidHeaders(t *testing.T) {
+	client := createMockClient(t)
+
+	mockRateLimitHeaders(t, http.Header{
+		"X-Ratelimit-Limit":     {"800"},
+		"X-Ratelimit-Remaining": {"lots"},
+	})
+
+	_, err := client.GetProfile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, linodego.RateLimitInfo{Limit: 800}, client.LastRateLimit())
+
+	// Responses without any valid rate limit headers leave the last values in place
+	httpmock.Reset()
+	mockRateLimitHeaders(t, http.Header{"X-Ratelimit-Reset": {"soon"}})
+
+	hooked := false
+	client.OnRateLimit(func(linodego.RateLimitInfo) {
+		hooked = true
+	})
+
+	_, err = client.GetProfile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, linodego.RateLimitInfo{Limit: 800}, client.LastRateLimit())
+	require.False(t, hooked)
+}
+
+func TestRateLimits_Concurrent(t *testing.T) {
+	client := createMockClient(t)
+
+	mockRateLimitHeaders(t, http.Header{"X-Ratelimit-Remaining": {"10"}})
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := client.GetProfile(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, 10, client.LastRateLimit().Remaining)
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,30 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegion_HasCapabilities(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "regions/us-east"),
+		httpmock.NewStringResponder(200, `{
+			"id": "us-east",
+			"capabilities": ["Linodes", "Block Storage Encryption", "Some Future Capability"]
+		}`))
+
+	region, err := client.GetRegion(context.Background(), "us-east")
+	require.NoError(t, err)
+
+	require.Contains(t, region.Capabilities, "Some Future Capability")
+
+	require.True(t, region.HasCapabilities(linodego.CapabilityLinodes, linodego.CapabilityBlockStorageEncryption))
+	require.True(t, region.HasCapabilities("linodes"))
+	require.True(t, region.HasCapabilities("Some Future Capability"))
+	require.False(t, region.HasCapabilities(linodego.CapabilityLinodes, linodego.CapabilityMetadata))
+}
@@ -0,0 +1,117 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSlowInstance responds to GetInstance after a delay, counting the requests made
+func mockSlowInstance(t *testing.T, hits *atomic.Int32) {
+	t.Helper()
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		func(_ *http.Request) (*http.Response, error) {
+			hits.Add(1)
+			time.Sleep(200 * time.Millisecond)
+
+			return httpmock.NewJsonResponse(200, linodego.Instance{ID: 123, Tags: []string{"web"}})
+		})
+}
+
+// getInstancesConcurrently calls GetInstance from n goroutines at once
+func getInstancesConcurrently(t *testing.T, client *linodego.Client, n int) []*linodego.Instance {
+	t.Helper()
+
+	var wg sync.WaitGroup
+
+	start := make(chan struct{})
+	instances := make([]*linodego.Instance, n)
+	errs := make([]error, n)
+
+	for i := range n {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			<-start
+			instances[i], errs[i] = client.GetInstance(context.Background(), 123)
+		}()
+	}
+
+	close(start)
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	return instances
+}
+
+func TestGETDeduplication(t *testing.T) {
+	client := createMockClient(t)
+	client.SetGETDeduplication(true)
+
+	var hits atomic.Int32
+	mockSlowInstance(t, &hits)
+
+	instances := getInstancesConcurrently(t, client, 100)
+	require.Equal(t, int32(1), hits.Load())
+
+	// Each caller has its own copy of the result
+	instances[0].Tags[0] = "modified"
+
+	for _, instance := range instances[1:] {
+		require.Equal(t, 123, instance.ID)
+		require.Equal(t, []string{"web"}, instance.Tags)
+	}
+
+	// Requests made once the shared request has finished are not deduplicated with it
+	_, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), hits.Load())
+}
+
+func TestGETDeduplication_Disabled(t *testing.T) {
+	client := createMockClient(t)
+
+	var hits atomic.Int32
+	mockSlowInstance(t, &hits)
+
+	getInstancesConcurrently(t, client, 10)
+	require.Equal(t, int32(10), hits.Load())
+}
+
+func TestGETDeduplication_NotMutating(t *testing.T) {
+	client := createMockClient(t)
+	client.SetGETDeduplication(true)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/boot"),
+		func(_ *http.Request) (*http.Response, error) {
+			time.Sleep(50 * time.Millisecond)
+			return httpmock.NewStringResponse(200, "{}"), nil
+		})
+
+	var wg sync.WaitGroup
+
+	for range 5 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			require.NoError(t, client.BootInstance(context.Background(), 123, 0))
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, 5, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,109 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// mockLargeInstance responds with an Instance padded by a label of the given size. If
+// chunked is set, the response has no Content-Length so the limit is enforced while reading.
+func mockLargeInstance(t *testing.T, size int, chunked bool) {
+	t.Helper()
+
+	body := fmt.Sprintf(`{"id": 123, "label": %q}`, strings.Repeat("a", size))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, body)
+			resp.Header.Set("Content-Type", "application/json")
+
+			if chunked {
+				resp.ContentLength = -1
+			}
+
+			resp.Request = req
+
+			return resp, nil
+		})
+}
+
+func TestResponseLimits_TooLarge(t *testing.T) {
+	for _, chunked := range []bool{false, true} {
+		t.Run(fmt.Sprintf("chunked=%t", chunked), func(t *testing.T) {
+			client := createMockClient(t)
+			client.SetMaxResponseSize(1024)
+
+			mockLargeInstance(t, 2048, chunked)
+
+			_, err := client.GetInstance(context.Background(), 123)
+			require.ErrorIs(t, err, linodego.ErrResponseTooLarge)
+			require.ErrorContains(t, err, "response to GET /linode/instances/{id} exceeded the limit of 1024 bytes")
+		})
+	}
+}
+
+func TestResponseLimits_WithinLimit(t *testing.T) {
+	client := createMockClient(t)
+	client.SetMaxResponseSize(1024)
+
+	mockLargeInstance(t, 512, true)
+
+	instance, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, 123, instance.ID)
+}
+
+func TestResponseLimits_EndpointOverride(t *testing.T) {
+	client := createMockClient(t)
+	client.SetMaxResponseSize(1024).SetEndpointMaxResponseSize("/linode/instances/{id}", 4096)
+
+	mockLargeInstance(t, 2048, false)
+
+	instance, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+	require.Len(t, instance.Label, 2048)
+}
+
+func TestResponseLimits_Disabled(t *testing.T) {
+	client := createMockClient(t)
+	client.SetMaxResponseSize(1024).SetMaxResponseSize(0)
+
+	mockLargeInstance(t, 2048, true)
+
+	instance, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+	require.Len(t, instance.Label, 2048)
+}
+
+func TestResponseLimits_MaxResponseBytes(t *testing.T) {
+	client := createMockClient(t)
+	client.SetMaxResponseBytes(1024)
+
+	mockLargeInstance(t, 2048, true)
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.ErrorIs(t, err, linodego.ErrResponseTooLarge)
+
+	client.SetMaxResponseBytes(0)
+
+	instance, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+	require.Len(t, instance.Label, 2048)
+}
+
+func TestResponseLimits_CallerClientUnchanged(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	hc := &http.Client{Transport: transport}
+
+	client := linodego.NewClient(hc)
+	client.SetMaxResponseSize(1024)
+
+	require.Same(t, transport, hc.Transport)
+}
@@ -0,0 +1,78 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// mockFlakyResponder fails the first failures requests with the given status, then succeeds
+func mockFlakyResponder(failures int, status int, response any) httpmock.Responder {
+	calls := 0
+
+	return func(_ *http.Request) (*http.Response, error) {
+		calls++
+
+		if calls <= failures {
+			return httpmock.NewJsonResponse(status, linodego.APIError{
+				Errors: []linodego.APIErrorReason{{Reason: "try again"}},
+			})
+		}
+
+		return httpmock.NewJsonResponse(http.StatusOK, response)
+	}
+}
+
+func TestRetries_OnRetry(t *testing.T) {
+	client := createMockClient(t)
+	client.SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		mockFlakyResponder(2, http.StatusServiceUnavailable, linodego.Instance{ID: 123}))
+
+	var attempts []linodego.RetryAttempt
+	client.OnRetry(func(attempt linodego.RetryAttempt) {
+		attempts = append(attempts, attempt)
+	})
+
+	instance, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, 123, instance.ID)
+
+	require.Len(t, attempts, 2)
+
+	for i, attempt := range attempts {
+		require.Equal(t, i+1, attempt.Attempt)
+		require.Equal(t, http.StatusServiceUnavailable, attempt.Response.StatusCode())
+		require.NoError(t, attempt.Err)
+	}
+}
+
+func TestRetries_SetRetryNonIdempotent(t *testing.T) {
+	client := createMockClient(t)
+	client.SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances$"),
+		mockFlakyResponder(1, http.StatusBadGateway, linodego.Instance{ID: 123}))
+
+	// POST requests are not retried by default, as the API may have processed them
+	_, err := client.CreateInstance(context.Background(), linodego.InstanceCreateOptions{Region: "us-east"})
+	require.Error(t, err)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	httpmock.Reset()
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances$"),
+		mockFlakyResponder(1, http.StatusBadGateway, linodego.Instance{ID: 123}))
+
+	client.SetRetryNonIdempotent(true)
+
+	instance, err := client.CreateInstance(context.Background(), linodego.InstanceCreateOptions{Region: "us-east"})
+	require.NoError(t, err)
+	require.Equal(t, 123, instance.ID)
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
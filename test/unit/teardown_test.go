@@ -0,0 +1,244 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTeardownAccount registers an account where the "env:qa" tag selects an instance
+// with a reserved IP, an attached volume, a firewall protecting the instance and a
+// NodeBalancer. An untagged NodeBalancer balances traffic to the instance and a domain
+// has a record pointing at it.
+func mockTeardownAccount(t *testing.T) {
+	t.Helper()
+
+	publicIP := net.ParseIP("192.0.2.10")
+	privateIP := net.ParseIP("192.168.0.5")
+	linodeID := 100
+
+	mockPaginatedResponse(t, "linode/instances$", []linodego.Instance{
+		{ID: 100, Label: "qa-web", Tags: []string{"env:qa"}, IPv4: []*net.IP{&publicIP, &privateIP}},
+		{ID: 200, Label: "prod-web", Tags: []string{"env:prod"}},
+	}, 2)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/100/ips"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.InstanceIPAddressResponse{
+			IPv4: &linodego.InstanceIPv4Response{
+				Reserved: []*linodego.InstanceIP{{Address: "192.0.2.20", Reserved: true}},
+			},
+		}))
+
+	mockPaginatedResponse(t, "nodebalancers$", []linodego.NodeBalancer{
+		{ID: 300, Label: linodego.Pointer("qa-lb"), Tags: []string{"env:qa"}, IPv4: linodego.Pointer("192.0.2.30")},
+		{ID: 400, Label: linodego.Pointer("shared-lb")},
+	}, 2)
+
+	mockPaginatedResponse(t, "nodebalancers/400/configs$", []linodego.NodeBalancerConfig{{ID: 41}}, 1)
+	mockPaginatedResponse(t, "nodebalancers/400/configs/41/nodes$", []linodego.NodeBalancerNode{
+		{ID: 411, Address: "192.168.0.5:80"},
+		{ID: 412, Address: "192.168.0.6:80"},
+	}, 2)
+
+	mockPaginatedResponse(t, "domains$", []linodego.Domain{{ID: 500, Domain: "example.com"}}, 1)
+	mockPaginatedResponse(t, "domains/500/records$", []linodego.DomainRecord{
+		{ID: 501, Name: "qa", Type: linodego.RecordTypeA, Target: "192.0.2.10"},
+		{ID: 502, Name: "lb", Type: linodego.RecordTypeA, Target: "192.0.2.30"},
+		{ID: 503, Name: "www", Type: linodego.RecordTypeA, Target: "198.51.100.1"},
+		{ID: 504, Name: "txt", Type: linodego.RecordTypeTXT, Target: "192.0.2.10"},
+	}, 4)
+
+	mockPaginatedResponse(t, "volumes$", []linodego.Volume{
+		{ID: 600, Label: "qa-data", Tags: []string{"env:qa"}, LinodeID: &linodeID},
+	}, 1)
+
+	mockPaginatedResponse(t, "networking/firewalls$", []linodego.Firewall{
+		{ID: 700, Label: "qa-fw", Tags: []string{"env:qa"}},
+	}, 1)
+	mockPaginatedResponse(t, "networking/firewalls/700/devices$", []linodego.FirewallDevice{
+		{ID: 701, Entity: linodego.FirewallDeviceEntity{ID: 100, Type: linodego.FirewallDeviceLinode}},
+	}, 1)
+}
+
+func TestTeardown_Plan(t *testing.T) {
+	client := createMockClient(t)
+	mockTeardownAccount(t)
+
+	plan, err := client.PlanTeardown(context.Background(), linodego.TeardownSelector{Tag: "env:qa"})
+	require.NoError(t, err)
+
+	actions := make([]linodego.TeardownAction, len(plan.Steps))
+	for i, step := range plan.Steps {
+		actions[i] = step.Action
+	}
+
+	require.Equal(t, []linodego.TeardownAction{
+		linodego.TeardownDeleteDomainRecord,
+		linodego.TeardownDeleteDomainRecord,
+		linodego.TeardownDeleteNodeBalancerNode,
+		linodego.TeardownDetachVolume,
+		linodego.TeardownDeleteFirewallDevice,
+		linodego.TeardownDeleteNodeBalancer,
+		linodego.TeardownDeleteInstance,
+		linodego.TeardownDeleteVolume,
+		linodego.TeardownDeleteFirewall,
+		linodego.TeardownReleaseReservedIP,
+	}, actions)
+
+	require.Contains(t, plan.Steps[2].Description, "node 411")
+	require.Contains(t, plan.Steps[6].Description, "qa-web")
+	require.Contains(t, plan.Steps[9].Description, "192.0.2.20")
+
+	_, err = client.PlanTeardown(context.Background(), linodego.TeardownSelector{})
+	require.Error(t, err)
+}
+
+func TestTeardown_Execute(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+	mockTeardownAccount(t)
+
+	plan, err := client.PlanTeardown(context.Background(), linodego.TeardownSelector{LabelPrefix: "qa-"})
+	require.NoError(t, err)
+
+	results, err := client.ExecuteTeardown(context.Background(), plan, linodego.TeardownExecuteOptions{DryRun: true})
+	require.NoError(t, err)
+	require.Len(t, results, len(plan.Steps))
+
+	for _, r := range results {
+		require.False(t, r.Executed)
+	}
+
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+
+	record := func(name string) httpmock.Responder {
+		return func(_ *http.Request) (*http.Response, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			calls = append(calls, name)
+
+			return httpmock.NewStringResponse(200, "{}"), nil
+		}
+	}
+
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "domains/500/records/50[12]"), record("record"))
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "nodebalancers/400/configs/41/nodes/411"), record("node"))
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "volumes/600/detach"), record("detach"))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes/600$"),
+		httpmock.NewJsonResponderOrPanic(200, linodego.Volume{ID: 600}))
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "networking/firewalls/700/devices/701"), record("firewall device"))
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "nodebalancers/300$"), record("nodebalancer"))
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "linode/instances/100$"), record("instance"))
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "volumes/600$"), record("volume"))
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "networking/firewalls/700$"), record("firewall"))
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "networking/reserved/ips/192.0.2.20"), record("reserved ip"))
+
+	results, err = client.ExecuteTeardown(context.Background(), plan, linodego.TeardownExecuteOptions{Concurrency: 2})
+	require.NoError(t, err)
+
+	for _, r := range results {
+		require.True(t, r.Executed)
+		require.NoError(t, r.Err)
+	}
+
+	require.Equal(t, []string{
+		"record", "record", "node", "detach", "firewall device",
+		"nodebalancer", "instance", "volume", "firewall", "reserved ip",
+	}, calls)
+}
+
+func TestTeardown_ExecuteStopsOnFailure(t *testing.T) {
+	client := createMockClient(t)
+
+	mockTeardownAccount(t)
+
+	plan, err := client.PlanTeardown(context.Background(), linodego.TeardownSelector{Tag: "env:qa"})
+	require.NoError(t, err)
+
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "domains/500/records/"),
+		httpmock.NewJsonResponderOrPanic(400, linodego.APIError{Errors: []linodego.APIErrorReason{{Reason: "nope"}}}))
+
+	results, err := client.ExecuteTeardown(context.Background(), plan, linodego.TeardownExecuteOptions{})
+	require.ErrorContains(t, err, "failed to delete domain record")
+
+	for _, r := range results {
+		require.Equal(t, r.Step.Action == linodego.TeardownDeleteDomainRecord, r.Executed)
+	}
+}
+
+func TestTeardown_ExecuteSerializedPlan(t *testing.T) {
+	client := createMockClient(t)
+	mockTeardownAccount(t)
+
+	plan, err := client.PlanTeardown(context.Background(), linodego.TeardownSelector{Tag: "env:qa"})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(plan)
+	require.NoError(t, err)
+
+	var decoded linodego.TeardownPlan
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, *plan, decoded)
+
+	// Only the node and reserved IP steps are executed
+	decoded.Steps = slices.DeleteFunc(decoded.Steps, func(step linodego.TeardownStep) bool {
+		return step.Action != linodego.TeardownDeleteNodeBalancerNode && step.Action != linodego.TeardownReleaseReservedIP
+	})
+
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "nodebalancers/400/configs/41/nodes/411"),
+		httpmock.NewStringResponder(200, "{}"))
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "networking/reserved/ips/192.0.2.20"),
+		httpmock.NewStringResponder(200, "{}"))
+
+	results, err := client.ExecuteTeardown(context.Background(), &decoded, linodego.TeardownExecuteOptions{})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	for _, r := range results {
+		require.True(t, r.Executed)
+	}
+
+	info := httpmock.GetCallCountInfo()
+	require.Equal(t, 1, info["DELETE =~"+mockRequestURL(t, "nodebalancers/400/configs/41/nodes/411").String()])
+	require.Equal(t, 1, info["DELETE =~"+mockRequestURL(t, "networking/reserved/ips/192.0.2.20").String()])
+}
+
+func TestTeardown_ExecuteInvalidStep(t *testing.T) {
+	client := createMockClient(t)
+
+	plan := &linodego.TeardownPlan{Steps: []linodego.TeardownStep{
+		{Action: linodego.TeardownDeleteInstance, Description: "instance without an ID"},
+		{Action: linodego.TeardownDeleteInstance, Description: "instance 100", ResourceIDs: []int{100, 101}},
+		{Action: linodego.TeardownReleaseReservedIP, Description: "reserved IP without an address"},
+		{Action: "delete_everything", Description: "everything", ResourceIDs: []int{1}},
+	}}
+
+	results, err := client.ExecuteTeardown(context.Background(), plan, linodego.TeardownExecuteOptions{})
+	require.ErrorContains(t, err, "failed to delete instance instance without an ID: 1 resource IDs are required, got 0")
+	require.ErrorContains(t, err, "failed to delete instance instance 100: 1 resource IDs are required, got 2")
+
+	require.True(t, results[0].Executed)
+	require.True(t, results[1].Executed)
+	require.False(t, results[2].Executed)
+
+	results, err = client.ExecuteTeardown(context.Background(), &linodego.TeardownPlan{Steps: plan.Steps[2:]}, linodego.TeardownExecuteOptions{})
+	require.ErrorContains(t, err, "an address is required")
+	require.False(t, results[1].Executed)
+
+	results, err = client.ExecuteTeardown(context.Background(), &linodego.TeardownPlan{Steps: plan.Steps[3:]}, linodego.TeardownExecuteOptions{})
+	require.ErrorContains(t, err, `unknown teardown action "delete_everything"`)
+	require.True(t, results[0].Executed)
+}
@@ -0,0 +1,40 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypes_ListByClass(t *testing.T) {
+	client := createMockClient(t)
+
+	responseData := map[string]any{
+		"data": []linodego.LinodeType{
+			{ID: "g6-nanode-1", Class: linodego.ClassNanode},
+			{ID: "g6-dedicated-2", Class: linodego.ClassDedicated},
+			{ID: "g6-standard-2", Class: linodego.ClassStandard},
+			{ID: "g6-dedicated-4", Class: linodego.ClassDedicated},
+			{ID: "g7-premium-2", Class: linodego.ClassPremium},
+		},
+		"page":    1,
+		"pages":   1,
+		"results": 5,
+	}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/types"),
+		httpmock.NewJsonResponderOrPanic(200, responseData))
+
+	types, err := client.ListTypesByClass(context.Background(), linodego.ClassDedicated)
+	require.NoError(t, err)
+
+	require.Len(t, types, 2)
+	for _, lt := range types {
+		require.Equal(t, linodego.ClassDedicated, lt.Class)
+	}
+	require.Equal(t, "g6-dedicated-2", types[0].ID)
+	require.Equal(t, "g6-dedicated-4", types[1].ID)
+}
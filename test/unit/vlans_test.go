@@ -0,0 +1,34 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVLANs_GetInstanceVLANs(t *testing.T) {
+	client := createMockClient(t)
+
+	mockPaginatedResponse(t, "linode/instances/123/configs", []linodego.InstanceConfig{
+		{
+			ID: 456,
+			Interfaces: []linodego.InstanceConfigInterface{
+				{Purpose: linodego.InterfacePurposePublic, Primary: true, Active: true},
+				{
+					Purpose:     linodego.InterfacePurposeVLAN,
+					Label:       "backend",
+					IPAMAddress: "10.0.0.1/24",
+					Active:      true,
+				},
+			},
+		},
+	}, 1)
+
+	vlans, err := client.GetInstanceVLANs(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, []linodego.InstanceVLAN{
+		{Label: "backend", IPAMAddress: "10.0.0.1/24", ConfigID: 456, Active: true},
+	}, vlans)
+}
@@ -0,0 +1,73 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTaggedVolumes responds to ListVolumes with the given volumes, asserting the filter used
+func mockTaggedVolumes(t *testing.T, wantFilter map[string]any, volumes ...linodego.Volume) {
+	t.Helper()
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes"),
+		func(req *http.Request) (*http.Response, error) {
+			var filter map[string]any
+			require.NoError(t, json.Unmarshal([]byte(req.Header.Get("X-Filter")), &filter))
+			require.Equal(t, wantFilter, filter)
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data":    volumes,
+				"page":    1,
+				"pages":   1,
+				"results": len(volumes),
+			})
+		})
+}
+
+func TestVolumes_ListByTag(t *testing.T) {
+	client := createMockClient(t)
+
+	mockTaggedVolumes(t, map[string]any{"tags": "role:db"}, linodego.Volume{ID: 1, Tags: []string{"role:db"}})
+
+	volumes, err := client.ListVolumesByTag(context.Background(), "role:db")
+	require.NoError(t, err)
+	require.Len(t, volumes, 1)
+}
+
+func TestVolumes_FindAttachable(t *testing.T) {
+	client := createMockClient(t)
+
+	mockTaggedVolumes(t,
+		map[string]any{"+order_by": "id", "+order": "asc", "tags": "role:db", "region": "us-east"},
+		linodego.Volume{ID: 1, Region: "us-east", Status: linodego.VolumeActive, Tags: []string{"role:db"}, LinodeID: linodego.Pointer(100)},
+		linodego.Volume{ID: 2, Region: "us-west", Status: linodego.VolumeActive, Tags: []string{"role:db"}},
+		linodego.Volume{ID: 3, Region: "us-east", Status: linodego.VolumeCreating, Tags: []string{"role:db"}},
+		linodego.Volume{ID: 4, Region: "us-east", Status: linodego.VolumeActive, Tags: []string{"role:dbadmin"}},
+		linodego.Volume{ID: 5, Region: "us-east", Status: linodego.VolumeActive, Tags: []string{"env:prod", "role:db"}},
+		linodego.Volume{ID: 6, Region: "us-east", Status: linodego.VolumeActive, Tags: []string{"role:db"}},
+	)
+
+	volume, err := client.FindAttachableVolume(context.Background(), "role:db", "us-east")
+	require.NoError(t, err)
+	require.Equal(t, 5, volume.ID)
+}
+
+func TestVolumes_FindAttachableNone(t *testing.T) {
+	client := createMockClient(t)
+
+	mockTaggedVolumes(t,
+		map[string]any{"+order_by": "id", "+order": "asc", "tags": "role:db", "region": "us-east"},
+		linodego.Volume{ID: 1, Region: "us-east", Status: linodego.VolumeActive, Tags: []string{"role:db"}, LinodeID: linodego.Pointer(100)},
+		linodego.Volume{ID: 2, Region: "us-west", Status: linodego.VolumeActive, Tags: []string{"role:db"}},
+	)
+
+	_, err := client.FindAttachableVolume(context.Background(), "role:db", "us-east")
+	require.ErrorIs(t, err, linodego.ErrNoAttachableVolume)
+	require.ErrorContains(t, err, `no attachable volume with tag "role:db" in region us-east`)
+}
@@ -0,0 +1,44 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVPCIPs_ValidateInterfaceIPRanges(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "vpcs/123/ips"),
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"data": []linodego.VPCIP{
+				{Address: linodego.Pointer("10.0.0.2"), LinodeID: 456, InterfaceID: 1},
+				{AddressRange: linodego.Pointer("10.0.0.64/28"), LinodeID: 789, InterfaceID: 2},
+			},
+			"page":    1,
+			"pages":   1,
+			"results": 2,
+		}))
+
+	err := client.ValidateInterfaceIPRanges(context.Background(), 123, 0, []string{"10.0.0.128/28"})
+	require.NoError(t, err)
+
+	err = client.ValidateInterfaceIPRanges(context.Background(), 123, 0, []string{"10.0.0.72/29"})
+	require.ErrorContains(t, err, "IP range 10.0.0.72/29 overlaps 10.0.0.64/28 allocated to Linode 789 interface 2")
+
+	err = client.ValidateInterfaceIPRanges(context.Background(), 123, 0, []string{"10.0.0.0/30"})
+	require.ErrorContains(t, err, "IP range 10.0.0.0/30 overlaps 10.0.0.2/32 allocated to Linode 456 interface 1")
+
+	err = client.ValidateInterfaceIPRanges(context.Background(), 123, 0, []string{"10.0.1.0/24", "10.0.1.16/28"})
+	require.ErrorContains(t, err, "IP range 10.0.1.16/28 overlaps requested IP range 10.0.1.0/24")
+
+	// Interface 2 is being updated and keeps its own range
+	err = client.ValidateInterfaceIPRanges(context.Background(), 123, 2, []string{"10.0.0.64/28", "10.0.0.128/28"})
+	require.NoError(t, err)
+
+	err = client.ValidateInterfaceIPRanges(context.Background(), 123, 2, []string{"10.0.0.64/28", "10.0.0.0/30"})
+	require.ErrorContains(t, err, "IP range 10.0.0.0/30 overlaps 10.0.0.2/32 allocated to Linode 456 interface 1")
+}
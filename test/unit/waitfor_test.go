@@ -0,0 +1,272 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingClock fires every timer immediately, advancing its time by the timer's
+// duration and recording it
+type recordingClock struct {
+	now       time.Time
+	intervals []time.Duration
+}
+
+func (c *recordingClock) Now() time.Time { return c.now }
+
+func (c *recordingClock) After(d time.Duration) <-chan time.Time {
+	c.intervals = append(c.intervals, d)
+	c.now = c.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+
+	return ch
+}
+
+func TestWaitForEventFinished_Backoff(t *testing.T) {
+	client := createMockClient(t)
+
+	mockInstanceEvents(t, linodego.ActionLinodeBoot, "",
+		linodego.EventStarted, linodego.EventStarted, linodego.EventStarted, linodego.EventStarted, linodego.EventFinished)
+
+	clock := &recordingClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	var updates []linodego.WaitUpdate
+
+	event, err := client.WaitForEventFinishedWithOptions(context.Background(), 123, linodego.EntityLinode,
+		linodego.ActionLinodeBoot, time.Now(), 5,
+		linodego.WaitBackoff{InitialInterval: time.Second, MaxInterval: 5 * time.Second, Multiplier: 2},
+		linodego.WithWaitClock(clock),
+		linodego.WithWaitObserver(func(u linodego.WaitUpdate) {
+			updates = append(updates, u)
+		}))
+	require.NoError(t, err)
+	require.Equal(t, linodego.EventFinished, event.Status)
+
+	require.Equal(t, []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second,
+	}, clock.intervals)
+
+	// Elapsed time is measured using the clock
+	require.Len(t, updates, 5)
+	require.Equal(t, 17*time.Second, updates[4].Elapsed)
+}
+
+func TestWaitForEventFinished_FailedEvent(t *testing.T) {
+	client := createMockClient(t)
+
+	mockInstanceEvents(t, linodego.ActionLinodeBoot, "Insufficient memory", linodego.EventStarted, linodego.EventFailed)
+
+	clock := &recordingClock{}
+
+	event, err := client.WaitForEventFinishedWithOptions(context.Background(), 123, linodego.EntityLinode,
+		linodego.ActionLinodeBoot, time.Now(), 5, linodego.WaitBackoff{InitialInterval: time.Second},
+		linodego.WithWaitClock(clock))
+	require.ErrorIs(t, err, linodego.ErrEventFailed)
+	require.ErrorContains(t, err, "Linode 123 action linode_boot failed (event 456): Insufficient memory")
+
+	// The terminal Event is returned along with the error
+	require.Equal(t, linodego.EventFailed, event.Status)
+	require.Equal(t, "Insufficient memory", event.Message)
+
+	require.Equal(t, []time.Duration{time.Second, time.Second}, clock.intervals)
+}
+
+func TestWaitForInstanceStatus_BackoffJitter(t *testing.T) {
+	client := createMockClient(t)
+
+	polls := 0
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		func(_ *http.Request) (*http.Response, error) {
+			polls++
+
+			status := linodego.InstanceBooting
+			if polls >= 20 {
+				status = linodego.InstanceRunning
+			}
+
+			return httpmock.NewJsonResponse(200, linodego.Instance{ID: 123, Status: status})
+		})
+
+	clock := &recordingClock{}
+
+	_, err := client.WaitForInstanceStatus(context.Background(), 123, linodego.InstanceRunning, 5,
+		linodego.WithWaitBackoff(linodego.WaitBackoff{InitialInterval: 10 * time.Second, Jitter: 0.5}),
+		linodego.WithWaitClock(clock))
+	require.NoError(t, err)
+	require.Len(t, clock.intervals, 20)
+
+	distinct := make(map[time.Duration]bool)
+
+	for _, interval := range clock.intervals {
+		require.GreaterOrEqual(t, interval, 5*time.Second)
+		require.LessOrEqual(t, interval, 15*time.Second)
+
+		distinct[interval] = true
+	}
+
+	require.Greater(t, len(distinct), 1, "expected intervals to be jittered")
+}
+
+func TestWaitForStatus_DefaultInterval(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(3 * time.Second)
+
+	polls := 0
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes/123"),
+		func(_ *http.Request) (*http.Response, error) {
+			polls++
+
+			status := linodego.VolumeCreating
+			if polls >= 3 {
+				status = linodego.VolumeActive
+			}
+
+			return httpmock.NewJsonResponse(200, linodego.Volume{ID: 123, Status: status})
+		})
+
+	mockPaginatedResponse(t, "linode/instances/123/disks", []linodego.InstanceDisk{{ID: 5, Status: linodego.DiskReady}}, 1)
+
+	clock := &recordingClock{}
+
+	_, err := client.WaitForVolumeStatus(context.Background(), 123, linodego.VolumeActive, 5, linodego.WithWaitClock(clock))
+	require.NoError(t, err)
+
+	_, err = client.WaitForInstanceDiskStatus(context.Background(), 123, 5, linodego.DiskReady, 5, linodego.WithWaitClock(clock))
+	require.NoError(t, err)
+
+	// Without a backoff, polls are made at the client's fixed poll interval
+	require.Equal(t, []time.Duration{3 * time.Second, 3 * time.Second, 3 * time.Second, 3 * time.Second}, clock.intervals)
+}
+
+func TestWaitForEventFinished_ServerSideFilter(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type fakeEvent struct {
+		linodego.Event
+		created time.Time
+	}
+
+	// A busy account, where the event waited for is older than 1000 unrelated events
+	events := []fakeEvent{{
+		Event: linodego.Event{
+			ID:     1,
+			Action: linodego.ActionLinodeBoot,
+			Status: linodego.EventFinished,
+			Entity: &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode},
+		},
+		created: start.Add(time.Second),
+	}}
+
+	for i := range 1000 {
+		events = append(events, fakeEvent{
+			Event: linodego.Event{
+				ID:     i + 2,
+				Action: linodego.ActionLinodeBoot,
+				Status: linodego.EventFinished,
+				Entity: &linodego.EventEntity{ID: 1000 + i, Type: linodego.EntityLinode},
+			},
+			created: start.Add(time.Duration(i+2) * time.Second),
+		})
+	}
+
+	var requests []*http.Request
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/events"),
+		func(req *http.Request) (*http.Response, error) {
+			requests = append(requests, req)
+
+			var filter struct {
+				Order     string            `json:"+order"`
+				OrderBy   string            `json:"+order_by"`
+				Action    string            `json:"action"`
+				EntityID  int               `json:"entity.id"`
+				Type      string            `json:"entity.type"`
+				CreatedOp map[string]string `json:"created"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(req.Header.Get("X-Filter")), &filter))
+			require.Equal(t, "desc", filter.Order)
+			require.Equal(t, "created", filter.OrderBy)
+
+			since, err := time.Parse("2006-01-02T15:04:05", filter.CreatedOp["+gte"])
+			require.NoError(t, err)
+
+			matched := make([]linodego.Event, 0)
+
+			for i := len(events) - 1; i >= 0; i-- {
+				e := events[i]
+				if string(e.Action) == filter.Action && e.Entity.ID == filter.EntityID &&
+					string(e.Entity.Type) == filter.Type && !e.created.Before(since) {
+					matched = append(matched, e.Event)
+				}
+			}
+
+			pageSize, err := strconv.Atoi(req.URL.Query().Get("page_size"))
+			require.NoError(t, err)
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data":    matched[:min(pageSize, len(matched))],
+				"page":    1,
+				"pages":   (len(matched) + pageSize - 1) / pageSize,
+				"results": len(matched),
+			})
+		})
+
+	event, err := client.WaitForEventFinished(context.Background(), 123, linodego.EntityLinode,
+		linodego.ActionLinodeBoot, start, 5)
+	require.NoError(t, err)
+	require.Equal(t, 1, event.ID)
+
+	require.Len(t, requests, 1)
+	require.Equal(t, "1", requests[0].URL.Query().Get("page"))
+	require.Equal(t, "25", requests[0].URL.Query().Get("page_size"))
+}
+
+func TestWaitForEventFinished_FilterDoesNotGrow(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	var filters []string
+
+	status := []linodego.EventStatus{linodego.EventStarted, linodego.EventStarted, linodego.EventFinished}
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/events"),
+		func(req *http.Request) (*http.Response, error) {
+			filters = append(filters, req.Header.Get("X-Filter"))
+
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data": []linodego.Event{{
+					ID:     456,
+					Action: linodego.ActionLinodeBoot,
+					Status: status[min(len(filters), len(status))-1],
+					Entity: &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode},
+				}},
+				"page":    1,
+				"pages":   1,
+				"results": 1,
+			})
+		})
+
+	_, err := client.WaitForEventFinished(context.Background(), 123, linodego.EntityLinode,
+		linodego.ActionLinodeBoot, time.Now(), 5)
+	require.NoError(t, err)
+
+	require.Len(t, filters, 3)
+	require.NotContains(t, filters[0], `"id"`)
+	require.Contains(t, filters[1], `"id":{"+gte":456}`)
+	require.Equal(t, filters[1], filters[2])
+}
@@ -9,7 +9,7 @@ import (
 type LinodeType struct {
 	ID           string              `json:"id"`
 	Disk         int                 `json:"disk"`
-	Class        LinodeTypeClass     `json:"class"` // enum: nanode, standard, highmem, dedicated, gpu
+	Class        LinodeTypeClass     `json:"class"` // enum: nanode, standard, highmem, dedicated, gpu, premium
 	Price        *LinodePrice        `json:"price"`
 	Label        string              `json:"label"`
 	Addons       *LinodeAddons       `json:"addons"`
@@ -57,6 +57,7 @@ const (
 	ClassHighmem   LinodeTypeClass = "highmem"
 	ClassDedicated LinodeTypeClass = "dedicated"
 	ClassGPU       LinodeTypeClass = "gpu"
+	ClassPremium   LinodeTypeClass = "premium"
 )
 
 // ListTypes lists linode types. This endpoint is cached by default.
@@ -82,6 +83,25 @@ func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType
 	return response, nil
 }
 
+// ListTypesByClass lists all linode types belonging to the given class.
+// The full type list is cached, so repeated calls do not hit the API.
+func (c *Client) ListTypesByClass(ctx context.Context, class LinodeTypeClass) ([]LinodeType, error) {
+	types, err := c.ListTypes(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]LinodeType, 0)
+
+	for _, t := range types {
+		if t.Class == class {
+			result = append(result, t)
+		}
+	}
+
+	return result, nil
+}
+
 // GetType gets the type with the provided ID. This endpoint is cached by default.
 func (c *Client) GetType(ctx context.Context, typeID string) (*LinodeType, error) {
 	e := formatAPIPath("linode/types/%s", url.PathEscape(typeID))
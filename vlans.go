@@ -16,6 +16,16 @@ type VLAN struct {
 	Created *time.Time `json:"-"`
 }
 
+// InstanceVLAN represents a VLAN attached to one of an Instance's configs
+type InstanceVLAN struct {
+	Label       string
+	IPAMAddress string
+	ConfigID    int
+
+	// Active is true if the interface is in use by the Instance's running config
+	Active bool
+}
+
 // UnmarshalJSON for VLAN responses
 func (v *VLAN) UnmarshalJSON(b []byte) error {
 	type Mask VLAN
@@ -64,3 +74,31 @@ func (c *Client) GetVLANIPAMAddress(ctx context.Context, linodeID int, vlanLabel
 
 	return "", fmt.Errorf("Failed to find IPAMAddress for VLAN: %s", vlanLabel)
 }
+
+// GetInstanceVLANs returns the VLANs attached to the configs of an Instance, along with the
+// IPAM address used by each. A VLAN attached to several configs is returned once per config.
+func (c *Client) GetInstanceVLANs(ctx context.Context, linodeID int) ([]InstanceVLAN, error) {
+	cfgs, err := c.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Fetching configs for instance %v failed: %w", linodeID, err)
+	}
+
+	var vlans []InstanceVLAN
+
+	for _, cfg := range cfgs {
+		for _, face := range cfg.Interfaces {
+			if face.Purpose != InterfacePurposeVLAN {
+				continue
+			}
+
+			vlans = append(vlans, InstanceVLAN{
+				Label:       face.Label,
+				IPAMAddress: face.IPAMAddress,
+				ConfigID:    cfg.ID,
+				Active:      face.Active,
+			})
+		}
+	}
+
+	return vlans, nil
+}
@@ -3,11 +3,17 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
+// ErrNoAttachableVolume is returned by FindAttachableVolume when no Volume matches
+var ErrNoAttachableVolume = errors.New("no attachable volume")
+
 // VolumeStatus indicates the status of the Volume
 type VolumeStatus string
 
@@ -112,6 +118,40 @@ func (c *Client) ListVolumes(ctx context.Context, opts *ListOptions) ([]Volume,
 	return response, err
 }
 
+// ListVolumesByTag lists the Volumes with the given tag
+func (c *Client) ListVolumesByTag(ctx context.Context, tag string) ([]Volume, error) {
+	f := Filter{}
+	f.AddField(Eq, "tags", tag)
+
+	return c.ListVolumes(ctx, NewListOptions(0, &f))
+}
+
+// FindAttachableVolume finds an active Volume with the given tag in the given region that is not
+// attached to a Linode, such as a Volume to attach to a replacement Instance during failover.
+// If several Volumes match, the one with the lowest ID is returned. An error wrapping
+// ErrNoAttachableVolume is returned if none match.
+func (c *Client) FindAttachableVolume(ctx context.Context, tag, region string) (*Volume, error) {
+	f := Filter{OrderBy: "id", Order: Ascending}
+	f.AddField(Eq, "tags", tag)
+	f.AddField(Eq, "region", region)
+
+	volumes, err := c.ListVolumes(ctx, NewListOptions(0, &f))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, volume := range volumes {
+		attached := volume.LinodeID != nil && *volume.LinodeID > 0
+
+		// The tag and region are checked again rather than relying on how the API matches them
+		if !attached && volume.Status == VolumeActive && volume.Region == region && slices.Contains(volume.Tags, tag) {
+			return &volume, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w with tag %q in region %s", ErrNoAttachableVolume, tag, region)
+}
+
 // GetVolume gets the template with the provided ID
 func (c *Client) GetVolume(ctx context.Context, volumeID int) (*Volume, error) {
 	e := formatAPIPath("volumes/%d", volumeID)
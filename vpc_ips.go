@@ -2,7 +2,9 @@ package linodego
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 )
 
 // ListAllVPCIPAddresses gets the list of all IP addresses of all VPCs in the Linode account.
@@ -18,3 +20,87 @@ func (c *Client) ListVPCIPAddresses(
 ) ([]VPCIP, error) {
 	return getPaginatedResults[VPCIP](ctx, c, fmt.Sprintf("vpcs/%d/ips", vpcID), opts)
 }
+
+// ValidateInterfaceIPRanges checks the given VPC interface ip_ranges against each other and
+// against the addresses and ranges already allocated in the VPC, returning an error naming
+// every overlap found. This can be called before creating or updating a VPC interface
+// to avoid a request the API would reject. When updating an interface, pass its ID as
+// excludeInterfaceID so the ranges it already has are not reported as overlaps; pass 0
+// when creating one.
+func (c *Client) ValidateInterfaceIPRanges(ctx context.Context, vpcID, excludeInterfaceID int, ranges []string) error {
+	requested := make([]*net.IPNet, len(ranges))
+	for i, r := range ranges {
+		_, ipNet, err := net.ParseCIDR(r)
+		if err != nil {
+			return fmt.Errorf("invalid IP range %q: %w", r, err)
+		}
+
+		requested[i] = ipNet
+	}
+
+	var errs []error
+
+	for i := range requested {
+		for j := range requested[:i] {
+			if cidrsOverlap(requested[i], requested[j]) {
+				errs = append(errs, fmt.Errorf("IP range %s overlaps requested IP range %s", ranges[i], ranges[j]))
+			}
+		}
+	}
+
+	allocated, err := c.ListVPCIPAddresses(ctx, vpcID, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, ip := range allocated {
+		if excludeInterfaceID != 0 && ip.InterfaceID == excludeInterfaceID {
+			continue
+		}
+
+		existing, ok := ip.ipNet()
+		if !ok {
+			continue
+		}
+
+		for i, r := range requested {
+			if cidrsOverlap(r, existing) {
+				errs = append(errs, fmt.Errorf(
+					"IP range %s overlaps %s allocated to Linode %d interface %d",
+					ranges[i], existing, ip.LinodeID, ip.InterfaceID,
+				))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ipNet returns the range allocated to the VPC IP, or its single address if it is not a range
+func (ip VPCIP) ipNet() (*net.IPNet, bool) {
+	if ip.AddressRange != nil {
+		_, ipNet, err := net.ParseCIDR(*ip.AddressRange)
+		return ipNet, err == nil
+	}
+
+	if ip.Address != nil {
+		address := net.ParseIP(*ip.Address)
+		if address == nil {
+			return nil, false
+		}
+
+		bits := 8 * net.IPv6len
+		if v4 := address.To4(); v4 != nil {
+			address, bits = v4, 8*net.IPv4len
+		}
+
+		return &net.IPNet{IP: address, Mask: net.CIDRMask(bits, bits)}, true
+	}
+
+	return nil, false
+}
+
+// cidrsOverlap reports whether the two networks share any addresses
+func cidrsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
@@ -2,8 +2,11 @@ package linodego
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
+	"math/rand/v2"
 	"net/http"
 	"slices"
 	"strconv"
@@ -11,10 +14,19 @@ import (
 
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
 )
 
 var englishTitle = cases.Title(language.English)
 
+// ErrEventFailed is wrapped by errors reporting that the Event tracking an action has failed
+var ErrEventFailed = errors.New("event failed")
+
+// waitForEventPageSize is the number of events listed by each poll of WaitForEventFinished when
+// events are filtered by entity, and by the first poll of WatchEvents. It is the API's minimum,
+// as the events needed are among the newest.
+const waitForEventPageSize = 25
+
 type EventPoller struct {
 	EntityID   any
 	EntityType EntityType
@@ -29,97 +41,396 @@ type EventPoller struct {
 	previousEvents map[int]bool
 }
 
-// WaitForInstanceStatus waits for the Linode instance to reach the desired state
-// before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForInstanceStatus(ctx context.Context, instanceID int, status InstanceStatus, timeoutSeconds int) (*Instance, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
+// WaitUpdate describes the progress of a wait helper after one of its polls
+type WaitUpdate struct {
+	// Elapsed is the time since the wait started
+	Elapsed time.Duration
 
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
+	// Attempt is the number of polls made so far, starting at 1
+	Attempt int
+
+	// Status is the last status seen, or empty if nothing has been seen yet
+	Status string
+
+	// Percent is the last percent complete seen, if the polled resource reports one
+	Percent *int
+}
+
+// WaitOption configures a single call to a wait helper
+type WaitOption func(*waitOptions)
+
+type waitOptions struct {
+	observer func(WaitUpdate)
+	backoff  WaitBackoff
+	clock    Clock
+}
+
+// WithWaitObserver calls observer with a WaitUpdate after every poll made by the wait helper
+func WithWaitObserver(observer func(WaitUpdate)) WaitOption {
+	return func(o *waitOptions) {
+		o.observer = observer
+	}
+}
+
+// WaitBackoff configures the intervals between the polls made by a wait helper.
+// The first poll is made after InitialInterval, and each following interval is the
+// previous one multiplied by Multiplier, up to MaxInterval. The zero value polls at
+// the client's fixed poll interval.
+type WaitBackoff struct {
+	// InitialInterval defaults to the client's poll interval (see SetPollDelay)
+	InitialInterval time.Duration
+
+	// MaxInterval is the longest interval between polls; defaults to no limit
+	MaxInterval time.Duration
+
+	// Multiplier is applied to the interval after each poll; values below 1 are treated as 1
+	Multiplier float64
+
+	// Jitter randomly lengthens or shortens each interval by up to the given fraction of it
+	// (e.g. 0.1 for ±10%), so that concurrent waiters do not poll in lockstep. It is limited to 1.
+	Jitter float64
+}
+
+// WithWaitBackoff polls with the given backoff rather than at the client's fixed poll interval
+func WithWaitBackoff(backoff WaitBackoff) WaitOption {
+	return func(o *waitOptions) {
+		o.backoff = backoff
+	}
+}
+
+// Clock provides the current time and timers, allowing the passage of time to be controlled in tests
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// WithWaitClock uses clock instead of the system clock to time polls, e.g. in tests
+func WithWaitClock(clock Clock) WaitOption {
+	return func(o *waitOptions) {
+		o.clock = clock
+	}
+}
+
+// waiter times the polls of a wait helper and reports them to its observer, if any
+type waiter struct {
+	waitOptions
+
+	started  time.Time
+	attempt  int
+	interval time.Duration
+}
+
+func (client Client) newWaiter(opts []WaitOption) *waiter {
+	var o waitOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.clock == nil {
+		o.clock = systemClock{}
+	}
+
+	interval := o.backoff.InitialInterval
+	if interval <= 0 {
+		interval = client.pollInterval
+	}
+
+	if o.backoff.MaxInterval > 0 {
+		interval = min(interval, o.backoff.MaxInterval)
+	}
 
+	return &waiter{waitOptions: o, started: o.clock.Now(), interval: interval}
+}
+
+// poll calls fn after each interval until it reports that the wait is complete or returns
+// an error, which is returned as is. If ctx is done first, ctx.Err() is returned.
+func (w *waiter) poll(ctx context.Context, fn func() (bool, error)) error {
 	for {
 		select {
-		case <-ticker.C:
-			instance, err := client.GetInstance(ctx, instanceID)
-			if err != nil {
-				return instance, err
+		case <-w.clock.After(w.nextInterval()):
+			done, err := fn()
+			if err != nil && ctx.Err() != nil && !errors.Is(err, ctx.Err()) {
+				// The request failed because the context ended while it was being made
+				return fmt.Errorf("%w: %w", ctx.Err(), err)
 			}
-			complete := (instance.Status == status)
 
-			if complete {
-				return instance, nil
+			if err != nil || done {
+				return err
 			}
 		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Instance %d status %s: %w", instanceID, status, ctx.Err())
+			return ctx.Err()
+		}
+	}
+}
+
+// nextInterval returns the interval before the next poll and advances the backoff
+func (w *waiter) nextInterval() time.Duration {
+	interval := w.interval
+
+	if w.backoff.Multiplier > 1 {
+		w.interval = time.Duration(float64(w.interval) * w.backoff.Multiplier)
+		if w.backoff.MaxInterval > 0 {
+			w.interval = min(w.interval, w.backoff.MaxInterval)
+		}
+	}
+
+	if jitter := min(w.backoff.Jitter, 1); jitter > 0 {
+		interval += time.Duration((rand.Float64()*2 - 1) * jitter * float64(interval)) //nolint:gosec
+	}
+
+	return interval
+}
+
+// report records a poll that saw the given status and percent complete
+func (w *waiter) report(status string, percent *int) {
+	w.attempt++
+
+	if w.observer == nil {
+		return
+	}
+
+	w.observer(WaitUpdate{
+		Elapsed: w.clock.Now().Sub(w.started),
+		Attempt: w.attempt,
+		Status:  status,
+		Percent: percent,
+	})
+}
+
+// WaitForInstanceStatus waits for the Linode instance to reach the desired state
+// before returning. It will timeout with an error after timeoutSeconds.
+func (client Client) WaitForInstanceStatus(
+	ctx context.Context,
+	instanceID int,
+	status InstanceStatus,
+	timeoutSeconds int,
+	opts ...WaitOption,
+) (*Instance, error) {
+	w := client.newWaiter(opts)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	var instance *Instance
+
+	err := w.poll(ctx, func() (bool, error) {
+		var err error
+
+		instance, err = client.GetInstance(ctx, instanceID)
+		if err != nil {
+			return false, err
+		}
+
+		w.report(string(instance.Status), nil)
+
+		return instance.Status == status, nil
+	})
+	if err != nil {
+		if err == ctx.Err() {
+			return nil, fmt.Errorf("Error waiting for Instance %d status %s: %w", instanceID, status, err)
 		}
+
+		return instance, err
 	}
+
+	return instance, nil
 }
 
 // WaitForInstanceDiskStatus waits for the Linode instance disk to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID int, diskID int, status DiskStatus, timeoutSeconds int) (*InstanceDisk, error) {
+func (client Client) WaitForInstanceDiskStatus(
+	ctx context.Context,
+	instanceID int,
+	diskID int,
+	status DiskStatus,
+	timeoutSeconds int,
+	opts ...WaitOption,
+) (*InstanceDisk, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
+	disk, err := client.WaitForInstanceDiskStatusCtx(ctx, instanceID, diskID, status, opts...)
+	if err != nil && err == ctx.Err() {
+		return nil, fmt.Errorf("Error waiting for Instance %d Disk %d status %s: %w", instanceID, diskID, status, err)
+	}
 
-	for {
-		select {
-		case <-ticker.C:
-			// GetInstanceDisk will 404 on newly created disks. use List instead.
-			// disk, err := client.GetInstanceDisk(ctx, instanceID, diskID)
-			disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
-			if err != nil {
-				return nil, err
+	return disk, err
+}
+
+// WaitForInstanceDiskStatusCtx waits for the Linode instance disk to reach the desired state
+// before returning. It waits until the context is done, returning the context's error
+// (e.g. context.DeadlineExceeded) unwrapped.
+func (client Client) WaitForInstanceDiskStatusCtx(
+	ctx context.Context,
+	instanceID int,
+	diskID int,
+	status DiskStatus,
+	opts ...WaitOption,
+) (*InstanceDisk, error) {
+	w := client.newWaiter(opts)
+
+	var result *InstanceDisk
+
+	err := w.poll(ctx, func() (bool, error) {
+		// GetInstanceDisk will 404 on newly created disks. use List instead.
+		disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
+		if err != nil {
+			// Report a request interrupted by the context the same as an expired context
+			if ctx.Err() != nil {
+				return false, ctx.Err()
 			}
 
-			for _, disk := range disks {
-				if disk.ID == diskID {
-					complete := (disk.Status == status)
-					if complete {
-						return &disk, nil
-					}
+			return false, err
+		}
+
+		for _, disk := range disks {
+			if disk.ID == diskID {
+				w.report(string(disk.Status), nil)
 
-					break
+				if disk.Status == status {
+					result = &disk
+					return true, nil
 				}
+
+				break
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Instance %d Disk %d status %s: %w", instanceID, diskID, status, ctx.Err())
 		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
 	}
+
+	return result, nil
+}
+
+// WaitForInstanceDiskCreated waits for the creation of an Instance disk, e.g. from an Image, to finish
+// before returning the disk. Rather than polling the disk's status, which can race with the Event
+// populating it, the disk_create or disk_duplicate Event for the disk created at or after the disk's
+// Created timestamp is tracked until it finishes. If the Event fails, the returned error wraps
+// ErrEventFailed and includes the Event's message. It will timeout with an error after timeoutSeconds.
+func (client Client) WaitForInstanceDiskCreated(
+	ctx context.Context,
+	instanceID int,
+	diskID int,
+	timeoutSeconds int,
+	opts ...WaitOption,
+) (*InstanceDisk, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	// GetInstanceDisk will 404 on newly created disks. use List instead.
+	disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := slices.IndexFunc(disks, func(d InstanceDisk) bool { return d.ID == diskID })
+	if idx < 0 || disks[idx].Created == nil {
+		return nil, fmt.Errorf("failed to find the creation time of Instance %d Disk %d", instanceID, diskID)
+	}
+
+	f := Filter{
+		OrderBy: "created",
+		Order:   Descending,
+	}
+	f.AddField(Eq, "entity.type", EntityLinode)
+	f.AddField(Eq, "entity.id", instanceID)
+	f.AddField(Gte, "created", disks[idx].Created.UTC().Format("2006-01-02T15:04:05"))
+
+	fBytes, err := f.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := NewListOptions(1, string(fBytes))
+	listOptions.PageSize = waitForEventPageSize
+
+	w := client.newWaiter(opts)
+
+	err = w.poll(ctx, func() (bool, error) {
+		events, err := client.ListEvents(ctx, listOptions)
+		if err != nil {
+			return false, fmt.Errorf("failed to list events: %w", err)
+		}
+
+		idx := slices.IndexFunc(events, func(e Event) bool {
+			return (e.Action == ActionDiskCreate || e.Action == ActionDiskDuplicate) &&
+				eventMatchesSecondary(diskID, e)
+		})
+		if idx < 0 {
+			w.report("", nil)
+			return false, nil
+		}
+
+		event := events[idx]
+		w.report(string(event.Status), copyInt(&event.PercentComplete))
+
+		switch event.Status {
+		case EventFinished:
+			return true, nil
+		case EventFailed:
+			return false, fmt.Errorf("%w: creation of Instance %d Disk %d failed (event %d): %s",
+				ErrEventFailed, instanceID, diskID, event.ID, event.Message)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		if errors.Is(err, ctx.Err()) {
+			return nil, fmt.Errorf("failed to wait for Instance %d Disk %d creation: %w", instanceID, diskID, err)
+		}
+
+		return nil, err
+	}
+
+	return client.GetInstanceDisk(ctx, instanceID, diskID)
 }
 
 // WaitForVolumeStatus waits for the Volume to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForVolumeStatus(ctx context.Context, volumeID int, status VolumeStatus, timeoutSeconds int) (*Volume, error) {
+func (client Client) WaitForVolumeStatus(
+	ctx context.Context,
+	volumeID int,
+	status VolumeStatus,
+	timeoutSeconds int,
+	opts ...WaitOption,
+) (*Volume, error) {
+	w := client.newWaiter(opts)
+
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.pollInterval)
-	defer ticker.Stop()
+	var volume *Volume
 
-	for {
-		select {
-		case <-ticker.C:
-			volume, err := client.GetVolume(ctx, volumeID)
-			if err != nil {
-				return volume, err
-			}
-			complete := (volume.Status == status)
+	err := w.poll(ctx, func() (bool, error) {
+		var err error
 
-			if complete {
-				return volume, nil
-			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Volume %d status %s: %w", volumeID, status, ctx.Err())
+		volume, err = client.GetVolume(ctx, volumeID)
+		if err != nil {
+			return false, err
+		}
+
+		w.report(string(volume.Status), nil)
+
+		return volume.Status == status, nil
+	})
+	if err != nil {
+		if err == ctx.Err() {
+			return nil, fmt.Errorf("Error waiting for Volume %d status %s: %w", volumeID, status, err)
 		}
+
+		return volume, err
 	}
+
+	return volume, nil
 }
 
 // WaitForSnapshotStatus waits for the Snapshot to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
+// If the Snapshot fails or is aborted before reaching the desired state,
+// both the Snapshot and an error are returned immediately.
 func (client Client) WaitForSnapshotStatus(ctx context.Context, instanceID int, snapshotID int, status InstanceSnapshotStatus, timeoutSeconds int) (*InstanceSnapshot, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
@@ -139,6 +450,10 @@ func (client Client) WaitForSnapshotStatus(ctx context.Context, instanceID int,
 			if complete {
 				return snapshot, nil
 			}
+
+			if snapshot.Status.isFailed() {
+				return snapshot, fmt.Errorf("Instance %d Snapshot %d reached status %s while waiting for status %s", instanceID, snapshotID, snapshot.Status, status)
+			}
 		case <-ctx.Done():
 			return nil, fmt.Errorf("Error waiting for Instance %d Snapshot %d status %s: %w", instanceID, snapshotID, status, ctx.Err())
 		}
@@ -276,9 +591,118 @@ func (client Client) WaitForLKEClusterConditions(
 	return nil
 }
 
+// WaitForLKEClusterReady waits for the Kubeconfig of the LKE Cluster to be available and
+// for every node of its node pools to be ready, returning the decoded Kubeconfig.
+// While the cluster is being provisioned, GetLKEClusterKubeconfig reports that the Kubeconfig
+// is not found; this is treated as the cluster not being ready yet. It will timeout with an
+// error after timeoutSeconds.
+func (client Client) WaitForLKEClusterReady(
+	ctx context.Context,
+	clusterID int,
+	timeoutSeconds int,
+	opts ...WaitOption,
+) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	w := client.newWaiter(opts)
+
+	var kubeconfig []byte
+
+	err := w.poll(ctx, func() (bool, error) {
+		if kubeconfig == nil {
+			response, err := client.GetLKEClusterKubeconfig(ctx, clusterID)
+			if IsNotFound(err) {
+				w.report("", nil)
+				return false, nil
+			}
+
+			if err != nil {
+				return false, err
+			}
+
+			decoded, err := decodeKubeconfig(response.KubeConfig)
+			if err != nil {
+				return false, fmt.Errorf("failed to decode Kubeconfig for LKE cluster %d: %w", clusterID, err)
+			}
+
+			kubeconfig = decoded
+		}
+
+		pools, err := client.ListLKENodePools(ctx, clusterID, nil)
+		if err != nil {
+			return false, err
+		}
+
+		ready := lkeNodePoolsReady(pools)
+		if ready {
+			w.report(string(LKELinodeReady), nil)
+		} else {
+			w.report(string(LKELinodeNotReady), nil)
+		}
+
+		return ready, nil
+	})
+	if err != nil {
+		if errors.Is(err, ctx.Err()) {
+			return nil, fmt.Errorf("Error waiting for LKE cluster %d to be ready: %w", clusterID, err)
+		}
+
+		return nil, err
+	}
+
+	return kubeconfig, nil
+}
+
+// decodeKubeconfig decodes a base64 encoded Kubeconfig, checking that it is YAML with an
+// apiVersion and at least one cluster. The YAML is not otherwise validated.
+func decodeKubeconfig(encoded string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var kubeconfig struct {
+		APIVersion string `yaml:"apiVersion"`
+		Clusters   []any  `yaml:"clusters"`
+	}
+
+	if err := yaml.Unmarshal(decoded, &kubeconfig); err != nil {
+		return nil, fmt.Errorf("invalid kubeconfig: %w", err)
+	}
+
+	if kubeconfig.APIVersion == "" {
+		return nil, errors.New("kubeconfig has no apiVersion")
+	}
+
+	if len(kubeconfig.Clusters) == 0 {
+		return nil, errors.New("kubeconfig has no clusters")
+	}
+
+	return decoded, nil
+}
+
+// lkeNodePoolsReady reports whether every node pool has all of its nodes and each node is ready
+func lkeNodePoolsReady(pools []LKENodePool) bool {
+	for _, pool := range pools {
+		if len(pool.Linodes) < pool.Count {
+			return false
+		}
+
+		for _, node := range pool.Linodes {
+			if node.Status != LKELinodeReady {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // WaitForEventFinished waits for an entity action to reach the 'finished' state
 // before returning. It will timeout with an error after timeoutSeconds.
-// If the event indicates a failure both the failed event and the error will be returned.
+// If the event indicates a failure both the failed event and an error wrapping
+// ErrEventFailed, including the event's message, will be returned.
 // nolint
 func (client Client) WaitForEventFinished(
 	ctx context.Context,
@@ -287,7 +711,9 @@ func (client Client) WaitForEventFinished(
 	action EventAction,
 	minStart time.Time,
 	timeoutSeconds int,
+	opts ...WaitOption,
 ) (*Event, error) {
+	w := client.newWaiter(opts)
 	titledEntityType := englishTitle.String(string(entityType))
 	filter := Filter{
 		Order:   Descending,
@@ -300,6 +726,10 @@ func (client Client) WaitForEventFinished(
 	// precise filtering options exist.
 	pages := 1
 
+	// The page size used when events are filtered by entity, and so only
+	// include the events of the entity being waited for.
+	pageSize := 0
+
 	// The API has limitted filtering support for Event ID and Event Type
 	// Optimize the list, if possible
 	switch entityType {
@@ -312,6 +742,7 @@ func (client Client) WaitForEventFinished(
 		}
 		filter.AddField(Eq, "entity.id", filterableEntityID)
 		filter.AddField(Eq, "entity.type", entityType)
+		pageSize = waitForEventPageSize
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
@@ -322,97 +753,207 @@ func (client Client) WaitForEventFinished(
 		log.Printf("[INFO] Waiting %d seconds for %s events since %v for %s %v", int(duration.Seconds()), action, minStart, titledEntityType, id)
 	}
 
-	ticker := time.NewTicker(client.pollInterval)
-
 	// avoid repeating log messages
 	nextLog := ""
 	lastLog := ""
 	lastEventID := 0
 
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			if lastEventID > 0 {
-				filter.AddField(Gte, "id", lastEventID)
+	var result *Event
+
+	err := w.poll(ctx, func() (bool, error) {
+		pollFilter := filter
+		if lastEventID > 0 {
+			pollFilter.Children = append(slices.Clip(filter.Children), &Comp{"id", Gte, lastEventID})
+		}
+
+		filterStr, err := pollFilter.MarshalJSON()
+		if err != nil {
+			return false, err
+		}
+
+		listOptions := NewListOptions(pages, string(filterStr))
+		listOptions.PageSize = pageSize
+
+		events, err := client.ListEvents(ctx, listOptions)
+		if err != nil {
+			return false, err
+		}
+
+		// Only the first matching event of each poll is reported as progress
+		polled := false
+
+		// If there are events for this instance + action, inspect them
+		for _, event := range events {
+			event := event
+
+			if event.Entity == nil || event.Entity.Type != entityType {
+				continue
 			}
 
-			filterStr, err := filter.MarshalJSON()
-			if err != nil {
-				return nil, err
+			var entID string
+
+			switch id := event.Entity.ID.(type) {
+			case float64, float32:
+				entID = fmt.Sprintf("%.f", id)
+			case int:
+				entID = strconv.Itoa(id)
+			default:
+				entID = fmt.Sprintf("%v", id)
 			}
 
-			listOptions := NewListOptions(pages, string(filterStr))
+			var findID string
+			switch id := id.(type) {
+			case float64, float32:
+				findID = fmt.Sprintf("%.f", id)
+			case int:
+				findID = strconv.Itoa(id)
+			default:
+				findID = fmt.Sprintf("%v", id)
+			}
 
-			events, err := client.ListEvents(ctx, listOptions)
-			if err != nil {
-				return nil, err
+			if entID != findID {
+				continue
 			}
 
-			// If there are events for this instance + action, inspect them
-			for _, event := range events {
-				event := event
+			if event.Created == nil {
+				log.Printf("[WARN] event.Created is nil when API returned: %#+v", event.Created)
+			}
 
-				if event.Entity == nil || event.Entity.Type != entityType {
-					// log.Println("type mismatch", event.Entity.Type, entityType)
-					continue
-				}
+			// This is the event we are looking for. Save our place.
+			if lastEventID == 0 {
+				lastEventID = event.ID
+			}
 
-				var entID string
+			if !polled {
+				w.report(string(event.Status), copyInt(&event.PercentComplete))
+				polled = true
+			}
 
-				switch id := event.Entity.ID.(type) {
-				case float64, float32:
-					entID = fmt.Sprintf("%.f", id)
-				case int:
-					entID = strconv.Itoa(id)
-				default:
-					entID = fmt.Sprintf("%v", id)
-				}
+			switch event.Status {
+			case EventFailed:
+				result = &event
+				return false, fmt.Errorf("%w: %s %v action %s failed (event %d): %s",
+					ErrEventFailed, titledEntityType, id, action, event.ID, event.Message)
+			case EventFinished:
+				log.Printf("[INFO] %s %v action %s is finished", titledEntityType, id, action)
+				result = &event
+				return true, nil
+			}
 
-				var findID string
-				switch id := id.(type) {
-				case float64, float32:
-					findID = fmt.Sprintf("%.f", id)
-				case int:
-					findID = strconv.Itoa(id)
-				default:
-					findID = fmt.Sprintf("%v", id)
-				}
+			nextLog = fmt.Sprintf("[INFO] %s %v action %s is %s", titledEntityType, id, action, event.Status)
+		}
 
-				if entID != findID {
-					// log.Println("id mismatch", entID, findID)
-					continue
-				}
+		if !polled {
+			w.report("", nil)
+		}
 
-				if event.Created == nil {
-					log.Printf("[WARN] event.Created is nil when API returned: %#+v", event.Created)
-				}
+		// de-dupe logging statements
+		if nextLog != lastLog {
+			log.Print(nextLog)
+			lastLog = nextLog
+		}
 
-				// This is the event we are looking for. Save our place.
-				if lastEventID == 0 {
-					lastEventID = event.ID
-				}
+		return false, nil
+	})
+	if err != nil {
+		if errors.Is(err, ctx.Err()) {
+			return nil, fmt.Errorf("Error waiting for Event Status '%s' of %s %v action '%s': %w", EventFinished, titledEntityType, id, action, err)
+		}
 
-				switch event.Status {
-				case EventFailed:
-					return &event, fmt.Errorf("%s %v action %s failed", titledEntityType, id, action)
-				case EventFinished:
-					log.Printf("[INFO] %s %v action %s is finished", titledEntityType, id, action)
-					return &event, nil
-				}
+		return result, err
+	}
 
-				nextLog = fmt.Sprintf("[INFO] %s %v action %s is %s", titledEntityType, id, action, event.Status)
-			}
+	return result, nil
+}
 
-			// de-dupe logging statements
-			if nextLog != lastLog {
-				log.Print(nextLog)
-				lastLog = nextLog
-			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Event Status '%s' of %s %v action '%s': %w", EventFinished, titledEntityType, id, action, ctx.Err())
+// WaitForEventFinishedWithOptions waits for an entity action to reach the 'finished' state as
+// WaitForEventFinished does, polling with the given backoff. This allows many resources to be
+// waited for concurrently without polling the API at a fixed rate for each of them.
+func (client Client) WaitForEventFinishedWithOptions(
+	ctx context.Context,
+	id any,
+	entityType EntityType,
+	action EventAction,
+	minStart time.Time,
+	timeoutSeconds int,
+	backoff WaitBackoff,
+	opts ...WaitOption,
+) (*Event, error) {
+	opts = append(slices.Clone(opts), WithWaitBackoff(backoff))
+	return client.WaitForEventFinished(ctx, id, entityType, action, minStart, timeoutSeconds, opts...)
+}
+
+// WaitForInstanceResize waits for the resize of the Instance started at or after minStart to finish.
+// Rather than polling the Instance's status, which passes through transient states such as running
+// during a warm resize, the resize is tracked using its linode_resize Event, so minStart should be
+// recorded before calling ResizeInstance. If the resize fails, both the failed Event and an error
+// wrapping ErrEventFailed, including the Event's message, are returned. It will timeout with an
+// error after timeoutSeconds.
+func (client Client) WaitForInstanceResize(
+	ctx context.Context,
+	instanceID int,
+	minStart time.Time,
+	timeoutSeconds int,
+	opts ...WaitOption,
+) (*Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	f := Filter{
+		OrderBy: "created",
+		Order:   Descending,
+	}
+	f.AddField(Eq, "entity.type", EntityLinode)
+	f.AddField(Eq, "entity.id", instanceID)
+	f.AddField(Eq, "action", ActionLinodeResize)
+	f.AddField(Gte, "created", minStart.UTC().Format("2006-01-02T15:04:05"))
+
+	fBytes, err := f.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := NewListOptions(1, string(fBytes))
+	listOptions.PageSize = waitForEventPageSize
+
+	w := client.newWaiter(opts)
+
+	var result *Event
+
+	err = w.poll(ctx, func() (bool, error) {
+		events, err := client.ListEvents(ctx, listOptions)
+		if err != nil {
+			return false, fmt.Errorf("failed to list events: %w", err)
+		}
+
+		if len(events) == 0 {
+			w.report("", nil)
+			return false, nil
+		}
+
+		event := events[0]
+		w.report(string(event.Status), copyInt(&event.PercentComplete))
+
+		switch event.Status {
+		case EventFinished:
+			result = &event
+			return true, nil
+		case EventFailed:
+			result = &event
+			return false, fmt.Errorf("%w: resize of Instance %d failed (event %d): %s", ErrEventFailed, instanceID, event.ID, event.Message)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		if errors.Is(err, ctx.Err()) {
+			return nil, fmt.Errorf("failed to wait for Instance %d resize: %w", instanceID, err)
 		}
+
+		return result, err
 	}
+
+	return result, nil
 }
 
 // WaitForImageStatus waits for the Image to reach the desired state